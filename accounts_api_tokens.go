@@ -0,0 +1,224 @@
+package goztl
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"time"
+)
+
+const aatBasePath = "accounts/api_tokens/"
+
+// AccountsAPITokensService is an interface for interfacing with the API tokens
+// endpoints of the Zentral API
+type AccountsAPITokensService interface {
+	List(context.Context, *ListOptions) ([]APIToken, *Response, error)
+	ListByUserID(context.Context, int) ([]APIToken, *Response, error)
+	GetByID(context.Context, string) (*APIToken, *Response, error)
+	Create(context.Context, *APITokenRequest) (*APIToken, *Response, error)
+	Delete(context.Context, string) (*Response, error)
+}
+
+// AccountsAPITokensServiceOp handles communication with the API tokens related
+// methods of the Zentral API.
+type AccountsAPITokensServiceOp struct {
+	client *Client
+}
+
+var _ AccountsAPITokensService = &AccountsAPITokensServiceOp{}
+
+// APIToken represents a Zentral API token
+type APIToken struct {
+	ID       string     `json:"id"`
+	UserID   int        `json:"user"`
+	Name     string     `json:"name"`
+	Expiry   *Timestamp `json:"expiry"`
+	LastUsed *Timestamp `json:"last_used_at"`
+	Created  Timestamp  `json:"created_at"`
+
+	// Only returned when the token is created
+	Secret string `json:"secret,omitempty" ztl:"secret"`
+}
+
+func (t APIToken) String() string {
+	return Stringify(t)
+}
+
+// APITokenRequest represents a request to create an API token
+type APITokenRequest struct {
+	UserID int    `json:"user"`
+	Name   string `json:"name"`
+
+	// The token never expires if nil
+	Expiry *time.Time `json:"expiry"`
+}
+
+type listAATOptions struct {
+	UserID int `url:"user_id,omitempty"`
+}
+
+// List lists all the API tokens.
+func (s *AccountsAPITokensServiceOp) List(ctx context.Context, opt *ListOptions) ([]APIToken, *Response, error) {
+	return s.list(ctx, opt, nil)
+}
+
+// ListByUserID lists the API tokens of a user or a service account.
+func (s *AccountsAPITokensServiceOp) ListByUserID(ctx context.Context, userID int) ([]APIToken, *Response, error) {
+	if userID < 1 {
+		return nil, nil, NewArgError("userID", "cannot be less than 1")
+	}
+	return s.list(ctx, nil, &listAATOptions{UserID: userID})
+}
+
+// GetByID retrieves an API token by id.
+func (s *AccountsAPITokensServiceOp) GetByID(ctx context.Context, tokenID string) (*APIToken, *Response, error) {
+	if len(tokenID) < 1 {
+		return nil, nil, NewArgError("tokenID", "cannot be blank")
+	}
+
+	path := aatBasePath + url.PathEscape(tokenID) + "/"
+
+	req, err := s.client.NewRequest(ctx, http.MethodGet, path, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	token := new(APIToken)
+
+	resp, err := s.client.Do(ctx, req, token)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return token, resp, err
+}
+
+// Create a new API token. The secret of the token is only available in the returned token.
+func (s *AccountsAPITokensServiceOp) Create(ctx context.Context, createRequest *APITokenRequest) (*APIToken, *Response, error) {
+	if createRequest == nil {
+		return nil, nil, NewArgError("createRequest", "cannot be nil")
+	}
+
+	req, err := s.client.NewRequest(ctx, http.MethodPost, aatBasePath, createRequest)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	token := new(APIToken)
+	resp, err := s.client.Do(ctx, req, token)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return token, resp, err
+}
+
+// Delete revokes an API token.
+func (s *AccountsAPITokensServiceOp) Delete(ctx context.Context, tokenID string) (*Response, error) {
+	if len(tokenID) < 1 {
+		return nil, NewArgError("tokenID", "cannot be blank")
+	}
+
+	path := aatBasePath + url.PathEscape(tokenID) + "/"
+
+	req, err := s.client.NewRequest(ctx, http.MethodDelete, path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.client.Do(ctx, req, nil)
+
+	return resp, err
+}
+
+// Helper method for listing API tokens
+func (s *AccountsAPITokensServiceOp) list(ctx context.Context, opt *ListOptions, aatOpt *listAATOptions) ([]APIToken, *Response, error) {
+	path := aatBasePath
+	path, err := addOptions(path, opt)
+	if err != nil {
+		return nil, nil, err
+	}
+	path, err = addOptions(path, aatOpt)
+	if err != nil {
+		return nil, nil, err
+	}
+	return resolveAllPages[APIToken](ctx, s.client, path)
+}
+
+// ErrAPITokenRejected is returned by RotateAPIToken when the new token is not accepted by the API.
+var ErrAPITokenRejected = errors.New("new API token rejected")
+
+// RotateAPIToken replaces an API token:
+//
+//   - a new token is created with createRequest;
+//   - the new token is passed to store, to be saved, e.g. in a vault;
+//   - the new token is verified with a GET request on the API tokens endpoint. A 2xx or a 403
+//     response proves that the token authenticates, since a token without the permission to list
+//     the tokens is valid;
+//   - the old token is revoked.
+//
+// If the new token cannot be stored, or is rejected with a 401 response, it is revoked, and the old
+// token is kept. If the new token cannot be verified for another reason, e.g. a network or a server
+// error, both tokens are kept, and the new token is returned with the error: the stored token is
+// likely valid, and the old token can be revoked once it is verified. If the old token cannot be
+// revoked, the new token is returned with the error.
+func RotateAPIToken(ctx context.Context, c *Client, oldTokenID string, createRequest *APITokenRequest, store func(context.Context, *APIToken) error) (*APIToken, error) {
+	if len(oldTokenID) < 1 {
+		return nil, NewArgError("oldTokenID", "cannot be blank")
+	}
+	if store == nil {
+		return nil, NewArgError("store", "cannot be nil")
+	}
+
+	token, _, err := c.AccountsAPITokens.Create(ctx, createRequest)
+	if err != nil {
+		return nil, fmt.Errorf("create new API token: %w", err)
+	}
+	if err := store(ctx, token); err != nil {
+		return nil, revokeNewToken(ctx, c, token, fmt.Errorf("store new API token: %w", err))
+	}
+	if err := verifyAPIToken(ctx, c, token.Secret); errors.Is(err, ErrAPITokenRejected) {
+		return nil, revokeNewToken(ctx, c, token, err)
+	} else if err != nil {
+		return token, fmt.Errorf("verify new API token: %w", err)
+	}
+	if _, err := c.AccountsAPITokens.Delete(ctx, oldTokenID); err != nil {
+		return token, fmt.Errorf("revoke old API token: %w", err)
+	}
+	return token, nil
+}
+
+// revokeNewToken revokes the new token of a failed rotation, and returns the rotation error.
+func revokeNewToken(ctx context.Context, c *Client, token *APIToken, err error) error {
+	if _, rerr := c.AccountsAPITokens.Delete(ctx, token.ID); rerr != nil {
+		return errors.Join(err, fmt.Errorf("revoke new API token: %w", rerr))
+	}
+	return err
+}
+
+// verifyAPIToken makes a cheap authenticated request with a token. ErrAPITokenRejected is
+// returned for a 401 response, and the other errors, except a 403 response, are returned as is.
+func verifyAPIToken(ctx context.Context, c *Client, secret string) error {
+	path, err := addOptions(aatBasePath, &ListOptions{Limit: 1})
+	if err != nil {
+		return err
+	}
+	req, err := c.NewRequest(ctx, http.MethodGet, path, nil)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Authorization", fmt.Sprintf("Token %v", secret))
+	_, err = c.Do(ctx, req, nil)
+	var er *ErrorResponse
+	if errors.As(err, &er) {
+		switch er.Response.StatusCode {
+		case http.StatusUnauthorized:
+			return ErrAPITokenRejected
+		case http.StatusForbidden:
+			return nil
+		}
+	}
+	return err
+}
@@ -0,0 +1,229 @@
+package goztl
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+var aatJSONResponse = `
+{
+  "id": "f8e1b2c3-d4e5-4f60-8a7b-9c0d1e2f3a4b",
+  "user": 5,
+  "name": "CI",
+  "expiry": "2022-07-22T01:02:03.444444",
+  "last_used_at": null,
+  "created_at": "2022-07-22T01:02:03.444444"
+}
+`
+
+func TestAccountsAPITokensService_List(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/accounts/api_tokens/", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testHeader(t, r, "Accept", "application/json")
+		fmt.Fprint(w, "["+aatJSONResponse+"]")
+	})
+
+	ctx := context.Background()
+	got, _, err := client.AccountsAPITokens.List(ctx, nil)
+	if err != nil {
+		t.Errorf("AccountsAPITokens.List returned error: %v", err)
+	}
+
+	want := []APIToken{
+		{
+			ID:      "f8e1b2c3-d4e5-4f60-8a7b-9c0d1e2f3a4b",
+			UserID:  5,
+			Name:    "CI",
+			Expiry:  &Timestamp{referenceTime},
+			Created: Timestamp{referenceTime},
+		},
+	}
+	if !cmp.Equal(got, want) {
+		t.Errorf("AccountsAPITokens.List returned %+v, want %+v", got, want)
+	}
+}
+
+func TestAccountsAPITokensService_ListByUserID(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/accounts/api_tokens/", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testHeader(t, r, "Accept", "application/json")
+		testQueryArg(t, r, "user_id", "5")
+		fmt.Fprint(w, "["+aatJSONResponse+"]")
+	})
+
+	ctx := context.Background()
+	got, _, err := client.AccountsAPITokens.ListByUserID(ctx, 5)
+	if err != nil {
+		t.Errorf("AccountsAPITokens.ListByUserID returned error: %v", err)
+	}
+	if len(got) != 1 || got[0].UserID != 5 {
+		t.Errorf("AccountsAPITokens.ListByUserID returned %+v", got)
+	}
+}
+
+func TestAccountsAPITokensService_GetByID(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/accounts/api_tokens/f8e1b2c3-d4e5-4f60-8a7b-9c0d1e2f3a4b/", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testHeader(t, r, "Accept", "application/json")
+		fmt.Fprint(w, aatJSONResponse)
+	})
+
+	ctx := context.Background()
+	got, _, err := client.AccountsAPITokens.GetByID(ctx, "f8e1b2c3-d4e5-4f60-8a7b-9c0d1e2f3a4b")
+	if err != nil {
+		t.Errorf("AccountsAPITokens.GetByID returned error: %v", err)
+	}
+	if got == nil || got.Name != "CI" {
+		t.Errorf("AccountsAPITokens.GetByID returned %+v", got)
+	}
+}
+
+func TestAccountsAPITokensService_Create(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/accounts/api_tokens/", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testHeader(t, r, "Accept", "application/json")
+		testHeader(t, r, "Content-Type", "application/json")
+		testBody(t, r, `{"user":5,"name":"CI","expiry":"2022-07-22T01:02:03.444444Z"}`+"\n")
+		fmt.Fprint(w, `{"id": "f8e1b2c3", "user": 5, "name": "CI", "expiry": "2022-07-22T01:02:03.444444",
+		                "last_used_at": null, "created_at": "2022-07-22T01:02:03.444444", "secret": "ztlp_yolo"}`)
+	})
+
+	ctx := context.Background()
+	expiry := referenceTime
+	got, _, err := client.AccountsAPITokens.Create(ctx, &APITokenRequest{UserID: 5, Name: "CI", Expiry: &expiry})
+	if err != nil {
+		t.Errorf("AccountsAPITokens.Create returned error: %v", err)
+	}
+	if got == nil || got.Secret != "ztlp_yolo" {
+		t.Errorf("AccountsAPITokens.Create returned %+v", got)
+	}
+	if s := got.String(); s != `goztl.APIToken{ID:"f8e1b2c3", UserID:5, Name:"CI", Expiry:goztl.Timestamp{2022-07-22 01:02:03.444444 +0000 UTC}, `+
+		`Created:goztl.Timestamp{2022-07-22 01:02:03.444444 +0000 UTC}, Secret:<redacted>}` {
+		t.Errorf("APIToken.String returned %s", s)
+	}
+}
+
+func TestAccountsAPITokensService_Delete(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/accounts/api_tokens/f8e1b2c3/", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	ctx := context.Background()
+	_, err := client.AccountsAPITokens.Delete(ctx, "f8e1b2c3")
+	if err != nil {
+		t.Errorf("AccountsAPITokens.Delete returned error: %v", err)
+	}
+}
+
+func TestRotateAPIToken(t *testing.T) {
+	for _, tt := range []struct {
+		name        string
+		storeErr    error
+		verifyCode  int
+		wantErr     error
+		keptBoth    bool
+		wantDeleted []string
+	}{
+		{name: "rotated", verifyCode: http.StatusOK, wantDeleted: []string{"old"}},
+		{name: "rotated without permission", verifyCode: http.StatusForbidden, wantDeleted: []string{"old"}},
+		{name: "store error", storeErr: errors.New("vault sealed"), wantDeleted: []string{"new"}},
+		{name: "rejected", verifyCode: http.StatusUnauthorized, wantErr: ErrAPITokenRejected, wantDeleted: []string{"new"}},
+		// both tokens are kept when the verification fails for another reason
+		{name: "server error", verifyCode: http.StatusInternalServerError, keptBoth: true},
+		{name: "not found", verifyCode: http.StatusNotFound, keptBoth: true},
+		{name: "network error", verifyCode: 0, keptBoth: true},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			client, mux, teardown := setup()
+			defer teardown()
+
+			var verified bool
+			mux.HandleFunc("/accounts/api_tokens/", func(w http.ResponseWriter, r *http.Request) {
+				switch r.Method {
+				case http.MethodPost:
+					testHeader(t, r, "Authorization", "Token "+testToken)
+					fmt.Fprint(w, `{"id": "new", "user": 5, "name": "CI", "expiry": null, "last_used_at": null,
+					                "created_at": "2022-07-22T01:02:03.444444", "secret": "ztlp_new"}`)
+				case http.MethodGet:
+					testHeader(t, r, "Authorization", "Token ztlp_new")
+					testQueryArg(t, r, "limit", "1")
+					verified = true
+					if tt.verifyCode == 0 {
+						conn, _, err := w.(http.Hijacker).Hijack()
+						if err != nil {
+							t.Error(err)
+							return
+						}
+						conn.Close()
+						return
+					}
+					w.WriteHeader(tt.verifyCode)
+				}
+			})
+			var deleted []string
+			for _, id := range []string{"old", "new"} {
+				mux.HandleFunc("/accounts/api_tokens/"+id+"/", func(w http.ResponseWriter, r *http.Request) {
+					testMethod(t, r, "DELETE")
+					testHeader(t, r, "Authorization", "Token "+testToken)
+					deleted = append(deleted, id)
+					w.WriteHeader(http.StatusNoContent)
+				})
+			}
+
+			ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+			defer cancel()
+			var stored string
+			store := func(_ context.Context, token *APIToken) error {
+				stored = token.Secret
+				return tt.storeErr
+			}
+			token, err := RotateAPIToken(ctx, client, "old", &APITokenRequest{UserID: 5, Name: "CI"}, store)
+			switch {
+			case tt.storeErr != nil:
+				if !errors.Is(err, tt.storeErr) || verified {
+					t.Errorf("RotateAPIToken returned error %v, verified %t", err, verified)
+				}
+			case tt.keptBoth:
+				if err == nil || token == nil || token.ID != "new" || !verified {
+					t.Errorf("RotateAPIToken returned %+v, %v", token, err)
+				}
+			case tt.wantErr != nil:
+				if !errors.Is(err, tt.wantErr) {
+					t.Errorf("RotateAPIToken returned error %v, want %v", err, tt.wantErr)
+				}
+			default:
+				if err != nil || token == nil || token.ID != "new" || !verified {
+					t.Errorf("RotateAPIToken returned %+v, %v", token, err)
+				}
+			}
+			if stored != "ztlp_new" {
+				t.Errorf("RotateAPIToken stored %q", stored)
+			}
+			if !cmp.Equal(deleted, tt.wantDeleted) {
+				t.Errorf("RotateAPIToken revoked %v, want %v", deleted, tt.wantDeleted)
+			}
+		})
+	}
+}
@@ -0,0 +1,201 @@
+package goztl
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+)
+
+const auBasePath = "accounts/users/"
+
+// AccountsUsersService is an interface for interfacing with the users and service accounts
+// endpoints of the Zentral API
+type AccountsUsersService interface {
+	List(context.Context, *ListOptions) ([]User, *Response, error)
+	GetByID(context.Context, int) (*User, *Response, error)
+	GetByUsername(context.Context, string) (*User, *Response, error)
+	GetByEmail(context.Context, string) (*User, *Response, error)
+	Create(context.Context, *UserRequest) (*User, *Response, error)
+	Update(context.Context, int, *UserRequest) (*User, *Response, error)
+	Delete(context.Context, int) (*Response, error)
+}
+
+// AccountsUsersServiceOp handles communication with the users and service accounts related
+// methods of the Zentral API.
+type AccountsUsersServiceOp struct {
+	client *Client
+}
+
+var _ AccountsUsersService = &AccountsUsersServiceOp{}
+
+// User represents a Zentral user or service account
+type User struct {
+	ID               int        `json:"id"`
+	Username         string     `json:"username"`
+	Email            string     `json:"email"`
+	Description      string     `json:"description"`
+	IsActive         bool       `json:"is_active"`
+	IsServiceAccount bool       `json:"is_service_account"`
+	IsSuperuser      bool       `json:"is_superuser"`
+	IsRemote         bool       `json:"is_remote"`
+	RoleIDs          []int      `json:"roles"`
+	DateJoined       Timestamp  `json:"date_joined"`
+	LastLogin        *Timestamp `json:"last_login"`
+}
+
+func (u User) String() string {
+	return Stringify(u)
+}
+
+// UserRequest represents a request to create or update a user or a service account. The email of
+// a service account is generated by Zentral if left empty.
+type UserRequest struct {
+	Username         string `json:"username"`
+	Email            string `json:"email,omitempty"`
+	Description      string `json:"description"`
+	IsActive         bool   `json:"is_active"`
+	IsServiceAccount bool   `json:"is_service_account"`
+	RoleIDs          []int  `json:"roles"`
+}
+
+type listAUOptions struct {
+	Username string `url:"username,omitempty"`
+	Email    string `url:"email,omitempty"`
+}
+
+// List lists all the users and service accounts.
+func (s *AccountsUsersServiceOp) List(ctx context.Context, opt *ListOptions) ([]User, *Response, error) {
+	return s.list(ctx, opt, nil)
+}
+
+// GetByID retrieves a user or a service account by id.
+func (s *AccountsUsersServiceOp) GetByID(ctx context.Context, userID int) (*User, *Response, error) {
+	if userID < 1 {
+		return nil, nil, NewArgError("userID", "cannot be less than 1")
+	}
+
+	path := fmt.Sprintf("%s%d/", auBasePath, userID)
+
+	req, err := s.client.NewRequest(ctx, http.MethodGet, path, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	user := new(User)
+
+	resp, err := s.client.Do(ctx, req, user)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return user, resp, err
+}
+
+// GetByUsername retrieves a user or a service account by username.
+func (s *AccountsUsersServiceOp) GetByUsername(ctx context.Context, username string) (*User, *Response, error) {
+	if len(username) < 1 {
+		return nil, nil, NewArgError("username", "cannot be blank")
+	}
+
+	return s.get(ctx, &listAUOptions{Username: username})
+}
+
+// GetByEmail retrieves a user or a service account by email.
+func (s *AccountsUsersServiceOp) GetByEmail(ctx context.Context, email string) (*User, *Response, error) {
+	if len(email) < 1 {
+		return nil, nil, NewArgError("email", "cannot be blank")
+	}
+
+	return s.get(ctx, &listAUOptions{Email: email})
+}
+
+// Create a new user or service account.
+func (s *AccountsUsersServiceOp) Create(ctx context.Context, createRequest *UserRequest) (*User, *Response, error) {
+	if createRequest == nil {
+		return nil, nil, NewArgError("createRequest", "cannot be nil")
+	}
+
+	req, err := s.client.NewRequest(ctx, http.MethodPost, auBasePath, createRequest)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	user := new(User)
+	resp, err := s.client.Do(ctx, req, user)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return user, resp, err
+}
+
+// Update a user or a service account.
+func (s *AccountsUsersServiceOp) Update(ctx context.Context, userID int, updateRequest *UserRequest) (*User, *Response, error) {
+	if userID < 1 {
+		return nil, nil, NewArgError("userID", "cannot be less than 1")
+	}
+
+	if updateRequest == nil {
+		return nil, nil, NewArgError("updateRequest", "cannot be nil")
+	}
+
+	path := fmt.Sprintf("%s%d/", auBasePath, userID)
+
+	req, err := s.client.NewRequest(ctx, http.MethodPut, path, updateRequest)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	user := new(User)
+	resp, err := s.client.Do(ctx, req, user)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return user, resp, err
+}
+
+// Delete a user or a service account.
+func (s *AccountsUsersServiceOp) Delete(ctx context.Context, userID int) (*Response, error) {
+	if userID < 1 {
+		return nil, NewArgError("userID", "cannot be less than 1")
+	}
+
+	path := fmt.Sprintf("%s%d/", auBasePath, userID)
+
+	req, err := s.client.NewRequest(ctx, http.MethodDelete, path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.client.Do(ctx, req, nil)
+
+	return resp, err
+}
+
+// Helper method for the lookups, returning nil if no user or service account is found
+func (s *AccountsUsersServiceOp) get(ctx context.Context, auOpt *listAUOptions) (*User, *Response, error) {
+	users, resp, err := s.list(ctx, nil, auOpt)
+	if err != nil {
+		return nil, resp, err
+	}
+	if len(users) < 1 {
+		return nil, resp, nil
+	}
+
+	return &users[0], resp, err
+}
+
+// Helper method for listing users and service accounts
+func (s *AccountsUsersServiceOp) list(ctx context.Context, opt *ListOptions, auOpt *listAUOptions) ([]User, *Response, error) {
+	path := auBasePath
+	path, err := addOptions(path, opt)
+	if err != nil {
+		return nil, nil, err
+	}
+	path, err = addOptions(path, auOpt)
+	if err != nil {
+		return nil, nil, err
+	}
+	return resolveAllPages[User](ctx, s.client, path)
+}
@@ -0,0 +1,233 @@
+package goztl
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+var auJSONResponse = `
+{
+  "id": 4,
+  "username": "yolo",
+  "email": "yolo@example.com",
+  "description": "",
+  "is_active": true,
+  "is_service_account": false,
+  "is_superuser": false,
+  "is_remote": true,
+  "roles": [1, 2],
+  "date_joined": "2022-07-22T01:02:03.444444",
+  "last_login": null
+}
+`
+
+var auServiceAccountJSONResponse = `
+{
+  "id": 5,
+  "username": "ci",
+  "email": "ci@zentral-service-accounts.example.com",
+  "description": "CI pipeline",
+  "is_active": true,
+  "is_service_account": true,
+  "is_superuser": false,
+  "is_remote": false,
+  "roles": [3],
+  "date_joined": "2022-07-22T01:02:03.444444",
+  "last_login": "2022-07-22T01:02:03.444444"
+}
+`
+
+func TestAccountsUsersService_List(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/accounts/users/", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testHeader(t, r, "Accept", "application/json")
+		fmt.Fprint(w, "["+auJSONResponse+","+auServiceAccountJSONResponse+"]")
+	})
+
+	ctx := context.Background()
+	got, _, err := client.AccountsUsers.List(ctx, nil)
+	if err != nil {
+		t.Errorf("AccountsUsers.List returned error: %v", err)
+	}
+
+	want := []User{
+		{
+			ID:         4,
+			Username:   "yolo",
+			Email:      "yolo@example.com",
+			IsActive:   true,
+			IsRemote:   true,
+			RoleIDs:    []int{1, 2},
+			DateJoined: Timestamp{referenceTime},
+		},
+		{
+			ID:               5,
+			Username:         "ci",
+			Email:            "ci@zentral-service-accounts.example.com",
+			Description:      "CI pipeline",
+			IsActive:         true,
+			IsServiceAccount: true,
+			RoleIDs:          []int{3},
+			DateJoined:       Timestamp{referenceTime},
+			LastLogin:        &Timestamp{referenceTime},
+		},
+	}
+	if !cmp.Equal(got, want) {
+		t.Errorf("AccountsUsers.List returned %+v, want %+v", got, want)
+	}
+}
+
+func TestAccountsUsersService_GetByID(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/accounts/users/4/", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testHeader(t, r, "Accept", "application/json")
+		fmt.Fprint(w, auJSONResponse)
+	})
+
+	ctx := context.Background()
+	got, _, err := client.AccountsUsers.GetByID(ctx, 4)
+	if err != nil {
+		t.Errorf("AccountsUsers.GetByID returned error: %v", err)
+	}
+
+	want := &User{
+		ID:         4,
+		Username:   "yolo",
+		Email:      "yolo@example.com",
+		IsActive:   true,
+		IsRemote:   true,
+		RoleIDs:    []int{1, 2},
+		DateJoined: Timestamp{referenceTime},
+	}
+	if !cmp.Equal(got, want) {
+		t.Errorf("AccountsUsers.GetByID returned %+v, want %+v", got, want)
+	}
+}
+
+func TestAccountsUsersService_GetByUsername(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/accounts/users/", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testHeader(t, r, "Accept", "application/json")
+		if r.URL.Query().Get("username") == "ci" {
+			fmt.Fprint(w, "["+auServiceAccountJSONResponse+"]")
+		} else {
+			fmt.Fprint(w, "[]")
+		}
+	})
+
+	ctx := context.Background()
+	got, _, err := client.AccountsUsers.GetByUsername(ctx, "ci")
+	if err != nil {
+		t.Errorf("AccountsUsers.GetByUsername returned error: %v", err)
+	}
+	if got == nil || got.ID != 5 || !got.IsServiceAccount {
+		t.Errorf("AccountsUsers.GetByUsername returned %+v", got)
+	}
+
+	got, _, err = client.AccountsUsers.GetByUsername(ctx, "fomo")
+	if err != nil || got != nil {
+		t.Errorf("AccountsUsers.GetByUsername returned %+v, %v, want nil, nil", got, err)
+	}
+}
+
+func TestAccountsUsersService_GetByEmail(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/accounts/users/", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testHeader(t, r, "Accept", "application/json")
+		testQueryArg(t, r, "email", "yolo@example.com")
+		fmt.Fprint(w, "["+auJSONResponse+"]")
+	})
+
+	ctx := context.Background()
+	got, _, err := client.AccountsUsers.GetByEmail(ctx, "yolo@example.com")
+	if err != nil {
+		t.Errorf("AccountsUsers.GetByEmail returned error: %v", err)
+	}
+	if got == nil || got.ID != 4 {
+		t.Errorf("AccountsUsers.GetByEmail returned %+v", got)
+	}
+}
+
+func TestAccountsUsersService_Create(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/accounts/users/", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testHeader(t, r, "Accept", "application/json")
+		testHeader(t, r, "Content-Type", "application/json")
+		testBody(t, r, `{"username":"ci","description":"CI pipeline","is_active":true,"is_service_account":true,"roles":[3]}`+"\n")
+		fmt.Fprint(w, auServiceAccountJSONResponse)
+	})
+
+	ctx := context.Background()
+	createRequest := &UserRequest{
+		Username:         "ci",
+		Description:      "CI pipeline",
+		IsActive:         true,
+		IsServiceAccount: true,
+		RoleIDs:          []int{3},
+	}
+	got, _, err := client.AccountsUsers.Create(ctx, createRequest)
+	if err != nil {
+		t.Errorf("AccountsUsers.Create returned error: %v", err)
+	}
+	if got == nil || got.ID != 5 {
+		t.Errorf("AccountsUsers.Create returned %+v", got)
+	}
+}
+
+func TestAccountsUsersService_Update(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/accounts/users/4/", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PUT")
+		testHeader(t, r, "Accept", "application/json")
+		testHeader(t, r, "Content-Type", "application/json")
+		testBody(t, r, `{"username":"yolo","email":"yolo@example.com","description":"","is_active":false,"is_service_account":false,"roles":[]}`+"\n")
+		fmt.Fprint(w, auJSONResponse)
+	})
+
+	ctx := context.Background()
+	updateRequest := &UserRequest{Username: "yolo", Email: "yolo@example.com", RoleIDs: []int{}}
+	got, _, err := client.AccountsUsers.Update(ctx, 4, updateRequest)
+	if err != nil {
+		t.Errorf("AccountsUsers.Update returned error: %v", err)
+	}
+	if got == nil || got.ID != 4 {
+		t.Errorf("AccountsUsers.Update returned %+v", got)
+	}
+}
+
+func TestAccountsUsersService_Delete(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/accounts/users/4/", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	ctx := context.Background()
+	_, err := client.AccountsUsers.Delete(ctx, 4)
+	if err != nil {
+		t.Errorf("AccountsUsers.Delete returned error: %v", err)
+	}
+}
@@ -0,0 +1,94 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"go.yaml.in/yaml/v3"
+)
+
+// config is the configuration file of ztl:
+//
+//	default_profile: prod
+//	profiles:
+//	  prod:
+//	    url: https://zentral.example.com/api/
+//	    token: 0123456789abcdef
+//	    retries: 3
+//	  staging:
+//	    url: https://zentral-staging.example.com/api/
+//	    token_env: ZENTRAL_STAGING_TOKEN
+//	    headers:
+//	      X-Tenant: staging
+type config struct {
+	DefaultProfile string              `yaml:"default_profile"`
+	Profiles       map[string]*profile `yaml:"profiles"`
+}
+
+// profile holds the connection settings of a Zentral instance.
+type profile struct {
+	URL     string            `yaml:"url"`
+	Token   string            `yaml:"token"`
+	Headers map[string]string `yaml:"headers"`
+	Retries int               `yaml:"retries"`
+
+	// Name of the environment variable holding the token, when not in the file.
+	TokenEnv string `yaml:"token_env"`
+}
+
+// configFile returns the path of the configuration file, and tells if it was explicitly set.
+func (a *app) configFile() (path string, explicit bool) {
+	if a.configPath != "" {
+		return a.configPath, true
+	}
+	if p := a.getenv("ZTL_CONFIG"); p != "" {
+		return p, true
+	}
+	dir, err := os.UserConfigDir()
+	if err != nil {
+		return "", false
+	}
+	return filepath.Join(dir, "ztl", "config.yaml"), false
+}
+
+// loadProfile returns the selected profile of the configuration file. An empty profile is
+// returned if no profile is selected and the file does not exist.
+func (a *app) loadProfile() (*profile, error) {
+	name := a.profile
+	if name == "" {
+		name = a.getenv("ZTL_PROFILE")
+	}
+	path, explicit := a.configFile()
+	if path == "" {
+		if name != "" {
+			return nil, usageError("unknown profile %q", name)
+		}
+		return &profile{}, nil
+	}
+	b, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) && !explicit && name == "" {
+		return &profile{}, nil
+	} else if err != nil {
+		return nil, err
+	}
+	var cfg config
+	if err := yaml.Unmarshal(b, &cfg); err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	if name == "" {
+		name = cfg.DefaultProfile
+	}
+	if name == "" {
+		return &profile{}, nil
+	}
+	p, ok := cfg.Profiles[name]
+	if !ok || p == nil {
+		return nil, usageError("unknown profile %q", name)
+	}
+	if p.Token == "" && p.TokenEnv != "" {
+		p.Token = a.getenv(p.TokenEnv)
+	}
+	return p, nil
+}
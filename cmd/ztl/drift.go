@@ -0,0 +1,58 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"sort"
+)
+
+// runSchemaDrift compares the goztl structs of the services, all the services by default, with the
+// fields reported by the API, and writes the differences. The command fails if any is found.
+func (a *app) runSchemaDrift(ctx context.Context, args []string) error {
+	fs := a.flagSet("schema-drift")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	args = fs.Args()
+	if len(args)%2 != 0 {
+		return usageError("schema-drift requires <group> <service> pairs")
+	}
+	var fields []string
+	for i := 0; i < len(args); i += 2 {
+		found := false
+		for _, s := range services() {
+			if s.Group == args[i] && s.Name == args[i+1] {
+				fields = append(fields, s.Field)
+				found = true
+				break
+			}
+		}
+		if !found {
+			return usageError("unknown service %q", args[i]+" "+args[i+1])
+		}
+	}
+
+	c, err := a.client()
+	if err != nil {
+		return err
+	}
+	r, err := c.CheckSchemaDrift(ctx, fields...)
+	if err != nil {
+		return err
+	}
+	skipped := make([]string, 0, len(r.Skipped))
+	for s := range r.Skipped {
+		skipped = append(skipped, s)
+	}
+	sort.Strings(skipped)
+	for _, s := range skipped {
+		fmt.Fprintf(a.stderr, "skipped %s: %s\n", s, r.Skipped[s])
+	}
+	if err := a.output(r.Drifts); err != nil {
+		return err
+	}
+	if len(r.Drifts) > 0 {
+		return fmt.Errorf("%d schema drifts found", len(r.Drifts))
+	}
+	return nil
+}
@@ -0,0 +1,231 @@
+// Command ztl is a command line client for the Zentral API.
+//
+// Every service of the goztl client is exposed as a "<group> <service> <action>" subcommand:
+//
+//	ztl santa rules list --configuration 3
+//	ztl mdm blueprints get --name Default
+//	ztl osquery queries create -f query.json
+//	ztl raw GET inventory/meta_business_units/
+//	ztl schema-drift santa rules
+//
+// The actions are the list, get, create, update and delete operations of the services. The other
+// service methods, like the machine tag updates, the archiving, the inventory cleanup and exports,
+// the task polling or the API token rotation, are not exposed as subcommands. Their endpoints can
+// be called in raw mode:
+//
+//	ztl raw POST inventory/machines/archive/ -f serial_numbers.json
+//
+// The connection settings are read from the flags, the ZTL_URL, ZTL_TOKEN and ZTL_PROFILE
+// environment variables, and the profiles of the configuration file.
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"os/signal"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/zentralopensource/goztl"
+)
+
+// errNotFound is returned when the requested object does not exist.
+var errNotFound = errors.New("not found")
+
+// errUsage is wrapped by the command line usage errors.
+var errUsage = errors.New("usage error")
+
+func usageError(format string, a ...interface{}) error {
+	return fmt.Errorf("%w: %s", errUsage, fmt.Sprintf(format, a...))
+}
+
+// app holds the global options and the I/O of a command line invocation.
+type app struct {
+	stdin  io.Reader
+	stdout io.Writer
+	stderr io.Writer
+	getenv func(string) string
+
+	// global options
+	configPath string
+	profile    string
+	url        string
+	token      string
+	retries    optionalInt
+	format     string
+
+	// HTTP client, the default client if nil
+	httpClient *http.Client
+}
+
+func main() {
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer stop()
+	a := &app{stdin: os.Stdin, stdout: os.Stdout, stderr: os.Stderr, getenv: os.Getenv}
+	os.Exit(a.run(ctx, os.Args[1:]))
+}
+
+// run runs the command line and returns the exit code.
+func (a *app) run(ctx context.Context, args []string) int {
+	err := a.dispatch(ctx, args)
+	switch {
+	case err == nil:
+		return 0
+	case errors.Is(err, flag.ErrHelp):
+		return 0
+	case errors.Is(err, errUsage):
+		fmt.Fprintf(a.stderr, "ztl: %v\n", err)
+		return 2
+	}
+	fmt.Fprintf(a.stderr, "ztl: %v\n", err)
+	return 1
+}
+
+// optionalInt is an integer flag that tells if it was set.
+type optionalInt struct {
+	value int
+	set   bool
+}
+
+func (i *optionalInt) String() string {
+	if i == nil || !i.set {
+		return ""
+	}
+	return strconv.Itoa(i.value)
+}
+
+func (i *optionalInt) Set(s string) error {
+	v, err := strconv.Atoi(s)
+	if err != nil {
+		return err
+	}
+	i.value, i.set = v, true
+	return nil
+}
+
+// flagSet returns a flag set with the global options, that can be set before or after the
+// subcommands.
+func (a *app) flagSet(name string) *flag.FlagSet {
+	fs := flag.NewFlagSet(name, flag.ContinueOnError)
+	fs.SetOutput(a.stderr)
+	fs.StringVar(&a.configPath, "config", a.configPath, "configuration `file`, defaults to $ZTL_CONFIG or ztl/config.yaml in the user configuration directory")
+	fs.StringVar(&a.profile, "profile", a.profile, "configuration profile, defaults to $ZTL_PROFILE or the default profile")
+	fs.StringVar(&a.url, "url", a.url, "base URL of the Zentral API, defaults to $ZTL_URL")
+	fs.StringVar(&a.token, "token", a.token, "Zentral API token, defaults to $ZTL_TOKEN")
+	fs.Var(&a.retries, "retries", "maximum `number` of retries of the failed idempotent requests")
+	fs.StringVar(&a.format, "o", a.format, "output `format`: json, yaml or table")
+	return fs
+}
+
+func (a *app) dispatch(ctx context.Context, args []string) error {
+	a.format = "table"
+	fs := a.flagSet("ztl")
+	fs.Usage = func() { a.usage(fs) }
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if err := checkFormat(a.format); err != nil {
+		return err
+	}
+	args = fs.Args()
+	if len(args) == 0 {
+		a.usage(fs)
+		return usageError("missing command")
+	}
+	if args[0] == "raw" {
+		return a.runRaw(ctx, args[1:])
+	}
+	if args[0] == "schema-drift" {
+		return a.runSchemaDrift(ctx, args[1:])
+	}
+	if len(args) < 2 {
+		return usageError("unknown command %q", strings.Join(args, " "))
+	}
+	for _, s := range services() {
+		if s.Group != args[0] || s.Name != args[1] {
+			continue
+		}
+		if len(args) < 3 {
+			return usageError("missing action, one of: %s", strings.Join(s.actions(), ", "))
+		}
+		for _, action := range s.actions() {
+			if action == args[2] {
+				return a.runService(ctx, s, action, args[3:])
+			}
+		}
+		return usageError("unknown action %q, one of: %s", args[2], strings.Join(s.actions(), ", "))
+	}
+	return usageError("unknown command %q", strings.Join(args[:2], " "))
+}
+
+func (a *app) usage(fs *flag.FlagSet) {
+	fmt.Fprint(a.stderr, "Usage: ztl [flags] <group> <service> <action> [flags]\n"+
+		"       ztl [flags] raw <method> <path> [-f file]\n"+
+		"       ztl [flags] schema-drift [<group> <service>]...\n\nFlags:\n")
+	fs.PrintDefaults()
+	fmt.Fprint(a.stderr, "\nServices:\n"+
+		"  Only the list, get, create, update and delete operations are available, use raw for\n"+
+		"  the other endpoints.\n\n")
+	tw := tabwriter.NewWriter(a.stderr, 0, 0, 2, ' ', 0)
+	for _, s := range services() {
+		fmt.Fprintf(tw, "  %s %s\t%s\n", s.Group, s.Name, strings.Join(s.actions(), ", "))
+	}
+	tw.Flush()
+}
+
+// client returns a client configured with the global options, the environment and the profile.
+func (a *app) client() (*goztl.Client, error) {
+	p, err := a.loadProfile()
+	if err != nil {
+		return nil, err
+	}
+	for _, o := range []struct {
+		dst  *string
+		flag string
+		env  string
+	}{
+		{&p.URL, a.url, "ZTL_URL"},
+		{&p.Token, a.token, "ZTL_TOKEN"},
+	} {
+		if o.flag != "" {
+			*o.dst = o.flag
+		} else if v := a.getenv(o.env); v != "" {
+			*o.dst = v
+		}
+	}
+	if a.retries.set {
+		p.Retries = a.retries.value
+	}
+	if p.URL == "" {
+		return nil, usageError("missing API URL, use --url, $ZTL_URL or a profile")
+	}
+	if p.Token == "" {
+		return nil, usageError("missing API token, use --token, $ZTL_TOKEN or a profile")
+	}
+	if !strings.HasSuffix(p.URL, "/") {
+		p.URL += "/"
+	}
+	opts := []goztl.ClientOpt{
+		goztl.SetUserAgent("ztl"),
+		goztl.SetRequestHeaders(p.Headers),
+	}
+	if p.Retries > 0 {
+		opts = append(opts, goztl.SetRetryPolicy(goztl.RetryPolicy{MaxRetries: p.Retries, MinBackoff: time.Second}))
+	}
+	return goztl.NewClient(a.httpClient, p.URL, p.Token, opts...)
+}
+
+// readFile reads a file, or stdin for "-".
+func (a *app) readFile(name string) ([]byte, error) {
+	if name == "-" {
+		return io.ReadAll(a.stdin)
+	}
+	return os.ReadFile(name)
+}
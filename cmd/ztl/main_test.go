@@ -0,0 +1,305 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+const testToken = "TOKEN"
+
+// setup returns a test server and a function running ztl against it.
+func setup(t *testing.T) (mux *http.ServeMux, run func(stdin string, args ...string) (int, string, string)) {
+	t.Helper()
+	mux = http.NewServeMux()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if got := r.Header.Get("Authorization"); got != "Token "+testToken {
+			t.Errorf("Authorization header %q", got)
+		}
+		mux.ServeHTTP(w, r)
+	}))
+	t.Cleanup(server.Close)
+	env := map[string]string{"ZTL_URL": server.URL + "/api", "ZTL_TOKEN": testToken}
+	run = func(stdin string, args ...string) (int, string, string) {
+		var stdout, stderr bytes.Buffer
+		a := &app{
+			stdin:  strings.NewReader(stdin),
+			stdout: &stdout,
+			stderr: &stderr,
+			getenv: func(k string) string { return env[k] },
+		}
+		code := a.run(context.Background(), args)
+		return code, stdout.String(), stderr.String()
+	}
+	return mux, run
+}
+
+func testRun(t *testing.T, code int, stdout, stderr, want string) {
+	t.Helper()
+	if code != 0 {
+		t.Fatalf("ztl returned %d: %s", code, stderr)
+	}
+	if stdout != want {
+		t.Errorf("ztl output\n%s\nwant\n%s", stdout, want)
+	}
+}
+
+func TestListFilter(t *testing.T) {
+	mux, run := setup(t)
+	mux.HandleFunc("/api/santa/rules/", func(w http.ResponseWriter, r *http.Request) {
+		if got := r.URL.Query().Get("configuration_id"); got != "3" {
+			t.Errorf("configuration_id %q, want 3", got)
+		}
+		fmt.Fprint(w, `[{"id": 4, "configuration": 3, "policy": 1, "target_type": "BINARY",
+		"target_identifier": "yolo", "tags": [1, 2], "version": 1}]`)
+	})
+
+	code, stdout, stderr := run("", "santa", "rules", "list", "--configuration", "3", "-o", "json")
+	testRun(t, code, stdout, stderr, `[
+  {
+    "id": 4,
+    "configuration": 3,
+    "policy": 1,
+    "cel_expr": "",
+    "target_type": "BINARY",
+    "target_identifier": "yolo",
+    "description": "",
+    "custom_msg": "",
+    "custom_url": "",
+    "ruleset": null,
+    "primary_users": null,
+    "excluded_primary_users": null,
+    "serial_numbers": null,
+    "excluded_serial_numbers": null,
+    "tags": [
+      1,
+      2
+    ],
+    "excluded_tags": null,
+    "version": 1,
+    "created_at": "0001-01-01T00:00:00Z",
+    "updated_at": "0001-01-01T00:00:00Z"
+  }
+]
+`)
+}
+
+func TestGetByNameTable(t *testing.T) {
+	mux, run := setup(t)
+	mux.HandleFunc("/api/inventory/meta_business_units/", func(w http.ResponseWriter, r *http.Request) {
+		if got := r.URL.Query().Get("name"); got != "Default" {
+			t.Errorf("name %q, want Default", got)
+		}
+		fmt.Fprint(w, `[{"id": 1, "name": "Default", "api_enrollment_enabled": true}]`)
+	})
+
+	code, stdout, stderr := run("", "-o", "table", "inventory", "meta-business-units", "get", "--name", "Default")
+	testRun(t, code, stdout, stderr, "ID  NAME     API_ENROLLMENT_ENABLED  CREATED_AT            UPDATED_AT\n"+
+		"1   Default  true                    0001-01-01T00:00:00Z  0001-01-01T00:00:00Z\n")
+}
+
+func TestGetNotFound(t *testing.T) {
+	mux, run := setup(t)
+	mux.HandleFunc("/api/mdm/blueprints/", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `[]`)
+	})
+
+	code, _, stderr := run("", "mdm", "blueprints", "get", "--name", "Default")
+	if code != 1 || stderr != "ztl: not found\n" {
+		t.Errorf("ztl returned %d: %q", code, stderr)
+	}
+}
+
+func TestCreateYAML(t *testing.T) {
+	mux, run := setup(t)
+	mux.HandleFunc("/api/osquery/queries/", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			t.Errorf("method %s, want POST", r.Method)
+		}
+		b, _ := io.ReadAll(r.Body)
+		want := `{"name":"Uptime","sql":"SELECT * FROM uptime;","platforms":["darwin"],"minimum_osquery_version":null,` +
+			`"description":"","value":"","compliance_check_enabled":false,"tag":null,"scheduling":null}` + "\n"
+		if got := string(b); got != want {
+			t.Errorf("request body %s, want %s", got, want)
+		}
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprint(w, `{"id": 7, "name": "Uptime", "sql": "SELECT * FROM uptime;", "platforms": ["darwin"], "version": 1}`)
+	})
+
+	path := filepath.Join(t.TempDir(), "query.yaml")
+	if err := os.WriteFile(path, []byte("name: Uptime\nsql: SELECT * FROM uptime;\nplatforms: [darwin]\n"), 0600); err != nil {
+		t.Fatal(err)
+	}
+	code, stdout, stderr := run("", "osquery", "queries", "create", "-f", path, "-o", "yaml")
+	if code != 0 {
+		t.Fatalf("ztl returned %d: %s", code, stderr)
+	}
+	for _, line := range []string{"id: 7\n", "name: Uptime\n", "platforms:\n  - darwin\n"} {
+		if !strings.Contains(stdout, line) {
+			t.Errorf("ztl output %q does not contain %q", stdout, line)
+		}
+	}
+
+	// unknown fields are rejected
+	code, _, stderr = run(`{"name": "Uptime", "zorg": 1}`, "osquery", "queries", "create", "-f", "-")
+	if code != 1 || !strings.Contains(stderr, `unknown field "zorg"`) {
+		t.Errorf("ztl returned %d: %q", code, stderr)
+	}
+}
+
+func TestUpdateDelete(t *testing.T) {
+	mux, run := setup(t)
+	var methods []string
+	mux.HandleFunc("/api/inventory/tags/5/", func(w http.ResponseWriter, r *http.Request) {
+		methods = append(methods, r.Method)
+		if r.Method == http.MethodDelete {
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+		fmt.Fprint(w, `{"id": 5, "name": "Fomo", "color": "00ff00"}`)
+	})
+
+	code, stdout, stderr := run(`{"name": "Fomo", "color": "00ff00"}`, "inventory", "tags", "update", "--id", "5", "-f", "-")
+	testRun(t, code, stdout, stderr, "ID  TAXONOMY  META_BUSINESS_UNIT  NAME  SLUG  COLOR\n"+
+		"5                                 Fomo        00ff00\n")
+	code, stdout, stderr = run("", "inventory", "tags", "delete", "--id", "5")
+	testRun(t, code, stdout, stderr, "")
+	if got := strings.Join(methods, ","); got != "PUT,DELETE" {
+		t.Errorf("methods %s, want PUT,DELETE", got)
+	}
+}
+
+func TestRawProfile(t *testing.T) {
+	mux, run := setup(t)
+	var requests int
+	mux.HandleFunc("/api/inventory/machines/", func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		if got := r.Header.Get("X-Tenant"); got != "yolo" {
+			t.Errorf("X-Tenant header %q, want yolo", got)
+		}
+		if requests == 1 {
+			w.Header().Set("Retry-After", "0")
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		fmt.Fprint(w, `{"count": 1, "results": [{"serial_number": "0123456789", "tags": []}]}`)
+	})
+
+	// the URL and the token of the environment take precedence over the profile
+	dir := t.TempDir()
+	config := filepath.Join(dir, "config.yaml")
+	if err := os.WriteFile(config, []byte(`
+default_profile: yolo
+profiles:
+  yolo:
+    url: https://zentral.example.com/api/
+    token: fomo
+    headers:
+      X-Tenant: yolo
+    retries: 1
+`), 0600); err != nil {
+		t.Fatal(err)
+	}
+	code, stdout, stderr := run("", "--config", config, "raw", "GET", "/inventory/machines/")
+	testRun(t, code, stdout, stderr, "SERIAL_NUMBER\n0123456789\n")
+	if requests != 2 {
+		t.Errorf("%d requests, want 2", requests)
+	}
+
+	code, _, stderr = run("", "--config", config, "--profile", "zorg", "raw", "GET", "/")
+	if code != 2 || stderr != "ztl: usage error: unknown profile \"zorg\"\n" {
+		t.Errorf("ztl returned %d: %q", code, stderr)
+	}
+}
+
+func TestSchemaDrift(t *testing.T) {
+	mux, run := setup(t)
+	mux.HandleFunc("/api/inventory/tags/", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodOptions {
+			t.Errorf("method %s, want OPTIONS", r.Method)
+		}
+		fmt.Fprint(w, `{"name": "Tag List", "actions": {"POST": {
+  "id": {"type": "integer", "read_only": true},
+  "taxonomy": {"type": "field"},
+  "meta_business_unit": {"type": "field"},
+  "name": {"type": "string", "required": true},
+  "slug": {"type": "slug", "read_only": true},
+  "color": {"type": "string"},
+  "description": {"type": "string"}}}}`)
+	})
+	mux.HandleFunc("/api/inventory/taxonomies/", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"name": "Taxonomy List", "actions": {"POST": {
+  "id": {"type": "integer", "read_only": true},
+  "meta_business_unit": {"type": "field"},
+  "name": {"type": "string", "required": true},
+  "created_at": {"type": "datetime", "read_only": true},
+  "updated_at": {"type": "datetime", "read_only": true}}}}`)
+	})
+
+	code, stdout, stderr := run("", "schema-drift", "inventory", "taxonomies")
+	testRun(t, code, stdout, stderr, "SERVICE  PATH  TYPE  FIELD  KIND\n")
+
+	code, stdout, stderr = run("", "-o", "json", "schema-drift", "inventory", "tags")
+	if code != 1 || !strings.Contains(stdout, `"kind": "missing"`) || !strings.Contains(stderr, "schema drifts found") {
+		t.Errorf("ztl schema-drift returned %d: %s%s", code, stdout, stderr)
+	}
+}
+
+func TestUsageErrors(t *testing.T) {
+	_, run := setup(t)
+	for _, tt := range []struct {
+		args []string
+		want string
+	}{
+		{[]string{"santa", "yolo", "list"}, `unknown command "santa yolo"`},
+		{[]string{"santa", "rules", "fomo"}, `unknown action "fomo", one of: list, get, create, update, delete`},
+		{[]string{"santa", "rules", "get"}, "get requires one of --configuration, --id, --target-identifier, --target-type"},
+		{[]string{"santa", "rules", "list", "--configuration", "1", "--target-type", "BINARY"}, "only one of --configuration, --target-type can be used"},
+		{[]string{"-o", "xml", "santa", "rules", "list"}, `unknown output format "xml"`},
+	} {
+		code, _, stderr := run("", tt.args...)
+		if code != 2 || !strings.Contains(stderr, tt.want) {
+			t.Errorf("ztl %s returned %d: %q, want %q", strings.Join(tt.args, " "), code, stderr, tt.want)
+		}
+	}
+}
+
+func TestServices(t *testing.T) {
+	seen := make(map[string]bool)
+	for _, s := range services() {
+		name := s.Group + " " + s.Name
+		if seen[name] {
+			t.Errorf("duplicated command %q", name)
+		}
+		seen[name] = true
+		if len(s.actions()) == 0 {
+			t.Errorf("command %q has no actions", name)
+		}
+	}
+	for _, name := range []string{"santa rules", "mdm blueprints", "osquery queries", "inventory tags", "realms realms", "tasks results"} {
+		if !seen[name] {
+			t.Errorf("missing command %q", name)
+		}
+	}
+}
+
+func TestKebab(t *testing.T) {
+	for s, want := range map[string]string{
+		"ConfigurationID": "configuration-id",
+		"MDMInfoID":       "mdm-info-id",
+		"DEPEnrollments":  "dep-enrollments",
+		"UUID":            "uuid",
+		"Name":            "name",
+	} {
+		if got := kebab(s); got != want {
+			t.Errorf("kebab(%q) = %q, want %q", s, got, want)
+		}
+	}
+}
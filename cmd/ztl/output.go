@@ -0,0 +1,209 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"reflect"
+	"sort"
+	"strings"
+	"text/tabwriter"
+
+	"go.yaml.in/yaml/v3"
+)
+
+// checkFormat checks the output format.
+func checkFormat(format string) error {
+	switch format {
+	case "json", "yaml", "table":
+		return nil
+	}
+	return usageError("unknown output format %q, one of: json, yaml, table", format)
+}
+
+// output writes a value returned by a service method in the output format.
+func (a *app) output(v interface{}) error {
+	rv := reflect.ValueOf(v)
+	if rv.Kind() == reflect.Slice && rv.IsNil() {
+		v = reflect.MakeSlice(rv.Type(), 0, 0).Interface()
+	}
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	return a.outputJSON(b, reflect.TypeOf(v))
+}
+
+// outputJSON writes a JSON document in the output format. The columns of the tables follow the
+// order of the fields of t, if it is a struct or a list of structs.
+func (a *app) outputJSON(b []byte, t reflect.Type) error {
+	var doc interface{}
+	d := json.NewDecoder(bytes.NewReader(b))
+	d.UseNumber()
+	if err := d.Decode(&doc); err != nil {
+		return err
+	}
+	switch a.format {
+	case "yaml":
+		e := yaml.NewEncoder(a.stdout)
+		e.SetIndent(2)
+		if err := e.Encode(yamlValue(doc)); err != nil {
+			return err
+		}
+		return e.Close()
+	case "table":
+		if rows, ok := tableRows(doc); ok {
+			return writeTable(a.stdout, rows, columns(t, rows))
+		}
+	}
+	var buf bytes.Buffer
+	if err := json.Indent(&buf, b, "", "  "); err != nil {
+		return err
+	}
+	buf.WriteByte('\n')
+	_, err := buf.WriteTo(a.stdout)
+	return err
+}
+
+// yamlValue converts the JSON numbers of a decoded JSON document for the YAML encoder.
+func yamlValue(v interface{}) interface{} {
+	switch v := v.(type) {
+	case json.Number:
+		if i, err := v.Int64(); err == nil {
+			return i
+		}
+		f, _ := v.Float64()
+		return f
+	case map[string]interface{}:
+		for k, e := range v {
+			v[k] = yamlValue(e)
+		}
+	case []interface{}:
+		for i, e := range v {
+			v[i] = yamlValue(e)
+		}
+	}
+	return v
+}
+
+// toJSON converts a JSON or YAML document to JSON.
+func toJSON(b []byte) ([]byte, error) {
+	if json.Valid(b) {
+		return b, nil
+	}
+	var doc interface{}
+	if err := yaml.Unmarshal(b, &doc); err != nil {
+		return nil, err
+	}
+	return json.Marshal(doc)
+}
+
+// tableRows returns the rows of a table for a decoded JSON object, list of objects, or paginated
+// results.
+func tableRows(doc interface{}) ([]map[string]interface{}, bool) {
+	switch v := doc.(type) {
+	case map[string]interface{}:
+		if results, ok := v["results"].([]interface{}); ok {
+			if _, ok := v["count"]; ok {
+				return tableRows(results)
+			}
+		}
+		return []map[string]interface{}{v}, true
+	case []interface{}:
+		rows := make([]map[string]interface{}, 0, len(v))
+		for _, e := range v {
+			row, ok := e.(map[string]interface{})
+			if !ok {
+				return nil, false
+			}
+			rows = append(rows, row)
+		}
+		return rows, true
+	}
+	return nil, false
+}
+
+// columns returns the columns of the scalar values of the rows, in the field order of t, or in
+// alphabetical order.
+func columns(t reflect.Type, rows []map[string]interface{}) []string {
+	var names []string
+	for t != nil && (t.Kind() == reflect.Ptr || t.Kind() == reflect.Slice) {
+		t = t.Elem()
+	}
+	if t != nil && t.Kind() == reflect.Struct {
+		names = structFields(t)
+	} else {
+		seen := make(map[string]bool)
+		for _, row := range rows {
+			for k := range row {
+				if !seen[k] {
+					seen[k] = true
+					names = append(names, k)
+				}
+			}
+		}
+		sort.Strings(names)
+	}
+	var cols []string
+	for _, name := range names {
+		scalar := true
+		for _, row := range rows {
+			switch row[name].(type) {
+			case map[string]interface{}, []interface{}:
+				scalar = false
+			}
+		}
+		if scalar {
+			cols = append(cols, name)
+		}
+	}
+	return cols
+}
+
+// structFields returns the JSON names of the fields of a struct, the fields of the embedded
+// structs included.
+func structFields(t reflect.Type) []string {
+	var names []string
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		name, _, _ := strings.Cut(f.Tag.Get("json"), ",")
+		if name == "-" || (!f.IsExported() && !f.Anonymous) {
+			continue
+		}
+		if f.Anonymous && name == "" {
+			ft := f.Type
+			if ft.Kind() == reflect.Ptr {
+				ft = ft.Elem()
+			}
+			if ft.Kind() == reflect.Struct {
+				names = append(names, structFields(ft)...)
+				continue
+			}
+		}
+		if name == "" {
+			name = f.Name
+		}
+		names = append(names, name)
+	}
+	return names
+}
+
+func writeTable(w io.Writer, rows []map[string]interface{}, cols []string) error {
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	header := make([]string, len(cols))
+	for i, c := range cols {
+		header[i] = strings.ToUpper(c)
+	}
+	fmt.Fprintln(tw, strings.Join(header, "\t"))
+	for _, row := range rows {
+		cells := make([]string, len(cols))
+		for i, c := range cols {
+			if v := row[c]; v != nil {
+				cells[i] = strings.NewReplacer("\t", " ", "\n", " ").Replace(fmt.Sprint(v))
+			}
+		}
+		fmt.Fprintln(tw, strings.Join(cells, "\t"))
+	}
+	return tw.Flush()
+}
@@ -0,0 +1,65 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+// runRaw sends a request to an arbitrary path of the API, relative to the base URL, and writes
+// the response.
+func (a *app) runRaw(ctx context.Context, args []string) error {
+	if len(args) < 2 {
+		return usageError("raw requires a method and a path")
+	}
+	method, path := strings.ToUpper(args[0]), strings.TrimPrefix(args[1], "/")
+	fs := a.flagSet("raw")
+	var file string
+	fs.StringVar(&file, "f", "", "JSON or YAML `file` of the request body, - for stdin")
+	if err := fs.Parse(args[2:]); err != nil {
+		return err
+	}
+	if fs.NArg() > 0 {
+		return usageError("unexpected arguments: %s", strings.Join(fs.Args(), " "))
+	}
+
+	c, err := a.client()
+	if err != nil {
+		return err
+	}
+	var body interface{}
+	if file != "" {
+		switch method {
+		case http.MethodGet, http.MethodHead, http.MethodOptions:
+			return usageError("%s requests have no body", method)
+		}
+		b, err := a.readFile(file)
+		if err != nil {
+			return err
+		}
+		if b, err = toJSON(b); err != nil {
+			return fmt.Errorf("%s: %w", file, err)
+		}
+		body = json.RawMessage(b)
+	}
+	req, err := c.NewRequest(ctx, method, path, body)
+	if err != nil {
+		return err
+	}
+	var buf bytes.Buffer
+	if _, err := c.Do(ctx, req, &buf); err != nil {
+		return err
+	}
+	b := bytes.TrimSpace(buf.Bytes())
+	if len(b) == 0 {
+		return nil
+	}
+	if !json.Valid(b) {
+		_, err := a.stdout.Write(append(b, '\n'))
+		return err
+	}
+	return a.outputJSON(b, nil)
+}
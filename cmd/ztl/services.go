@@ -0,0 +1,357 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/zentralopensource/goztl"
+	"github.com/zentralopensource/goztl/tenant"
+)
+
+// service is a service of the client, exposed as a "<group> <name>" command.
+type service struct {
+	Group string
+	Name  string
+	Field string
+}
+
+// selector is a single argument GetByXxx method, exposed as a --xxx flag.
+type selector struct {
+	Flag   string
+	Method string
+	Arg    reflect.Type
+	List   bool
+}
+
+// servicePrefixes maps the prefixes of the client service fields to the command groups. The
+// services without a prefix belong to the inventory group.
+var servicePrefixes = []struct{ prefix, group string }{
+	{"Accounts", "accounts"},
+	{"GWS", "gws"},
+	{"Inventory", "inventory"},
+	{"MDM", "mdm"},
+	{"Monolith", "monolith"},
+	{"Munki", "munki"},
+	{"Osquery", "osquery"},
+	{"Probes", "probes"},
+	{"Realms", "realms"},
+	{"Roles", "accounts"},
+	{"Santa", "santa"},
+	{"Stores", "stores"},
+	{"Tasks", "tasks"},
+	{"Turbo", "turbo"},
+}
+
+// serviceNames maps the client service fields to the command names that cannot be derived from
+// the fields, because the prefix is the whole field.
+var serviceNames = map[string]string{
+	"Tasks": "results",
+}
+
+// services returns the services of the client, sorted by group and name. The names of the tenant
+// kinds are used when available, the other names are derived from the client service fields.
+func services() []service {
+	kindNames := make(map[string]string)
+	for _, k := range tenant.Kinds() {
+		kindNames[k.Service] = k.Name
+	}
+	var svcs []service
+	t := reflect.TypeOf(goztl.Client{})
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		if !f.IsExported() || f.Type.Kind() != reflect.Interface || !strings.HasSuffix(f.Type.Name(), "Service") {
+			continue
+		}
+		svc := service{Field: f.Name}
+		if name, ok := kindNames[f.Name]; ok {
+			svc.Group, svc.Name, _ = strings.Cut(name, "/")
+			svc.Name = strings.ReplaceAll(svc.Name, "_", "-")
+		} else {
+			svc.Group = "inventory"
+			rest := f.Name
+			for _, p := range servicePrefixes {
+				if r, ok := strings.CutPrefix(f.Name, p.prefix); ok {
+					svc.Group, rest = p.group, r
+					break
+				}
+			}
+			if name, ok := serviceNames[f.Name]; ok {
+				rest = name
+			} else if rest == "" {
+				rest = f.Name
+			}
+			svc.Name = kebab(rest)
+		}
+		svcs = append(svcs, svc)
+	}
+	sort.Slice(svcs, func(i, j int) bool {
+		if svcs[i].Group != svcs[j].Group {
+			return svcs[i].Group < svcs[j].Group
+		}
+		return svcs[i].Name < svcs[j].Name
+	})
+	return svcs
+}
+
+// kebab converts a CamelCase name to kebab case, keeping the acronyms together.
+func kebab(s string) string {
+	rs := []rune(s)
+	var b strings.Builder
+	for i, r := range rs {
+		if i > 0 && unicode.IsUpper(r) &&
+			(unicode.IsLower(rs[i-1]) || (i+1 < len(rs) && unicode.IsLower(rs[i+1]))) {
+			b.WriteByte('-')
+		}
+		b.WriteRune(unicode.ToLower(r))
+	}
+	return b.String()
+}
+
+// value returns the service of the client.
+func (s service) value(c *goztl.Client) reflect.Value {
+	return reflect.ValueOf(c).Elem().FieldByName(s.Field)
+}
+
+func (s service) methodType(name string) (reflect.Method, bool) {
+	t, _ := reflect.TypeOf(goztl.Client{}).FieldByName(s.Field)
+	return t.Type.MethodByName(name)
+}
+
+// actionNames are the service actions, in help order.
+var actionNames = []string{"list", "get", "create", "update", "delete"}
+
+// actionMethods maps the service actions to the service methods required to support them. The get
+// action is supported by the services with GetByXxx methods.
+var actionMethods = map[string]string{
+	"list":   "List",
+	"get":    "GetByID",
+	"create": "Create",
+	"update": "Update",
+	"delete": "Delete",
+}
+
+// actions returns the actions supported by the service.
+func (s service) actions() []string {
+	var actions []string
+	for _, a := range actionNames {
+		_, ok := s.methodType(actionMethods[a])
+		if a == "get" {
+			ok = len(s.selectors()) > 0
+		}
+		if ok {
+			actions = append(actions, a)
+		}
+	}
+	return actions
+}
+
+// selectors returns the single argument GetByXxx methods of the service, GetByID included.
+func (s service) selectors() []selector {
+	var sels []selector
+	t, _ := reflect.TypeOf(goztl.Client{}).FieldByName(s.Field)
+	for i := 0; i < t.Type.NumMethod(); i++ {
+		m := t.Type.Method(i)
+		by, ok := strings.CutPrefix(m.Name, "GetBy")
+		if !ok || m.Type.NumIn() != 2 || strings.Contains(by, "And") {
+			continue
+		}
+		flagName := kebab(by)
+		if flagName != "id" {
+			flagName = strings.TrimSuffix(flagName, "-id")
+		}
+		sels = append(sels, selector{
+			Flag:   flagName,
+			Method: m.Name,
+			Arg:    m.Type.In(1),
+			List:   m.Type.Out(0).Kind() == reflect.Slice,
+		})
+	}
+	return sels
+}
+
+// parseArg parses a command line argument for a method argument of type t.
+func parseArg(name, s string, t reflect.Type) (reflect.Value, error) {
+	switch t.Kind() {
+	case reflect.Int:
+		i, err := strconv.Atoi(s)
+		if err != nil {
+			return reflect.Value{}, fmt.Errorf("invalid --%s value %q: not an integer", name, s)
+		}
+		return reflect.ValueOf(i), nil
+	case reflect.String:
+		return reflect.ValueOf(s), nil
+	}
+	return reflect.Value{}, fmt.Errorf("unsupported --%s argument type %s", name, t)
+}
+
+// call calls a service method and returns its first result, nil for a nil pointer or a
+// *goztl.Response.
+func (s service) call(ctx context.Context, c *goztl.Client, method string, args ...reflect.Value) (interface{}, error) {
+	m := s.value(c).MethodByName(method)
+	out := m.Call(append([]reflect.Value{reflect.ValueOf(ctx)}, args...))
+	if err, _ := out[len(out)-1].Interface().(error); err != nil {
+		return nil, err
+	}
+	v := out[0]
+	if _, ok := v.Interface().(*goztl.Response); ok || (v.Kind() == reflect.Ptr && v.IsNil()) {
+		return nil, nil
+	}
+	return v.Interface(), nil
+}
+
+// runService runs an action of a service.
+func (a *app) runService(ctx context.Context, s service, action string, args []string) error {
+	fs := a.flagSet(fmt.Sprintf("%s %s %s", s.Group, s.Name, action))
+	sels := s.selectors()
+	selected := make(map[string]*string)
+	var file string
+	switch action {
+	case "list", "get":
+		for _, sel := range sels {
+			if action == "list" && (!sel.List || sel.Flag == "id") {
+				continue
+			}
+			if _, ok := selected[sel.Flag]; !ok {
+				selected[sel.Flag] = fs.String(sel.Flag, "", "select the objects by "+sel.Flag)
+			}
+		}
+	case "create", "update", "delete":
+		if action != "create" {
+			selected["id"] = fs.String("id", "", "ID of the object")
+		}
+		if action != "delete" {
+			fs.StringVar(&file, "f", "", "JSON or YAML `file` of the request, - for stdin")
+		}
+	}
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if fs.NArg() > 0 {
+		return usageError("unexpected arguments: %s", strings.Join(fs.Args(), " "))
+	}
+	var set []string
+	fs.Visit(func(f *flag.Flag) {
+		if _, ok := selected[f.Name]; ok {
+			set = append(set, f.Name)
+		}
+	})
+	if len(set) > 1 {
+		return usageError("only one of --%s can be used", strings.Join(set, ", --"))
+	}
+	if action != "list" && action != "create" && len(set) == 0 {
+		var flags []string
+		for name := range selected {
+			flags = append(flags, "--"+name)
+		}
+		sort.Strings(flags)
+		return usageError("%s requires one of %s", action, strings.Join(flags, ", "))
+	}
+	if (action == "create" || action == "update") && file == "" {
+		return usageError("%s requires -f", action)
+	}
+
+	c, err := a.client()
+	if err != nil {
+		return err
+	}
+
+	var idArg reflect.Value
+	if action == "update" || action == "delete" {
+		m, _ := s.methodType(actionMethods[action])
+		if idArg, err = parseArg("id", *selected["id"], m.Type.In(1)); err != nil {
+			return err
+		}
+	}
+
+	var v interface{}
+	switch action {
+	case "list":
+		if len(set) == 0 {
+			v, err = s.call(ctx, c, "List", reflect.ValueOf((*goztl.ListOptions)(nil)))
+			break
+		}
+		v, err = s.callSelector(ctx, c, sels, set[0], *selected[set[0]], true)
+	case "get":
+		v, err = s.callSelector(ctx, c, sels, set[0], *selected[set[0]], false)
+		if err == nil {
+			v, err = single(v)
+		}
+	case "create", "update":
+		m, _ := s.methodType(actionMethods[action])
+		reqType := m.Type.In(m.Type.NumIn() - 1)
+		req := reflect.New(reqType.Elem())
+		if err = a.readRequest(file, req.Interface()); err != nil {
+			return err
+		}
+		if action == "create" {
+			v, err = s.call(ctx, c, "Create", req)
+		} else {
+			v, err = s.call(ctx, c, "Update", idArg, req)
+		}
+	case "delete":
+		_, err = s.call(ctx, c, "Delete", idArg)
+		return err
+	}
+	if err != nil {
+		return err
+	}
+	if v == nil {
+		return errNotFound
+	}
+	return a.output(v)
+}
+
+// callSelector calls the GetByXxx methods of a selector flag.
+func (s service) callSelector(ctx context.Context, c *goztl.Client, sels []selector, name, value string, list bool) (interface{}, error) {
+	for _, sel := range sels {
+		if sel.Flag != name || (list && !sel.List) {
+			continue
+		}
+		arg, err := parseArg(name, value, sel.Arg)
+		if err != nil {
+			return nil, err
+		}
+		return s.call(ctx, c, sel.Method, arg)
+	}
+	return nil, usageError("unknown flag --%s", name)
+}
+
+// single returns the only element of a list, or the value if it is not a list.
+func single(v interface{}) (interface{}, error) {
+	rv := reflect.ValueOf(v)
+	if v == nil || rv.Kind() != reflect.Slice {
+		return v, nil
+	}
+	switch rv.Len() {
+	case 0:
+		return nil, nil
+	case 1:
+		return rv.Index(0).Interface(), nil
+	}
+	return nil, fmt.Errorf("%d objects found, use list", rv.Len())
+}
+
+// readRequest decodes a JSON or YAML request file. The unknown fields are rejected.
+func (a *app) readRequest(file string, req interface{}) error {
+	b, err := a.readFile(file)
+	if err != nil {
+		return err
+	}
+	b, err = toJSON(b)
+	if err != nil {
+		return fmt.Errorf("%s: %w", file, err)
+	}
+	d := json.NewDecoder(strings.NewReader(string(b)))
+	d.DisallowUnknownFields()
+	if err := d.Decode(req); err != nil {
+		return fmt.Errorf("%s: %w", file, err)
+	}
+	return nil
+}
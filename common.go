@@ -4,7 +4,7 @@ package goztl
 
 type HTTPHeader struct {
 	Name  string `json:"name"`
-	Value string `json:"value"`
+	Value string `json:"value" ztl:"secret"`
 }
 
 // Endpoint metadata
@@ -0,0 +1,136 @@
+package goztl
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+)
+
+const (
+	complianceCheckBasePath       = "inventory/compliance_checks/"
+	complianceCheckStatusBasePath = complianceCheckBasePath + "statuses/"
+)
+
+// ComplianceChecksService is an interface for interfacing with the compliance checks
+// endpoints of the Zentral API.
+type ComplianceChecksService interface {
+	List(context.Context, *ListOptions) ([]ComplianceCheck, *Response, error)
+	GetByID(context.Context, int) (*ComplianceCheck, *Response, error)
+	ListStatusesByCheckID(context.Context, int, *ListOptions) ([]ComplianceCheckStatus, *Response, error)
+	ListStatusesBySerialNumber(context.Context, string, *ListOptions) ([]ComplianceCheckStatus, *Response, error)
+}
+
+// ComplianceChecksServiceOp handles communication with the compliance checks related
+// methods of the Zentral API.
+type ComplianceChecksServiceOp struct {
+	client *Client
+}
+
+var _ ComplianceChecksService = &ComplianceChecksServiceOp{}
+
+// ComplianceCheck represents a Zentral compliance check. The compliance checks are created and
+// updated through their JMESPath checks, Munki script checks, osquery queries, Turbo scripts or
+// Turbo mSCP checks.
+type ComplianceCheck struct {
+	ID int `json:"id"`
+
+	// Kind of the check, e.g. "JMESPathCheck" or "OsqueryCheck"
+	Model string `json:"model"`
+
+	Name        string    `json:"name"`
+	Description string    `json:"description"`
+	Version     int       `json:"version"`
+	Created     Timestamp `json:"created_at"`
+	Updated     Timestamp `json:"updated_at"`
+}
+
+func (cc ComplianceCheck) String() string {
+	return Stringify(cc)
+}
+
+// Compliance check statuses
+const (
+	ComplianceStatusOK      = "OK"
+	ComplianceStatusFailed  = "FAILED"
+	ComplianceStatusUnknown = "UNKNOWN"
+)
+
+// ComplianceCheckStatus represents the status of a compliance check on a machine
+type ComplianceCheckStatus struct {
+	ComplianceCheckID      int       `json:"compliance_check"`
+	ComplianceCheckVersion int       `json:"compliance_check_version"`
+	SerialNumber           string    `json:"serial_number"`
+	Status                 string    `json:"status"`
+	StatusTime             Timestamp `json:"status_time"`
+}
+
+func (ccs ComplianceCheckStatus) String() string {
+	return Stringify(ccs)
+}
+
+type listCCStatusOptions struct {
+	ComplianceCheckID int    `url:"compliance_check_id,omitempty"`
+	SerialNumber      string `url:"serial_number,omitempty"`
+}
+
+// List lists all the compliance checks.
+func (s *ComplianceChecksServiceOp) List(ctx context.Context, opt *ListOptions) ([]ComplianceCheck, *Response, error) {
+	path, err := addOptions(complianceCheckBasePath, opt)
+	if err != nil {
+		return nil, nil, err
+	}
+	return resolveAllPages[ComplianceCheck](ctx, s.client, path)
+}
+
+// GetByID retrieves a compliance check by id.
+func (s *ComplianceChecksServiceOp) GetByID(ctx context.Context, ccID int) (*ComplianceCheck, *Response, error) {
+	if ccID < 1 {
+		return nil, nil, NewArgError("ccID", "cannot be less than 1")
+	}
+
+	path := fmt.Sprintf("%s%d/", complianceCheckBasePath, ccID)
+
+	req, err := s.client.NewRequest(ctx, http.MethodGet, path, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	cc := new(ComplianceCheck)
+
+	resp, err := s.client.Do(ctx, req, cc)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return cc, resp, err
+}
+
+// ListStatusesByCheckID lists the machine statuses of a compliance check.
+func (s *ComplianceChecksServiceOp) ListStatusesByCheckID(ctx context.Context, ccID int, opt *ListOptions) ([]ComplianceCheckStatus, *Response, error) {
+	if ccID < 1 {
+		return nil, nil, NewArgError("ccID", "cannot be less than 1")
+	}
+	return s.listStatuses(ctx, opt, &listCCStatusOptions{ComplianceCheckID: ccID})
+}
+
+// ListStatusesBySerialNumber lists the compliance check statuses of a machine.
+func (s *ComplianceChecksServiceOp) ListStatusesBySerialNumber(ctx context.Context, serialNumber string, opt *ListOptions) ([]ComplianceCheckStatus, *Response, error) {
+	if len(serialNumber) < 1 {
+		return nil, nil, NewArgError("serialNumber", "cannot be blank")
+	}
+	return s.listStatuses(ctx, opt, &listCCStatusOptions{SerialNumber: serialNumber})
+}
+
+// Helper method for listing compliance check statuses
+func (s *ComplianceChecksServiceOp) listStatuses(ctx context.Context, opt *ListOptions, ccsOpt *listCCStatusOptions) ([]ComplianceCheckStatus, *Response, error) {
+	path := complianceCheckStatusBasePath
+	path, err := addOptions(path, opt)
+	if err != nil {
+		return nil, nil, err
+	}
+	path, err = addOptions(path, ccsOpt)
+	if err != nil {
+		return nil, nil, err
+	}
+	return resolveAllPages[ComplianceCheckStatus](ctx, s.client, path)
+}
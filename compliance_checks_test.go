@@ -0,0 +1,178 @@
+package goztl
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+var ccJSONResponse = `
+{
+  "id": 4,
+  "model": "JMESPathCheck",
+  "name": "FileVault",
+  "description": "FileVault enabled",
+  "version": 2,
+  "created_at": "2022-07-22T01:02:03.444444",
+  "updated_at": "2022-07-22T01:02:03.444444"
+}
+`
+
+var ccsListJSONResponse = `
+{
+  "count": 2,
+  "next": null,
+  "previous": null,
+  "results": [
+    {
+      "compliance_check": 4,
+      "compliance_check_version": 2,
+      "serial_number": "0123456789",
+      "status": "OK",
+      "status_time": "2022-07-22T01:02:03.444444"
+    },
+    {
+      "compliance_check": 5,
+      "compliance_check_version": 1,
+      "serial_number": "0123456789",
+      "status": "FAILED",
+      "status_time": "2022-07-22T01:02:03.444444"
+    }
+  ]
+}
+`
+
+func TestComplianceChecksService_List(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/inventory/compliance_checks/", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testHeader(t, r, "Accept", "application/json")
+		fmt.Fprint(w, "["+ccJSONResponse+"]")
+	})
+
+	ctx := context.Background()
+	got, _, err := client.ComplianceChecks.List(ctx, nil)
+	if err != nil {
+		t.Errorf("ComplianceChecks.List returned error: %v", err)
+	}
+
+	want := []ComplianceCheck{
+		{
+			ID:          4,
+			Model:       "JMESPathCheck",
+			Name:        "FileVault",
+			Description: "FileVault enabled",
+			Version:     2,
+			Created:     Timestamp{referenceTime},
+			Updated:     Timestamp{referenceTime},
+		},
+	}
+	if !cmp.Equal(got, want) {
+		t.Errorf("ComplianceChecks.List returned %+v, want %+v", got, want)
+	}
+}
+
+func TestComplianceChecksService_GetByID(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/inventory/compliance_checks/4/", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testHeader(t, r, "Accept", "application/json")
+		fmt.Fprint(w, ccJSONResponse)
+	})
+
+	ctx := context.Background()
+	got, _, err := client.ComplianceChecks.GetByID(ctx, 4)
+	if err != nil {
+		t.Errorf("ComplianceChecks.GetByID returned error: %v", err)
+	}
+
+	want := &ComplianceCheck{
+		ID:          4,
+		Model:       "JMESPathCheck",
+		Name:        "FileVault",
+		Description: "FileVault enabled",
+		Version:     2,
+		Created:     Timestamp{referenceTime},
+		Updated:     Timestamp{referenceTime},
+	}
+	if !cmp.Equal(got, want) {
+		t.Errorf("ComplianceChecks.GetByID returned %+v, want %+v", got, want)
+	}
+}
+
+func TestComplianceChecksService_ListStatusesByCheckID(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/inventory/compliance_checks/statuses/", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testHeader(t, r, "Accept", "application/json")
+		testQueryArg(t, r, "compliance_check_id", "4")
+		fmt.Fprint(w, `[{"compliance_check": 4, "compliance_check_version": 2, "serial_number": "0123456789",
+		                 "status": "UNKNOWN", "status_time": "2022-07-22T01:02:03.444444"}]`)
+	})
+
+	ctx := context.Background()
+	got, _, err := client.ComplianceChecks.ListStatusesByCheckID(ctx, 4, nil)
+	if err != nil {
+		t.Errorf("ComplianceChecks.ListStatusesByCheckID returned error: %v", err)
+	}
+
+	want := []ComplianceCheckStatus{
+		{
+			ComplianceCheckID:      4,
+			ComplianceCheckVersion: 2,
+			SerialNumber:           "0123456789",
+			Status:                 ComplianceStatusUnknown,
+			StatusTime:             Timestamp{referenceTime},
+		},
+	}
+	if !cmp.Equal(got, want) {
+		t.Errorf("ComplianceChecks.ListStatusesByCheckID returned %+v, want %+v", got, want)
+	}
+}
+
+func TestComplianceChecksService_ListStatusesBySerialNumber(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/inventory/compliance_checks/statuses/", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testHeader(t, r, "Accept", "application/json")
+		testQueryArg(t, r, "serial_number", "0123456789")
+		fmt.Fprint(w, ccsListJSONResponse)
+	})
+
+	ctx := context.Background()
+	got, _, err := client.ComplianceChecks.ListStatusesBySerialNumber(ctx, "0123456789", nil)
+	if err != nil {
+		t.Errorf("ComplianceChecks.ListStatusesBySerialNumber returned error: %v", err)
+	}
+
+	want := []ComplianceCheckStatus{
+		{
+			ComplianceCheckID:      4,
+			ComplianceCheckVersion: 2,
+			SerialNumber:           "0123456789",
+			Status:                 ComplianceStatusOK,
+			StatusTime:             Timestamp{referenceTime},
+		},
+		{
+			ComplianceCheckID:      5,
+			ComplianceCheckVersion: 1,
+			SerialNumber:           "0123456789",
+			Status:                 ComplianceStatusFailed,
+			StatusTime:             Timestamp{referenceTime},
+		},
+	}
+	if !cmp.Equal(got, want) {
+		t.Errorf("ComplianceChecks.ListStatusesBySerialNumber returned %+v, want %+v", got, want)
+	}
+}
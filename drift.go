@@ -0,0 +1,287 @@
+package goztl
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"reflect"
+	"sort"
+	"strings"
+)
+
+// serviceBasePaths maps the client service fields to the base paths of their endpoints.
+var serviceBasePaths = map[string]string{
+	"AccountsAPITokens":                  aatBasePath,
+	"AccountsUsers":                      auBasePath,
+	"ComplianceChecks":                   complianceCheckBasePath,
+	"GWSConnections":                     gwsConnctionsBasePath,
+	"GWSGroupTagMappings":                gwsGroupTagMappingsBasePath,
+	"InventoryMachines":                  imBasePath,
+	"JMESPathChecks":                     jmespathCheckBasePath,
+	"MDMACMEIssuers":                     mACMEIssuerBasePath,
+	"MDMArtifacts":                       maBasePath,
+	"MDMBlueprintArtifacts":              mbaBasePath,
+	"MDMBlueprints":                      mbBasePath,
+	"MDMCertAssets":                      mcaBasePath,
+	"MDMDataAssets":                      mdaBasePath,
+	"MDMDeclarations":                    mdBasePath,
+	"MDMDEPEnrollmentCustomViews":        depEnrollmentCustomViewBasePath,
+	"MDMDEPEnrollments":                  depEnrollmentBasePath,
+	"MDMDEPVirtualServers":               depVirtualServersBasePath,
+	"MDMEnrollmentCustomViews":           enrollmentCustomViewBasePath,
+	"MDMEnterpriseApps":                  meaBasePath,
+	"MDMFileVaultConfigs":                mfcBasePath,
+	"MDMLocationAssets":                  mlaBasePath,
+	"MDMLocations":                       mlBasePath,
+	"MDMOTAEnrollments":                  moeBasePath,
+	"MDMPackages":                        mpkgBasePath,
+	"MDMProfiles":                        mpBasePath,
+	"MDMProvisioningProfiles":            mppBasePath,
+	"MDMPushCertificates":                mpcBasePath,
+	"MDMRecoveryPasswordConfigs":         mrpcBasePath,
+	"MDMSCEPIssuers":                     mSCEPIssuerBasePath,
+	"MDMSoftwareUpdateEnforcements":      msueBasePath,
+	"MDMStoreApps":                       msaBasePath,
+	"MetaBusinessUnits":                  mbuBasePath,
+	"MonolithCatalogs":                   mcBasePath,
+	"MonolithConditions":                 mcoBasePath,
+	"MonolithEnrollments":                meBasePath,
+	"MonolithManifestCatalogs":           mmcBasePath,
+	"MonolithManifestEnrollmentPackages": mmepBasePath,
+	"MonolithManifestSubManifests":       mmsmBasePath,
+	"MonolithManifests":                  mmBasePath,
+	"MonolithRepositories":               mrBasePath,
+	"MonolithSubManifestPkgInfos":        smpiBasePath,
+	"MonolithSubManifests":               msmBasePath,
+	"MunkiConfigurations":                mucBasePath,
+	"MunkiEnrollments":                   mueBasePath,
+	"MunkiScriptChecks":                  mscBasePath,
+	"OsqueryATC":                         oaBasePath,
+	"OsqueryConfigurationPacks":          ocpBasePath,
+	"OsqueryConfigurations":              ocBasePath,
+	"OsqueryEnrollments":                 oeBasePath,
+	"OsqueryFileCategories":              ofcBasePath,
+	"OsqueryPacks":                       opBasePath,
+	"OsqueryQueries":                     oqBasePath,
+	"Probes":                             probesBasePath,
+	"ProbesActions":                      probesActionsBasePath,
+	"RealmsGroups":                       rgBasePath,
+	"RealmsRealms":                       rBasePath,
+	"RealmsRoleMappings":                 rrmBasePath,
+	"RealmsTagMappings":                  rtmBasePath,
+	"Roles":                              rolesBasePath,
+	"SantaConfigurations":                scBasePath,
+	"SantaEnrollments":                   seBasePath,
+	"SantaRules":                         srBasePath,
+	"Stores":                             storesBasePath,
+	"Tags":                               tagBasePath,
+	"Taxonomies":                         TaxonomyBasePath,
+	"Tasks":                              taskResultBasePath,
+	"TurboConfigurations":                tconfBasePath,
+	"TurboEnrollments":                   tenrBasePath,
+	"TurboMSCPChecks":                    tmscBasePath,
+	"TurboOneTimeJobs":                   totjBasePath,
+	"TurboRecurringJobs":                 trjBasePath,
+	"TurboScripts":                       tscrBasePath,
+}
+
+// DriftKind is the kind of a difference between a goztl struct and the fields of an endpoint.
+type DriftKind string
+
+// The drift kinds.
+const (
+	// A field of the endpoint is not in the struct.
+	DriftMissing DriftKind = "missing"
+
+	// A field of the struct is not a field of the endpoint.
+	DriftExtra DriftKind = "extra"
+
+	// A field of the request struct is read-only for the endpoint.
+	DriftReadOnlySent DriftKind = "read-only but sent"
+
+	// A field of the request struct is required by the endpoint, but omitted when empty.
+	DriftRequiredOmitEmpty DriftKind = "required but omitempty"
+)
+
+// SchemaDrift is a difference between a goztl struct and the fields reported by an endpoint.
+type SchemaDrift struct {
+	Service string `json:"service"`
+	Path    string `json:"path"`
+
+	// Name of the goztl struct, e.g. SantaRule or SantaRuleRequest.
+	Type string `json:"type"`
+
+	Field string    `json:"field"`
+	Kind  DriftKind `json:"kind"`
+}
+
+func (d SchemaDrift) String() string {
+	return fmt.Sprintf("%s %s.%s: %s", d.Service, d.Type, d.Field, d.Kind)
+}
+
+// SchemaDriftReport is the result of a schema drift check.
+type SchemaDriftReport struct {
+	Drifts []SchemaDrift
+
+	// Services that could not be checked, with the reason: OPTIONS request error, or POST method
+	// not described, because the endpoint is read-only or the token lacks the permission.
+	Skipped map[string]string
+}
+
+// CheckSchemaDrift makes an OPTIONS request on the base path of the services, all the services by
+// default, and compares the fields of their POST method with the JSON fields of the goztl model
+// and request structs. Only the top-level fields are compared.
+//
+// The fields of the endpoint that are not in the model or request structs are missing, the fields
+// of the structs that are not fields of the endpoint are extra. The write-only fields of the
+// endpoint, that are in a request struct, are not reported as missing from the model.
+func (c *Client) CheckSchemaDrift(ctx context.Context, services ...string) (*SchemaDriftReport, error) {
+	if len(services) == 0 {
+		for s := range serviceBasePaths {
+			services = append(services, s)
+		}
+	}
+	sort.Strings(services)
+	r := &SchemaDriftReport{Skipped: make(map[string]string)}
+	for _, s := range services {
+		path, ok := serviceBasePaths[s]
+		if !ok {
+			return nil, NewArgError("services", fmt.Sprintf("unknown service %q", s))
+		}
+		eo, _, err := c.EndpointOptions(ctx, path)
+		if err != nil {
+			var er *ErrorResponse
+			if !errors.As(err, &er) {
+				return nil, err
+			}
+			r.Skipped[s] = fmt.Sprintf("OPTIONS %s: %d", path, er.Response.StatusCode)
+			continue
+		}
+		fields, ok := eo.Actions[http.MethodPost]
+		if !ok {
+			r.Skipped[s] = "POST method not described"
+			continue
+		}
+		model, requests := serviceTypes(s)
+		r.Drifts = append(r.Drifts, schemaDrifts(s, path, fields, model, requests)...)
+	}
+	return r, nil
+}
+
+// serviceTypes returns the model and the request struct types of a client service.
+func serviceTypes(service string) (reflect.Type, []reflect.Type) {
+	f, _ := reflect.TypeOf(Client{}).FieldByName(service)
+	var model reflect.Type
+	if m, ok := f.Type.MethodByName("List"); ok {
+		model = m.Type.Out(0).Elem()
+	}
+	var requests []reflect.Type
+	for _, name := range []string{"Create", "Update"} {
+		m, ok := f.Type.MethodByName(name)
+		if !ok {
+			continue
+		}
+		t := m.Type.In(m.Type.NumIn() - 1)
+		if t.Kind() == reflect.Ptr {
+			t = t.Elem()
+		}
+		if t.Kind() == reflect.Struct && (len(requests) == 0 || requests[0] != t) {
+			requests = append(requests, t)
+		}
+	}
+	return model, requests
+}
+
+// structField is a JSON field of a struct.
+type structField struct {
+	name      string
+	omitEmpty bool
+}
+
+// structFields returns the JSON fields of a struct, the fields of the embedded structs included.
+func structFields(t reflect.Type) []structField {
+	var fields []structField
+	for i := 0; i < t.NumField(); i++ {
+		sf := t.Field(i)
+		name, opts, _ := strings.Cut(sf.Tag.Get("json"), ",")
+		if name == "-" || (!sf.IsExported() && !sf.Anonymous) {
+			continue
+		}
+		if sf.Anonymous && name == "" {
+			ft := sf.Type
+			if ft.Kind() == reflect.Ptr {
+				ft = ft.Elem()
+			}
+			if ft.Kind() == reflect.Struct {
+				fields = append(fields, structFields(ft)...)
+				continue
+			}
+		}
+		if name == "" {
+			name = sf.Name
+		}
+		fields = append(fields, structField{name: name, omitEmpty: strings.Contains(","+opts+",", ",omitempty,")})
+	}
+	return fields
+}
+
+// schemaDrifts compares the fields of an endpoint with the model and request structs.
+func schemaDrifts(service, path string, fields map[string]EndpointField, model reflect.Type, requests []reflect.Type) []SchemaDrift {
+	var drifts []SchemaDrift
+	add := func(t reflect.Type, field string, kind DriftKind) {
+		drifts = append(drifts, SchemaDrift{Service: service, Path: path, Type: t.Name(), Field: field, Kind: kind})
+	}
+	sent := make(map[string]bool)
+	for _, t := range requests {
+		inStruct := make(map[string]bool)
+		for _, f := range structFields(t) {
+			inStruct[f.name] = true
+			sent[f.name] = true
+			ef, ok := fields[f.name]
+			switch {
+			case !ok:
+				add(t, f.name, DriftExtra)
+			case ef.ReadOnly:
+				add(t, f.name, DriftReadOnlySent)
+			case ef.Required && f.omitEmpty:
+				add(t, f.name, DriftRequiredOmitEmpty)
+			}
+		}
+		for _, name := range sortedFieldNames(fields) {
+			if !fields[name].ReadOnly && !inStruct[name] {
+				add(t, name, DriftMissing)
+			}
+		}
+	}
+	if model != nil && model.Kind() == reflect.Struct {
+		inStruct := make(map[string]bool)
+		for _, f := range structFields(model) {
+			inStruct[f.name] = true
+			if _, ok := fields[f.name]; !ok {
+				add(model, f.name, DriftExtra)
+			}
+		}
+		for _, name := range sortedFieldNames(fields) {
+			if !inStruct[name] && !sent[name] {
+				add(model, name, DriftMissing)
+			}
+		}
+	}
+	sort.SliceStable(drifts, func(i, j int) bool {
+		if drifts[i].Type != drifts[j].Type {
+			return drifts[i].Type < drifts[j].Type
+		}
+		return drifts[i].Field < drifts[j].Field
+	})
+	return drifts
+}
+
+func sortedFieldNames(fields map[string]EndpointField) []string {
+	names := make([]string, 0, len(fields))
+	for name := range fields {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
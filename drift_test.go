@@ -0,0 +1,116 @@
+package goztl
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestServiceBasePaths(t *testing.T) {
+	ct := reflect.TypeOf(Client{})
+	for i := 0; i < ct.NumField(); i++ {
+		f := ct.Field(i)
+		if !f.IsExported() || f.Type.Kind() != reflect.Interface {
+			continue
+		}
+		if _, ok := serviceBasePaths[f.Name]; !ok {
+			t.Errorf("service %s has no base path", f.Name)
+		}
+	}
+	for s := range serviceBasePaths {
+		if _, ok := ct.FieldByName(s); !ok {
+			t.Errorf("unknown service %s", s)
+		}
+	}
+}
+
+var srOptionsJSONResponse = `
+{
+  "name": "Rule List",
+  "actions": {
+    "POST": {
+      "id": {"type": "integer", "required": false, "read_only": true},
+      "configuration": {"type": "field", "required": true, "read_only": false},
+      "policy": {"type": "choice", "required": true, "read_only": false},
+      "cel_expr": {"type": "string", "required": false, "read_only": false},
+      "target_type": {"type": "choice", "required": true, "read_only": false},
+      "target_identifier": {"type": "string", "required": true, "read_only": false},
+      "description": {"type": "string", "required": false, "read_only": true},
+      "custom_msg": {"type": "string", "required": false, "read_only": false},
+      "ruleset": {"type": "field", "required": false, "read_only": true},
+      "primary_users": {"type": "list", "required": false, "read_only": false},
+      "excluded_primary_users": {"type": "list", "required": false, "read_only": false},
+      "serial_numbers": {"type": "list", "required": false, "read_only": false},
+      "excluded_serial_numbers": {"type": "list", "required": false, "read_only": false},
+      "tags": {"type": "field", "required": false, "read_only": false},
+      "excluded_tags": {"type": "field", "required": false, "read_only": false},
+      "file_bundle_hint": {"type": "string", "required": false, "read_only": false},
+      "version": {"type": "integer", "required": false, "read_only": true},
+      "created_at": {"type": "datetime", "required": false, "read_only": true},
+      "updated_at": {"type": "datetime", "required": false, "read_only": true}
+    }
+  }
+}
+`
+
+func TestCheckSchemaDrift(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/santa/rules/", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "OPTIONS")
+		fmt.Fprint(w, srOptionsJSONResponse)
+	})
+	mux.HandleFunc("/santa/configurations/", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "OPTIONS")
+		fmt.Fprint(w, `{"name": "Configuration List", "actions": {}}`)
+	})
+	mux.HandleFunc("/santa/enrollments/", func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, `{"detail": "Forbidden"}`, http.StatusForbidden)
+	})
+
+	ctx := context.Background()
+	got, err := client.CheckSchemaDrift(ctx, "SantaRules", "SantaConfigurations", "SantaEnrollments")
+	if err != nil {
+		t.Fatalf("CheckSchemaDrift returned error: %v", err)
+	}
+	srDrift := func(typ, field string, kind DriftKind) SchemaDrift {
+		return SchemaDrift{Service: "SantaRules", Path: "santa/rules/", Type: typ, Field: field, Kind: kind}
+	}
+	want := &SchemaDriftReport{
+		Drifts: []SchemaDrift{
+			srDrift("SantaRule", "custom_url", DriftExtra),
+			srDrift("SantaRule", "file_bundle_hint", DriftMissing),
+			srDrift("SantaRuleRequest", "custom_url", DriftExtra),
+			srDrift("SantaRuleRequest", "description", DriftReadOnlySent),
+			srDrift("SantaRuleRequest", "file_bundle_hint", DriftMissing),
+		},
+		Skipped: map[string]string{
+			"SantaConfigurations": "POST method not described",
+			"SantaEnrollments":    "OPTIONS santa/enrollments/: 403",
+		},
+	}
+	if !cmp.Equal(got, want) {
+		t.Errorf("CheckSchemaDrift returned %s", cmp.Diff(want, got))
+	}
+
+	if _, err := client.CheckSchemaDrift(ctx, "Yolo"); err == nil {
+		t.Error("CheckSchemaDrift returned no error for an unknown service")
+	}
+}
+
+func TestSchemaDriftsRequiredOmitEmpty(t *testing.T) {
+	type request struct {
+		Name string `json:"name,omitempty"`
+	}
+	fields := map[string]EndpointField{"name": {Type: "string", Required: true}}
+	got := schemaDrifts("Yolo", "yolo/", fields, nil, []reflect.Type{reflect.TypeOf(request{})})
+	want := []SchemaDrift{{Service: "Yolo", Path: "yolo/", Type: "request", Field: "name", Kind: DriftRequiredOmitEmpty}}
+	if !cmp.Equal(got, want) {
+		t.Errorf("schemaDrifts returned %s", cmp.Diff(want, got))
+	}
+}
@@ -2,7 +2,7 @@ package goztl
 
 type EnrollmentSecret struct {
 	ID                 int      `json:"id"`
-	Secret             string   `json:"secret"`
+	Secret             string   `json:"secret" ztl:"secret"`
 	MetaBusinessUnitID int      `json:"meta_business_unit"`
 	TagIDs             []int    `json:"tags"`
 	SerialNumbers      []string `json:"serial_numbers"`
@@ -0,0 +1,285 @@
+package goztl
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+)
+
+// Extras keeps the JSON fields of an object that goztl does not model. It is embedded in the
+// models and in the requests opting in for forward compatibility.
+//
+// Client.Do fills Extra with the unknown fields of the decoded responses, and Client.NewRequest
+// adds Extra to the JSON object of the request bodies, without overriding the modeled fields. To
+// keep the unknown fields in a read-modify-write, copy the Extras of the model to the request:
+//
+//	sc, _, _ := client.SantaConfigurations.GetByID(ctx, 1)
+//	req := &goztl.SantaConfigurationRequest{Name: sc.Name, Extras: sc.Extras}
+//
+// The unknown fields can hold secrets, so Stringify only writes their keys, and Redact replaces
+// their values.
+type Extras struct {
+	Extra map[string]json.RawMessage `json:"-"`
+}
+
+var extrasType = reflect.TypeOf(Extras{})
+
+// SetStrictDecoding is a client option for returning an error when a response has a field that is
+// neither modeled nor kept in Extras. It is meant for the tests.
+func SetStrictDecoding() ClientOpt {
+	return func(c *Client) error {
+		c.strictDecoding = true
+		return nil
+	}
+}
+
+// UnknownFieldError is returned in strict decoding mode when a response has an unknown field.
+type UnknownFieldError struct {
+	// Type of the decoded struct.
+	Type string
+
+	Field string
+}
+
+func (e *UnknownFieldError) Error() string {
+	return fmt.Sprintf("json: unknown field %q in %s", e.Field, e.Type)
+}
+
+// decodeJSON decodes a JSON document into v, and fills the Extras of the decoded structs.
+func (c *Client) decodeJSON(data []byte, v interface{}) error {
+	if err := json.NewDecoder(bytes.NewReader(data)).Decode(v); err != nil {
+		return err
+	}
+	rv := reflect.ValueOf(v)
+	if !c.strictDecoding && !hasExtras(rv.Type(), make(map[reflect.Type]bool)) {
+		return nil
+	}
+	return unknownFields(data, rv, c.strictDecoding)
+}
+
+// hasExtras tells if the values of a type can contain structs embedding Extras.
+func hasExtras(t reflect.Type, seen map[reflect.Type]bool) bool {
+	if seen[t] {
+		return false
+	}
+	seen[t] = true
+	switch t.Kind() {
+	case reflect.Ptr, reflect.Slice, reflect.Array:
+		return hasExtras(t.Elem(), seen)
+	case reflect.Struct:
+		if t == extrasType {
+			return true
+		}
+		for i := 0; i < t.NumField(); i++ {
+			if hasExtras(t.Field(i).Type, seen) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// resultsHolder is implemented by the types decoding the bare JSON arrays into a Results field.
+type resultsHolder interface {
+	results() reflect.Value
+}
+
+func (p *maybePaginatedResults[T]) results() reflect.Value {
+	return reflect.ValueOf(&p.Results).Elem()
+}
+
+var (
+	resultsHolderType = reflect.TypeOf((*resultsHolder)(nil)).Elem()
+	unmarshalerType   = reflect.TypeOf((*json.Unmarshaler)(nil)).Elem()
+)
+
+// unknownFields walks a decoded value along its JSON document, and keeps the unknown fields of the
+// structs in their Extras. In strict mode, an error is returned for the unknown fields of the
+// structs without Extras.
+func unknownFields(data []byte, v reflect.Value, strict bool) error {
+	switch v.Kind() {
+	case reflect.Ptr:
+		if v.IsNil() {
+			return nil
+		}
+		return unknownFields(data, v.Elem(), strict)
+	case reflect.Slice, reflect.Array:
+		var elems []json.RawMessage
+		if json.Unmarshal(data, &elems) != nil {
+			return nil
+		}
+		for i := 0; i < len(elems) && i < v.Len(); i++ {
+			if err := unknownFields(elems[i], v.Index(i), strict); err != nil {
+				return err
+			}
+		}
+		return nil
+	case reflect.Struct:
+	default:
+		return nil
+	}
+
+	if v.CanAddr() && v.Addr().Type().Implements(resultsHolderType) {
+		if bytes.HasPrefix(bytes.TrimLeft(data, " \t\r\n"), []byte("[")) {
+			return unknownFields(data, v.Addr().Interface().(resultsHolder).results(), strict)
+		}
+		return unknownFields(data, v.Field(0), strict)
+	}
+	if v.Type().Implements(unmarshalerType) || (v.CanAddr() && v.Addr().Type().Implements(unmarshalerType)) {
+		return nil
+	}
+	var obj map[string]json.RawMessage
+	if json.Unmarshal(data, &obj) != nil {
+		return nil
+	}
+	fields, extras := jsonFields(v)
+	keys := make([]string, 0, len(obj))
+	for k := range obj {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		fv, ok := fields[k]
+		if !ok {
+			for name, f := range fields {
+				if strings.EqualFold(name, k) {
+					fv, ok = f, true
+					break
+				}
+			}
+		}
+		switch {
+		case ok:
+			if err := unknownFields(obj[k], fv, strict); err != nil {
+				return err
+			}
+		case extras != nil:
+			if extras.Extra == nil {
+				extras.Extra = make(map[string]json.RawMessage)
+			}
+			extras.Extra[k] = obj[k]
+		case strict:
+			return &UnknownFieldError{Type: v.Type().String(), Field: k}
+		}
+	}
+	return nil
+}
+
+// jsonFields returns the values of the JSON fields of a struct, the fields of the embedded
+// structs included, and its Extras, if any.
+func jsonFields(v reflect.Value) (map[string]reflect.Value, *Extras) {
+	fields := make(map[string]reflect.Value)
+	var extras *Extras
+	t := v.Type()
+	for i := 0; i < t.NumField(); i++ {
+		sf := t.Field(i)
+		fv := v.Field(i)
+		if sf.Type == extrasType {
+			if fv.CanAddr() {
+				extras = fv.Addr().Interface().(*Extras)
+			}
+			continue
+		}
+		name, _, _ := strings.Cut(sf.Tag.Get("json"), ",")
+		if name == "-" || (!sf.IsExported() && !sf.Anonymous) {
+			continue
+		}
+		if sf.Anonymous && name == "" {
+			ev := fv
+			if ev.Kind() == reflect.Ptr {
+				if ev.IsNil() {
+					continue
+				}
+				ev = ev.Elem()
+			}
+			if ev.Kind() == reflect.Struct {
+				efields, eextras := jsonFields(ev)
+				for k, f := range efields {
+					if _, ok := fields[k]; !ok {
+						fields[k] = f
+					}
+				}
+				if extras == nil {
+					extras = eextras
+				}
+				continue
+			}
+		}
+		if name == "" {
+			name = sf.Name
+		}
+		fields[name] = fv
+	}
+	return fields, extras
+}
+
+// encodeJSON returns the JSON encoding of a request body, with the Extras of the body, if any,
+// added to its object.
+func encodeJSON(body interface{}) ([]byte, error) {
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(body); err != nil {
+		return nil, err
+	}
+	b := buf.Bytes()
+	rv := reflect.Indirect(reflect.ValueOf(body))
+	if rv.Kind() != reflect.Struct {
+		return b, nil
+	}
+	extras := findExtras(rv)
+	if extras == nil || len(extras.Extra) == 0 {
+		return b, nil
+	}
+	var obj map[string]json.RawMessage
+	if err := json.Unmarshal(b, &obj); err != nil {
+		return nil, err
+	}
+	keys := make([]string, 0, len(extras.Extra))
+	for k := range extras.Extra {
+		if _, ok := obj[k]; !ok {
+			keys = append(keys, k)
+		}
+	}
+	if len(keys) == 0 {
+		return b, nil
+	}
+	sort.Strings(keys)
+	out := bytes.TrimRight(b, " \t\r\n")
+	out = out[:len(out)-1] // closing brace
+	for i, k := range keys {
+		if len(obj) > 0 || i > 0 {
+			out = append(out, ',')
+		}
+		kb, err := json.Marshal(k)
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, kb...)
+		out = append(out, ':')
+		out = append(out, extras.Extra[k]...)
+	}
+	return append(out, '}', '\n'), nil
+}
+
+// findExtras returns the Extras embedded in a struct, directly or through an embedded struct.
+func findExtras(v reflect.Value) *Extras {
+	for i := 0; i < v.NumField(); i++ {
+		sf := v.Type().Field(i)
+		if !sf.Anonymous {
+			continue
+		}
+		fv := reflect.Indirect(v.Field(i))
+		if sf.Type == extrasType {
+			e := fv.Interface().(Extras)
+			return &e
+		}
+		if fv.Kind() == reflect.Struct {
+			if e := findExtras(fv); e != nil {
+				return e
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,109 @@
+package goztl
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestExtrasReadModifyWrite(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/santa/configurations/", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `[{"id": 4, "name": "Default", "batch_size": 50, "block_network_mount": true,
+		  "banned_block_message": {"en": "Yolo"}}]`)
+	})
+	mux.HandleFunc("/santa/configurations/4/", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PUT")
+		testBody(t, r, `{"name":"Fomo","client_mode":0,"client_certificate_auth":false,"batch_size":50,`+
+			`"full_sync_interval":0,"enable_bundles":false,"enable_transitive_rules":false,"allowed_path_regex":"",`+
+			`"blocked_path_regex":"","block_usb_mount":false,"remount_usb_mode":null,"allow_unknown_shard":0,`+
+			`"enable_all_event_upload_shard":0,"sync_incident_severity":0,`+
+			`"banned_block_message":{"en": "Yolo"},"block_network_mount":true}`+"\n")
+		fmt.Fprint(w, `{"id": 4, "name": "Fomo"}`)
+	})
+
+	ctx := context.Background()
+	scs, _, err := client.SantaConfigurations.List(ctx, nil)
+	if err != nil {
+		t.Fatalf("SantaConfigurations.List returned error: %v", err)
+	}
+	wantExtra := map[string]json.RawMessage{
+		"banned_block_message": json.RawMessage(`{"en": "Yolo"}`),
+		"block_network_mount":  json.RawMessage(`true`),
+	}
+	if len(scs) != 1 || !cmp.Equal(scs[0].Extra, wantExtra) {
+		t.Fatalf("SantaConfigurations.List returned %+v", scs)
+	}
+
+	sc := scs[0]
+	req := &SantaConfigurationRequest{Name: "Fomo", BatchSize: sc.BatchSize, Extras: sc.Extras}
+	if _, _, err := client.SantaConfigurations.Update(ctx, sc.ID, req); err != nil {
+		t.Fatalf("SantaConfigurations.Update returned error: %v", err)
+	}
+}
+
+func TestEncodeJSONModeledFieldsWin(t *testing.T) {
+	type request struct {
+		Name string `json:"name"`
+		Extras
+	}
+	b, err := encodeJSON(&request{Name: "Yolo", Extras: Extras{Extra: map[string]json.RawMessage{"name": json.RawMessage(`"Fomo"`)}}})
+	if err != nil {
+		t.Fatalf("encodeJSON returned error: %v", err)
+	}
+	var got map[string]interface{}
+	if err := json.Unmarshal(b, &got); err != nil || got["name"] != "Yolo" {
+		t.Errorf("encodeJSON returned %s", b)
+	}
+}
+
+func TestStrictDecoding(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+	if err := SetStrictDecoding()(client); err != nil {
+		t.Fatalf("SetStrictDecoding returned error: %v", err)
+	}
+
+	mux.HandleFunc("/inventory/tags/1/", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"id": 1, "name": "Yolo", "slug": "yolo", "color": "ff0000", "description": "Fomo"}`)
+	})
+	mux.HandleFunc("/stores/stores/", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"count": 1, "next": null, "previous": null,
+		  "results": [{"id": "0", "name": "Default", "backend": "HTTP", "http_kwargs": {"endpoint_url": "https://zentral.example.com", "yolo": 1}}]}`)
+	})
+	mux.HandleFunc("/stores/stores/1/", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"id": "1", "name": "Default", "backend": "Fomo", "fomo_kwargs": {}}`)
+	})
+
+	ctx := context.Background()
+	_, _, err := client.Tags.GetByID(ctx, 1)
+	want := &UnknownFieldError{Type: "goztl.Tag", Field: "description"}
+	var ufe *UnknownFieldError
+	if !errors.As(err, &ufe) || !cmp.Equal(ufe, want) {
+		t.Errorf("Tags.GetByID returned error %v, want %v", err, want)
+	}
+
+	// the unknown fields of the nested structs are reported
+	_, _, err = client.Stores.List(ctx, nil)
+	want = &UnknownFieldError{Type: "goztl.StoreHTTP", Field: "yolo"}
+	if !errors.As(err, &ufe) || !cmp.Equal(ufe, want) {
+		t.Errorf("Stores.List returned error %v, want %v", err, want)
+	}
+
+	// the unknown fields kept in Extras are not reported
+	s, _, err := client.Stores.GetByID(ctx, "1")
+	if err != nil {
+		t.Fatalf("Stores.GetByID returned error: %v", err)
+	}
+	if !cmp.Equal(s.Extra, map[string]json.RawMessage{"fomo_kwargs": json.RawMessage(`{}`)}) {
+		t.Errorf("Stores.GetByID returned %+v", s)
+	}
+}
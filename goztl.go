@@ -4,13 +4,16 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"net/http"
 	"net/url"
 	"reflect"
 	"runtime/debug"
+	"strconv"
 	"strings"
+	"time"
 
 	"github.com/google/go-querystring/query"
 )
@@ -48,10 +51,16 @@ type Client struct {
 	UserAgent string
 
 	// Services used for communicating with the API
+	// Accounts
+	AccountsAPITokens AccountsAPITokensService
+	AccountsUsers     AccountsUsersService
+	Roles             RolesService
 	// Google Workspace
 	GWSConnections      GWSConnectionsService
 	GWSGroupTagMappings GWSGroupTagMappingsService
 	// Inventory
+	ComplianceChecks  ComplianceChecksService
+	InventoryMachines InventoryMachinesService
 	JMESPathChecks    JMESPathChecksService
 	MetaBusinessUnits MetaBusinessUnitsService
 	Tags              TagsService
@@ -108,13 +117,18 @@ type Client struct {
 	Probes        ProbesService
 	ProbesActions ProbesActionsService
 	// Realms
-	RealmsRealms RealmsRealmsService
+	RealmsGroups       RealmsGroupsService
+	RealmsRealms       RealmsRealmsService
+	RealmsRoleMappings RealmsRoleMappingsService
+	RealmsTagMappings  RealmsTagMappingsService
 	// Santa
 	SantaConfigurations SantaConfigurationsService
 	SantaEnrollments    SantaEnrollmentsService
 	SantaRules          SantaRulesService
 	// Stores
 	Stores StoresService
+	// Tasks
+	Tasks TasksService
 	// Turbo
 	TurboConfigurations TurboConfigurationsService
 	TurboEnrollments    TurboEnrollmentsService
@@ -128,6 +142,28 @@ type Client struct {
 
 	// Optional extra HTTP headers to set on every request to the API.
 	headers map[string]string
+
+	// Optional retry policy of the failed requests.
+	retryPolicy *RetryPolicy
+
+	// Optional audit journal of the mutating requests, and its default actor.
+	journal      Journal
+	journalActor string
+
+	// Return an error for the unknown fields of the responses.
+	strictDecoding bool
+}
+
+// RetryPolicy specifies how the failed requests are retried.
+type RetryPolicy struct {
+	// Maximum number of retries of a request.
+	MaxRetries int
+
+	// Wait before the first retry, doubled after each retry. Defaults to 500ms.
+	MinBackoff time.Duration
+
+	// Maximum wait between two retries. Defaults to 30s.
+	MaxBackoff time.Duration
 }
 
 // ListOptions specifies the optional parameters to various List methods that
@@ -278,10 +314,16 @@ func NewClient(httpClient *http.Client, bu string, token string, opts ...ClientO
 		UserAgent: UserAgent(),
 		token:     cleanToken,
 	}
+	// Accounts
+	c.AccountsAPITokens = &AccountsAPITokensServiceOp{client: c}
+	c.AccountsUsers = &AccountsUsersServiceOp{client: c}
+	c.Roles = &RolesServiceOp{client: c}
 	// Google Workspace
 	c.GWSConnections = &GWSConnectionsServiceOp{client: c}
 	c.GWSGroupTagMappings = &GWSGroupTagMappingsServiceOp{client: c}
 	// Inventory
+	c.ComplianceChecks = &ComplianceChecksServiceOp{client: c}
+	c.InventoryMachines = &InventoryMachinesServiceOp{client: c}
 	c.JMESPathChecks = &JMESPathChecksServiceOp{client: c}
 	c.MetaBusinessUnits = &MetaBusinessUnitsServiceOp{client: c}
 	c.Tags = &TagsServiceOp{client: c}
@@ -338,13 +380,18 @@ func NewClient(httpClient *http.Client, bu string, token string, opts ...ClientO
 	c.Probes = &ProbesServiceOp{client: c}
 	c.ProbesActions = &ProbesActionsServiceOp{client: c}
 	// Realms
+	c.RealmsGroups = &RealmsGroupsServiceOp{client: c}
 	c.RealmsRealms = &RealmsRealmsServiceOp{client: c}
+	c.RealmsRoleMappings = &RealmsRoleMappingsServiceOp{client: c}
+	c.RealmsTagMappings = &RealmsTagMappingsServiceOp{client: c}
 	// Santa
 	c.SantaConfigurations = &SantaConfigurationsServiceOp{client: c}
 	c.SantaEnrollments = &SantaEnrollmentsServiceOp{client: c}
 	c.SantaRules = &SantaRulesServiceOp{client: c}
 	// Stores
 	c.Stores = &StoresServiceOp{client: c}
+	// Tasks
+	c.Tasks = &TasksServiceOp{client: c}
 	// Turbo
 	c.TurboConfigurations = &TurboConfigurationsServiceOp{client: c}
 	c.TurboEnrollments = &TurboEnrollmentsServiceOp{client: c}
@@ -382,6 +429,25 @@ func SetRequestHeaders(headers map[string]string) ClientOpt {
 	}
 }
 
+// SetRetryPolicy is a client option for retrying the idempotent requests (GET, HEAD, OPTIONS, PUT
+// and DELETE) failing with a network error, or with a 429, 502, 503 or 504 status code. The
+// Retry-After header of the responses is honored, up to MaxBackoff.
+func SetRetryPolicy(p RetryPolicy) ClientOpt {
+	return func(c *Client) error {
+		if p.MaxRetries < 0 {
+			return NewArgError("MaxRetries", "cannot be negative")
+		}
+		if p.MinBackoff <= 0 {
+			p.MinBackoff = 500 * time.Millisecond
+		}
+		if p.MaxBackoff <= 0 {
+			p.MaxBackoff = 30 * time.Second
+		}
+		c.retryPolicy = &p
+		return nil
+	}
+}
+
 // NewRequest creates an API request. A relative URL can be provided in urlStr, which will be resolved to the
 // BaseURL of the Client. Relative URLS should always be specified without a preceding slash. If specified, the
 // value pointed to by body is JSON encoded and included in as the request body.
@@ -402,10 +468,11 @@ func (c *Client) NewRequest(ctx context.Context, method, urlStr string, body int
 	default:
 		buf := new(bytes.Buffer)
 		if body != nil {
-			err = json.NewEncoder(buf).Encode(body)
+			b, err := encodeJSON(body)
 			if err != nil {
 				return nil, err
 			}
+			buf.Write(b)
 		}
 
 		req, err = http.NewRequest(method, u.String(), buf)
@@ -423,7 +490,7 @@ func (c *Client) NewRequest(ctx context.Context, method, urlStr string, body int
 	req.Header.Set("Accept", mediaType)
 	req.Header.Set("User-Agent", c.UserAgent)
 
-	return req, nil
+	return c.withJournalBody(req, body), nil
 }
 
 // newResponse creates a new Response for the provided http.Response
@@ -436,12 +503,39 @@ func newResponse(r *http.Response) *Response {
 // Do sends an API request and returns the API response. The API response is JSON decoded and stored in the value
 // pointed to by v, or returned as an error if an API error has occurred. If v implements the io.Writer interface,
 // the raw response will be written to v, without attempting to decode it.
-func (c *Client) Do(ctx context.Context, req *http.Request, v interface{}) (*Response, error) {
-	resp, err := DoRequestWithClient(ctx, c.client, req)
+func (c *Client) Do(ctx context.Context, req *http.Request, v interface{}) (_ *Response, err error) {
+	var record *JournalRecord
+	if c.journal != nil && isMutating(req.Method) {
+		record = c.newJournalRecord(ctx, req)
+	}
+
+	resp, err := c.doWithRetries(ctx, req)
 	if err != nil {
+		if record != nil {
+			record.setOutcome(nil, nil, err)
+			if jerr := c.journal.Append(record); jerr != nil {
+				err = errors.Join(err, fmt.Errorf("audit journal: %w", jerr))
+			}
+		}
 		return nil, err
 	}
 
+	if record != nil {
+		// the response body is buffered for the object ID of the journal record
+		body, rerr := io.ReadAll(resp.Body)
+		resp.Body.Close()
+		resp.Body = io.NopCloser(bytes.NewReader(body))
+		record.setOutcome(resp, body, rerr)
+		defer func() {
+			if jerr := c.journal.Append(record); jerr != nil {
+				err = errors.Join(err, fmt.Errorf("audit journal: %w", jerr))
+			}
+		}()
+		if rerr != nil {
+			return nil, rerr
+		}
+	}
+
 	defer func() {
 		// Ensure the response body is fully read and closed
 		// before we reconnect, so that we reuse the same TCPConnection.
@@ -473,7 +567,11 @@ func (c *Client) Do(ctx context.Context, req *http.Request, v interface{}) (*Res
 				return nil, err
 			}
 		} else {
-			err = json.NewDecoder(resp.Body).Decode(v)
+			body, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			err = c.decodeJSON(body, v)
 			if err != nil {
 				return nil, err
 			}
@@ -483,6 +581,76 @@ func (c *Client) Do(ctx context.Context, req *http.Request, v interface{}) (*Res
 	return response, err
 }
 
+// doWithRetries submits an HTTP request, retrying it according to the retry policy of the client.
+func (c *Client) doWithRetries(ctx context.Context, req *http.Request) (*http.Response, error) {
+	p := c.retryPolicy
+	if p == nil || !isIdempotent(req.Method) {
+		return DoRequestWithClient(ctx, c.client, req)
+	}
+	backoff := p.MinBackoff
+	for attempt := 0; ; attempt++ {
+		if attempt > 0 && req.GetBody != nil {
+			body, err := req.GetBody()
+			if err != nil {
+				return nil, err
+			}
+			req.Body = body
+		}
+		resp, err := DoRequestWithClient(ctx, c.client, req)
+		if attempt >= p.MaxRetries || ctx.Err() != nil || (err == nil && !isRetryableStatus(resp.StatusCode)) {
+			return resp, err
+		}
+		wait := backoff
+		if err == nil {
+			if ra, ok := retryAfter(resp); ok {
+				wait = ra
+			}
+			io.Copy(io.Discard, resp.Body)
+			resp.Body.Close()
+		}
+		wait = min(wait, p.MaxBackoff)
+		t := time.NewTimer(wait)
+		select {
+		case <-ctx.Done():
+			t.Stop()
+			return nil, ctx.Err()
+		case <-t.C:
+		}
+		backoff = min(2*backoff, p.MaxBackoff)
+	}
+}
+
+func isIdempotent(method string) bool {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodPut, http.MethodDelete:
+		return true
+	}
+	return false
+}
+
+func isRetryableStatus(code int) bool {
+	switch code {
+	case http.StatusTooManyRequests, http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		return true
+	}
+	return false
+}
+
+// retryAfter returns the wait from the Retry-After header of a response, in seconds or as an HTTP date.
+func retryAfter(resp *http.Response) (time.Duration, bool) {
+	v := resp.Header.Get("Retry-After")
+	if v == "" {
+		return 0, false
+	}
+	if s, err := strconv.Atoi(v); err == nil && s >= 0 {
+		return time.Duration(s) * time.Second, true
+	}
+	if t, err := http.ParseTime(v); err == nil {
+		return max(time.Until(t), 0), true
+	}
+	return 0, false
+}
+
 // EndpointOptions makes an OPTIONS request on a path and returns the metadata of the endpoint.
 func (c *Client) EndpointOptions(ctx context.Context, path string) (*EndpointOptions, *Response, error) {
 	req, err := c.NewRequest(ctx, http.MethodOptions, path, nil)
@@ -540,6 +708,10 @@ func Bool(v bool) *bool { return &v }
 // to store v and returns a pointer to it.
 func Int(v int) *int { return &v }
 
+// Int64 is a helper routine that allocates a new int64 value
+// to store v and returns a pointer to it.
+func Int64(v int64) *int64 { return &v }
+
 // String is a helper routine that allocates a new string value
 // to store v and returns a pointer to it.
 func String(v string) *string { return &v }
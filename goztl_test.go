@@ -7,6 +7,7 @@ import (
 	"net/http"
 	"net/http/httptest"
 	"testing"
+	"time"
 
 	"github.com/google/go-cmp/cmp"
 )
@@ -141,3 +142,155 @@ func TestResolveAllPagesUnpaginatedEndpoint(t *testing.T) {
 		t.Errorf("resolveAllPages made %d requests, want 1", requests)
 	}
 }
+
+func TestRetryPolicy(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	if err := SetRetryPolicy(RetryPolicy{MaxRetries: 2, MinBackoff: time.Millisecond})(client); err != nil {
+		t.Fatalf("SetRetryPolicy returned error: %v", err)
+	}
+
+	var requests int
+
+	mux.HandleFunc("/test/items/1/", func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		testBody(t, r, `{"id":1,"name":"un"}`+"\n")
+		if requests < 3 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		fmt.Fprint(w, `{"id": 1, "name": "un"}`)
+	})
+
+	ctx := context.Background()
+	req, err := client.NewRequest(ctx, "PUT", "test/items/1/", &rapTestItem{ID: 1, Name: "un"})
+	if err != nil {
+		t.Fatalf("NewRequest returned error: %v", err)
+	}
+	item := new(rapTestItem)
+	if _, err := client.Do(ctx, req, item); err != nil {
+		t.Errorf("Do returned error: %v", err)
+	}
+	if want := (&rapTestItem{ID: 1, Name: "un"}); !cmp.Equal(item, want) {
+		t.Errorf("Do returned %+v, want %+v", item, want)
+	}
+	if requests != 3 {
+		t.Errorf("Do made %d requests, want 3", requests)
+	}
+
+	// POST requests are not retried
+	requests = 0
+	mux.HandleFunc("/test/items/", func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		w.WriteHeader(http.StatusServiceUnavailable)
+	})
+	req, err = client.NewRequest(ctx, "POST", "test/items/", &rapTestItem{Name: "deux"})
+	if err != nil {
+		t.Fatalf("NewRequest returned error: %v", err)
+	}
+	if _, err := client.Do(ctx, req, nil); err == nil {
+		t.Error("Do returned no error")
+	}
+	if requests != 1 {
+		t.Errorf("Do made %d requests, want 1", requests)
+	}
+}
+
+func TestRetryPolicyRetryAfter(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	// the Retry-After wait is capped by MaxBackoff
+	if err := SetRetryPolicy(RetryPolicy{MaxRetries: 1, MinBackoff: time.Millisecond, MaxBackoff: 10 * time.Millisecond})(client); err != nil {
+		t.Fatalf("SetRetryPolicy returned error: %v", err)
+	}
+
+	var requests int
+
+	mux.HandleFunc("/test/items/", func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		w.Header().Set("Retry-After", "3600")
+		w.WriteHeader(http.StatusTooManyRequests)
+	})
+
+	ctx := context.Background()
+	req, err := client.NewRequest(ctx, "GET", "test/items/", nil)
+	if err != nil {
+		t.Fatalf("NewRequest returned error: %v", err)
+	}
+	start := time.Now()
+	_, err = client.Do(ctx, req, nil)
+	if err == nil {
+		t.Error("Do returned no error")
+	}
+	if d := time.Since(start); d > time.Second {
+		t.Errorf("Do waited %s", d)
+	}
+	if requests != 2 {
+		t.Errorf("Do made %d requests, want 2", requests)
+	}
+}
+
+func TestRetryPolicyContextDone(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	if err := SetRetryPolicy(RetryPolicy{MaxRetries: 5, MinBackoff: time.Hour})(client); err != nil {
+		t.Fatalf("SetRetryPolicy returned error: %v", err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	mux.HandleFunc("/test/items/", func(w http.ResponseWriter, r *http.Request) {
+		cancel()
+		w.WriteHeader(http.StatusBadGateway)
+	})
+
+	req, err := client.NewRequest(ctx, "GET", "test/items/", nil)
+	if err != nil {
+		t.Fatalf("NewRequest returned error: %v", err)
+	}
+	if _, err := client.Do(ctx, req, nil); err == nil {
+		t.Error("Do returned no error")
+	}
+}
+
+func TestSetRetryPolicy(t *testing.T) {
+	client, _, teardown := setup()
+	defer teardown()
+
+	if err := SetRetryPolicy(RetryPolicy{MaxRetries: -1})(client); err == nil {
+		t.Error("SetRetryPolicy returned no error for a negative MaxRetries")
+	}
+
+	if err := SetRetryPolicy(RetryPolicy{MaxRetries: 3})(client); err != nil {
+		t.Fatalf("SetRetryPolicy returned error: %v", err)
+	}
+	want := &RetryPolicy{MaxRetries: 3, MinBackoff: 500 * time.Millisecond, MaxBackoff: 30 * time.Second}
+	if !cmp.Equal(client.retryPolicy, want) {
+		t.Errorf("SetRetryPolicy set %+v, want %+v", client.retryPolicy, want)
+	}
+}
+
+func TestRetryAfter(t *testing.T) {
+	for _, tc := range []struct {
+		header string
+		want   time.Duration
+		ok     bool
+	}{
+		{"", 0, false},
+		{"120", 2 * time.Minute, true},
+		{"-1", 0, false},
+		{"Wed, 21 Oct 2015 07:28:00 GMT", 0, true},
+		{"yolo", 0, false},
+	} {
+		resp := &http.Response{Header: http.Header{}}
+		if tc.header != "" {
+			resp.Header.Set("Retry-After", tc.header)
+		}
+		got, ok := retryAfter(resp)
+		if got != tc.want || ok != tc.ok {
+			t.Errorf("retryAfter(%q) = %s, %v, want %s, %v", tc.header, got, ok, tc.want, tc.ok)
+		}
+	}
+}
@@ -0,0 +1,5344 @@
+// Code generated by go generate; DO NOT EDIT.
+
+package goztlfake
+
+import (
+	"context"
+	"io"
+	"time"
+
+	"github.com/zentralopensource/goztl"
+)
+
+// Fakes holds a fake for every service of a goztl.Client.
+type Fakes struct {
+	AccountsAPITokens                  *AccountsAPITokensService
+	AccountsUsers                      *AccountsUsersService
+	Roles                              *RolesService
+	GWSConnections                     *GWSConnectionsService
+	GWSGroupTagMappings                *GWSGroupTagMappingsService
+	ComplianceChecks                   *ComplianceChecksService
+	InventoryMachines                  *InventoryMachinesService
+	JMESPathChecks                     *JMESPathChecksService
+	MetaBusinessUnits                  *MetaBusinessUnitsService
+	Tags                               *TagsService
+	Taxonomies                         *TaxonomiesService
+	MDMACMEIssuers                     *MDMACMEIssuersService
+	MDMArtifacts                       *MDMArtifactsService
+	MDMBlueprints                      *MDMBlueprintsService
+	MDMBlueprintArtifacts              *MDMBlueprintArtifactsService
+	MDMDataAssets                      *MDMDataAssetsService
+	MDMCertAssets                      *MDMCertAssetsService
+	MDMDeclarations                    *MDMDeclarationsService
+	MDMDEPEnrollments                  *MDMDEPEnrollmentsService
+	MDMDEPEnrollmentCustomViews        *MDMDEPEnrollmentCustomViewsService
+	MDMDEPVirtualServers               *MDMDEPVirtualServersService
+	MDMEnterpriseApps                  *MDMEnterpriseAppsService
+	MDMEnrollmentCustomViews           *MDMEnrollmentCustomViewsService
+	MDMFileVaultConfigs                *MDMFileVaultConfigsService
+	MDMLocations                       *MDMLocationsService
+	MDMLocationAssets                  *MDMLocationAssetsService
+	MDMOTAEnrollments                  *MDMOTAEnrollmentsService
+	MDMPackages                        *MDMPackagesService
+	MDMProfiles                        *MDMProfilesService
+	MDMProvisioningProfiles            *MDMProvisioningProfilesService
+	MDMPushCertificates                *MDMPushCertificatesService
+	MDMRecoveryPasswordConfigs         *MDMRecoveryPasswordConfigsService
+	MDMSCEPIssuers                     *MDMSCEPIssuersService
+	MDMSoftwareUpdateEnforcements      *MDMSoftwareUpdateEnforcementsService
+	MDMStoreApps                       *MDMStoreAppsService
+	MonolithCatalogs                   *MonolithCatalogsService
+	MonolithConditions                 *MonolithConditionsService
+	MonolithEnrollments                *MonolithEnrollmentsService
+	MonolithManifests                  *MonolithManifestsService
+	MonolithManifestCatalogs           *MonolithManifestCatalogsService
+	MonolithManifestEnrollmentPackages *MonolithManifestEnrollmentPackagesService
+	MonolithManifestSubManifests       *MonolithManifestSubManifestsService
+	MonolithRepositories               *MonolithRepositoriesService
+	MonolithSubManifests               *MonolithSubManifestsService
+	MonolithSubManifestPkgInfos        *MonolithSubManifestPkgInfosService
+	MunkiConfigurations                *MunkiConfigurationsService
+	MunkiEnrollments                   *MunkiEnrollmentsService
+	MunkiScriptChecks                  *MunkiScriptChecksService
+	OsqueryATC                         *OsqueryATCService
+	OsqueryConfigurations              *OsqueryConfigurationsService
+	OsqueryConfigurationPacks          *OsqueryConfigurationPacksService
+	OsqueryEnrollments                 *OsqueryEnrollmentsService
+	OsqueryFileCategories              *OsqueryFileCategoriesService
+	OsqueryPacks                       *OsqueryPacksService
+	OsqueryQueries                     *OsqueryQueriesService
+	Probes                             *ProbesService
+	ProbesActions                      *ProbesActionsService
+	RealmsGroups                       *RealmsGroupsService
+	RealmsRealms                       *RealmsRealmsService
+	RealmsRoleMappings                 *RealmsRoleMappingsService
+	RealmsTagMappings                  *RealmsTagMappingsService
+	SantaConfigurations                *SantaConfigurationsService
+	SantaEnrollments                   *SantaEnrollmentsService
+	SantaRules                         *SantaRulesService
+	Stores                             *StoresService
+	Tasks                              *TasksService
+	TurboConfigurations                *TurboConfigurationsService
+	TurboEnrollments                   *TurboEnrollmentsService
+	TurboMSCPChecks                    *TurboMSCPChecksService
+	TurboOneTimeJobs                   *TurboOneTimeJobsService
+	TurboRecurringJobs                 *TurboRecurringJobsService
+	TurboScripts                       *TurboScriptsService
+}
+
+// NewClient returns a goztl.Client with all its services replaced by fakes, and the fakes.
+func NewClient() (*goztl.Client, *Fakes) {
+	c, err := goztl.NewClient(nil, fakeBaseURL, "")
+	if err != nil {
+		panic(err)
+	}
+	f := &Fakes{
+		AccountsAPITokens:                  &AccountsAPITokensService{},
+		AccountsUsers:                      &AccountsUsersService{},
+		Roles:                              &RolesService{},
+		GWSConnections:                     &GWSConnectionsService{},
+		GWSGroupTagMappings:                &GWSGroupTagMappingsService{},
+		ComplianceChecks:                   &ComplianceChecksService{},
+		InventoryMachines:                  &InventoryMachinesService{},
+		JMESPathChecks:                     &JMESPathChecksService{},
+		MetaBusinessUnits:                  &MetaBusinessUnitsService{},
+		Tags:                               &TagsService{},
+		Taxonomies:                         &TaxonomiesService{},
+		MDMACMEIssuers:                     &MDMACMEIssuersService{},
+		MDMArtifacts:                       &MDMArtifactsService{},
+		MDMBlueprints:                      &MDMBlueprintsService{},
+		MDMBlueprintArtifacts:              &MDMBlueprintArtifactsService{},
+		MDMDataAssets:                      &MDMDataAssetsService{},
+		MDMCertAssets:                      &MDMCertAssetsService{},
+		MDMDeclarations:                    &MDMDeclarationsService{},
+		MDMDEPEnrollments:                  &MDMDEPEnrollmentsService{},
+		MDMDEPEnrollmentCustomViews:        &MDMDEPEnrollmentCustomViewsService{},
+		MDMDEPVirtualServers:               &MDMDEPVirtualServersService{},
+		MDMEnterpriseApps:                  &MDMEnterpriseAppsService{},
+		MDMEnrollmentCustomViews:           &MDMEnrollmentCustomViewsService{},
+		MDMFileVaultConfigs:                &MDMFileVaultConfigsService{},
+		MDMLocations:                       &MDMLocationsService{},
+		MDMLocationAssets:                  &MDMLocationAssetsService{},
+		MDMOTAEnrollments:                  &MDMOTAEnrollmentsService{},
+		MDMPackages:                        &MDMPackagesService{},
+		MDMProfiles:                        &MDMProfilesService{},
+		MDMProvisioningProfiles:            &MDMProvisioningProfilesService{},
+		MDMPushCertificates:                &MDMPushCertificatesService{},
+		MDMRecoveryPasswordConfigs:         &MDMRecoveryPasswordConfigsService{},
+		MDMSCEPIssuers:                     &MDMSCEPIssuersService{},
+		MDMSoftwareUpdateEnforcements:      &MDMSoftwareUpdateEnforcementsService{},
+		MDMStoreApps:                       &MDMStoreAppsService{},
+		MonolithCatalogs:                   &MonolithCatalogsService{},
+		MonolithConditions:                 &MonolithConditionsService{},
+		MonolithEnrollments:                &MonolithEnrollmentsService{},
+		MonolithManifests:                  &MonolithManifestsService{},
+		MonolithManifestCatalogs:           &MonolithManifestCatalogsService{},
+		MonolithManifestEnrollmentPackages: &MonolithManifestEnrollmentPackagesService{},
+		MonolithManifestSubManifests:       &MonolithManifestSubManifestsService{},
+		MonolithRepositories:               &MonolithRepositoriesService{},
+		MonolithSubManifests:               &MonolithSubManifestsService{},
+		MonolithSubManifestPkgInfos:        &MonolithSubManifestPkgInfosService{},
+		MunkiConfigurations:                &MunkiConfigurationsService{},
+		MunkiEnrollments:                   &MunkiEnrollmentsService{},
+		MunkiScriptChecks:                  &MunkiScriptChecksService{},
+		OsqueryATC:                         &OsqueryATCService{},
+		OsqueryConfigurations:              &OsqueryConfigurationsService{},
+		OsqueryConfigurationPacks:          &OsqueryConfigurationPacksService{},
+		OsqueryEnrollments:                 &OsqueryEnrollmentsService{},
+		OsqueryFileCategories:              &OsqueryFileCategoriesService{},
+		OsqueryPacks:                       &OsqueryPacksService{},
+		OsqueryQueries:                     &OsqueryQueriesService{},
+		Probes:                             &ProbesService{},
+		ProbesActions:                      &ProbesActionsService{},
+		RealmsGroups:                       &RealmsGroupsService{},
+		RealmsRealms:                       &RealmsRealmsService{},
+		RealmsRoleMappings:                 &RealmsRoleMappingsService{},
+		RealmsTagMappings:                  &RealmsTagMappingsService{},
+		SantaConfigurations:                &SantaConfigurationsService{},
+		SantaEnrollments:                   &SantaEnrollmentsService{},
+		SantaRules:                         &SantaRulesService{},
+		Stores:                             &StoresService{},
+		Tasks:                              &TasksService{},
+		TurboConfigurations:                &TurboConfigurationsService{},
+		TurboEnrollments:                   &TurboEnrollmentsService{},
+		TurboMSCPChecks:                    &TurboMSCPChecksService{},
+		TurboOneTimeJobs:                   &TurboOneTimeJobsService{},
+		TurboRecurringJobs:                 &TurboRecurringJobsService{},
+		TurboScripts:                       &TurboScriptsService{},
+	}
+	c.AccountsAPITokens = f.AccountsAPITokens
+	c.AccountsUsers = f.AccountsUsers
+	c.Roles = f.Roles
+	c.GWSConnections = f.GWSConnections
+	c.GWSGroupTagMappings = f.GWSGroupTagMappings
+	c.ComplianceChecks = f.ComplianceChecks
+	c.InventoryMachines = f.InventoryMachines
+	c.JMESPathChecks = f.JMESPathChecks
+	c.MetaBusinessUnits = f.MetaBusinessUnits
+	c.Tags = f.Tags
+	c.Taxonomies = f.Taxonomies
+	c.MDMACMEIssuers = f.MDMACMEIssuers
+	c.MDMArtifacts = f.MDMArtifacts
+	c.MDMBlueprints = f.MDMBlueprints
+	c.MDMBlueprintArtifacts = f.MDMBlueprintArtifacts
+	c.MDMDataAssets = f.MDMDataAssets
+	c.MDMCertAssets = f.MDMCertAssets
+	c.MDMDeclarations = f.MDMDeclarations
+	c.MDMDEPEnrollments = f.MDMDEPEnrollments
+	c.MDMDEPEnrollmentCustomViews = f.MDMDEPEnrollmentCustomViews
+	c.MDMDEPVirtualServers = f.MDMDEPVirtualServers
+	c.MDMEnterpriseApps = f.MDMEnterpriseApps
+	c.MDMEnrollmentCustomViews = f.MDMEnrollmentCustomViews
+	c.MDMFileVaultConfigs = f.MDMFileVaultConfigs
+	c.MDMLocations = f.MDMLocations
+	c.MDMLocationAssets = f.MDMLocationAssets
+	c.MDMOTAEnrollments = f.MDMOTAEnrollments
+	c.MDMPackages = f.MDMPackages
+	c.MDMProfiles = f.MDMProfiles
+	c.MDMProvisioningProfiles = f.MDMProvisioningProfiles
+	c.MDMPushCertificates = f.MDMPushCertificates
+	c.MDMRecoveryPasswordConfigs = f.MDMRecoveryPasswordConfigs
+	c.MDMSCEPIssuers = f.MDMSCEPIssuers
+	c.MDMSoftwareUpdateEnforcements = f.MDMSoftwareUpdateEnforcements
+	c.MDMStoreApps = f.MDMStoreApps
+	c.MonolithCatalogs = f.MonolithCatalogs
+	c.MonolithConditions = f.MonolithConditions
+	c.MonolithEnrollments = f.MonolithEnrollments
+	c.MonolithManifests = f.MonolithManifests
+	c.MonolithManifestCatalogs = f.MonolithManifestCatalogs
+	c.MonolithManifestEnrollmentPackages = f.MonolithManifestEnrollmentPackages
+	c.MonolithManifestSubManifests = f.MonolithManifestSubManifests
+	c.MonolithRepositories = f.MonolithRepositories
+	c.MonolithSubManifests = f.MonolithSubManifests
+	c.MonolithSubManifestPkgInfos = f.MonolithSubManifestPkgInfos
+	c.MunkiConfigurations = f.MunkiConfigurations
+	c.MunkiEnrollments = f.MunkiEnrollments
+	c.MunkiScriptChecks = f.MunkiScriptChecks
+	c.OsqueryATC = f.OsqueryATC
+	c.OsqueryConfigurations = f.OsqueryConfigurations
+	c.OsqueryConfigurationPacks = f.OsqueryConfigurationPacks
+	c.OsqueryEnrollments = f.OsqueryEnrollments
+	c.OsqueryFileCategories = f.OsqueryFileCategories
+	c.OsqueryPacks = f.OsqueryPacks
+	c.OsqueryQueries = f.OsqueryQueries
+	c.Probes = f.Probes
+	c.ProbesActions = f.ProbesActions
+	c.RealmsGroups = f.RealmsGroups
+	c.RealmsRealms = f.RealmsRealms
+	c.RealmsRoleMappings = f.RealmsRoleMappings
+	c.RealmsTagMappings = f.RealmsTagMappings
+	c.SantaConfigurations = f.SantaConfigurations
+	c.SantaEnrollments = f.SantaEnrollments
+	c.SantaRules = f.SantaRules
+	c.Stores = f.Stores
+	c.Tasks = f.Tasks
+	c.TurboConfigurations = f.TurboConfigurations
+	c.TurboEnrollments = f.TurboEnrollments
+	c.TurboMSCPChecks = f.TurboMSCPChecks
+	c.TurboOneTimeJobs = f.TurboOneTimeJobs
+	c.TurboRecurringJobs = f.TurboRecurringJobs
+	c.TurboScripts = f.TurboScripts
+	return c, f
+}
+
+// AccountsAPITokensService is a fake goztl.AccountsAPITokensService.
+type AccountsAPITokensService struct {
+	Recorder
+	ListFunc         func(context.Context, *goztl.ListOptions) ([]goztl.APIToken, *goztl.Response, error)
+	ListByUserIDFunc func(context.Context, int) ([]goztl.APIToken, *goztl.Response, error)
+	GetByIDFunc      func(context.Context, string) (*goztl.APIToken, *goztl.Response, error)
+	CreateFunc       func(context.Context, *goztl.APITokenRequest) (*goztl.APIToken, *goztl.Response, error)
+	DeleteFunc       func(context.Context, string) (*goztl.Response, error)
+}
+
+var _ goztl.AccountsAPITokensService = &AccountsAPITokensService{}
+
+// List records the call and returns the results of ListFunc.
+func (f *AccountsAPITokensService) List(a0 context.Context, a1 *goztl.ListOptions) (r0 []goztl.APIToken, r1 *goztl.Response, r2 error) {
+	f.record("List", a1)
+	if f.ListFunc == nil {
+		r2 = errNotStubbed("AccountsAPITokensService", "List")
+		return
+	}
+	return f.ListFunc(a0, a1)
+}
+
+// ListByUserID records the call and returns the results of ListByUserIDFunc.
+func (f *AccountsAPITokensService) ListByUserID(a0 context.Context, a1 int) (r0 []goztl.APIToken, r1 *goztl.Response, r2 error) {
+	f.record("ListByUserID", a1)
+	if f.ListByUserIDFunc == nil {
+		r2 = errNotStubbed("AccountsAPITokensService", "ListByUserID")
+		return
+	}
+	return f.ListByUserIDFunc(a0, a1)
+}
+
+// GetByID records the call and returns the results of GetByIDFunc.
+func (f *AccountsAPITokensService) GetByID(a0 context.Context, a1 string) (r0 *goztl.APIToken, r1 *goztl.Response, r2 error) {
+	f.record("GetByID", a1)
+	if f.GetByIDFunc == nil {
+		r2 = errNotStubbed("AccountsAPITokensService", "GetByID")
+		return
+	}
+	return f.GetByIDFunc(a0, a1)
+}
+
+// Create records the call and returns the results of CreateFunc.
+func (f *AccountsAPITokensService) Create(a0 context.Context, a1 *goztl.APITokenRequest) (r0 *goztl.APIToken, r1 *goztl.Response, r2 error) {
+	f.record("Create", a1)
+	if f.CreateFunc == nil {
+		r2 = errNotStubbed("AccountsAPITokensService", "Create")
+		return
+	}
+	return f.CreateFunc(a0, a1)
+}
+
+// Delete records the call and returns the results of DeleteFunc.
+func (f *AccountsAPITokensService) Delete(a0 context.Context, a1 string) (r0 *goztl.Response, r1 error) {
+	f.record("Delete", a1)
+	if f.DeleteFunc == nil {
+		r1 = errNotStubbed("AccountsAPITokensService", "Delete")
+		return
+	}
+	return f.DeleteFunc(a0, a1)
+}
+
+// AccountsUsersService is a fake goztl.AccountsUsersService.
+type AccountsUsersService struct {
+	Recorder
+	ListFunc          func(context.Context, *goztl.ListOptions) ([]goztl.User, *goztl.Response, error)
+	GetByIDFunc       func(context.Context, int) (*goztl.User, *goztl.Response, error)
+	GetByUsernameFunc func(context.Context, string) (*goztl.User, *goztl.Response, error)
+	GetByEmailFunc    func(context.Context, string) (*goztl.User, *goztl.Response, error)
+	CreateFunc        func(context.Context, *goztl.UserRequest) (*goztl.User, *goztl.Response, error)
+	UpdateFunc        func(context.Context, int, *goztl.UserRequest) (*goztl.User, *goztl.Response, error)
+	DeleteFunc        func(context.Context, int) (*goztl.Response, error)
+}
+
+var _ goztl.AccountsUsersService = &AccountsUsersService{}
+
+// List records the call and returns the results of ListFunc.
+func (f *AccountsUsersService) List(a0 context.Context, a1 *goztl.ListOptions) (r0 []goztl.User, r1 *goztl.Response, r2 error) {
+	f.record("List", a1)
+	if f.ListFunc == nil {
+		r2 = errNotStubbed("AccountsUsersService", "List")
+		return
+	}
+	return f.ListFunc(a0, a1)
+}
+
+// GetByID records the call and returns the results of GetByIDFunc.
+func (f *AccountsUsersService) GetByID(a0 context.Context, a1 int) (r0 *goztl.User, r1 *goztl.Response, r2 error) {
+	f.record("GetByID", a1)
+	if f.GetByIDFunc == nil {
+		r2 = errNotStubbed("AccountsUsersService", "GetByID")
+		return
+	}
+	return f.GetByIDFunc(a0, a1)
+}
+
+// GetByUsername records the call and returns the results of GetByUsernameFunc.
+func (f *AccountsUsersService) GetByUsername(a0 context.Context, a1 string) (r0 *goztl.User, r1 *goztl.Response, r2 error) {
+	f.record("GetByUsername", a1)
+	if f.GetByUsernameFunc == nil {
+		r2 = errNotStubbed("AccountsUsersService", "GetByUsername")
+		return
+	}
+	return f.GetByUsernameFunc(a0, a1)
+}
+
+// GetByEmail records the call and returns the results of GetByEmailFunc.
+func (f *AccountsUsersService) GetByEmail(a0 context.Context, a1 string) (r0 *goztl.User, r1 *goztl.Response, r2 error) {
+	f.record("GetByEmail", a1)
+	if f.GetByEmailFunc == nil {
+		r2 = errNotStubbed("AccountsUsersService", "GetByEmail")
+		return
+	}
+	return f.GetByEmailFunc(a0, a1)
+}
+
+// Create records the call and returns the results of CreateFunc.
+func (f *AccountsUsersService) Create(a0 context.Context, a1 *goztl.UserRequest) (r0 *goztl.User, r1 *goztl.Response, r2 error) {
+	f.record("Create", a1)
+	if f.CreateFunc == nil {
+		r2 = errNotStubbed("AccountsUsersService", "Create")
+		return
+	}
+	return f.CreateFunc(a0, a1)
+}
+
+// Update records the call and returns the results of UpdateFunc.
+func (f *AccountsUsersService) Update(a0 context.Context, a1 int, a2 *goztl.UserRequest) (r0 *goztl.User, r1 *goztl.Response, r2 error) {
+	f.record("Update", a1, a2)
+	if f.UpdateFunc == nil {
+		r2 = errNotStubbed("AccountsUsersService", "Update")
+		return
+	}
+	return f.UpdateFunc(a0, a1, a2)
+}
+
+// Delete records the call and returns the results of DeleteFunc.
+func (f *AccountsUsersService) Delete(a0 context.Context, a1 int) (r0 *goztl.Response, r1 error) {
+	f.record("Delete", a1)
+	if f.DeleteFunc == nil {
+		r1 = errNotStubbed("AccountsUsersService", "Delete")
+		return
+	}
+	return f.DeleteFunc(a0, a1)
+}
+
+// ComplianceChecksService is a fake goztl.ComplianceChecksService.
+type ComplianceChecksService struct {
+	Recorder
+	ListFunc                       func(context.Context, *goztl.ListOptions) ([]goztl.ComplianceCheck, *goztl.Response, error)
+	GetByIDFunc                    func(context.Context, int) (*goztl.ComplianceCheck, *goztl.Response, error)
+	ListStatusesByCheckIDFunc      func(context.Context, int, *goztl.ListOptions) ([]goztl.ComplianceCheckStatus, *goztl.Response, error)
+	ListStatusesBySerialNumberFunc func(context.Context, string, *goztl.ListOptions) ([]goztl.ComplianceCheckStatus, *goztl.Response, error)
+}
+
+var _ goztl.ComplianceChecksService = &ComplianceChecksService{}
+
+// List records the call and returns the results of ListFunc.
+func (f *ComplianceChecksService) List(a0 context.Context, a1 *goztl.ListOptions) (r0 []goztl.ComplianceCheck, r1 *goztl.Response, r2 error) {
+	f.record("List", a1)
+	if f.ListFunc == nil {
+		r2 = errNotStubbed("ComplianceChecksService", "List")
+		return
+	}
+	return f.ListFunc(a0, a1)
+}
+
+// GetByID records the call and returns the results of GetByIDFunc.
+func (f *ComplianceChecksService) GetByID(a0 context.Context, a1 int) (r0 *goztl.ComplianceCheck, r1 *goztl.Response, r2 error) {
+	f.record("GetByID", a1)
+	if f.GetByIDFunc == nil {
+		r2 = errNotStubbed("ComplianceChecksService", "GetByID")
+		return
+	}
+	return f.GetByIDFunc(a0, a1)
+}
+
+// ListStatusesByCheckID records the call and returns the results of ListStatusesByCheckIDFunc.
+func (f *ComplianceChecksService) ListStatusesByCheckID(a0 context.Context, a1 int, a2 *goztl.ListOptions) (r0 []goztl.ComplianceCheckStatus, r1 *goztl.Response, r2 error) {
+	f.record("ListStatusesByCheckID", a1, a2)
+	if f.ListStatusesByCheckIDFunc == nil {
+		r2 = errNotStubbed("ComplianceChecksService", "ListStatusesByCheckID")
+		return
+	}
+	return f.ListStatusesByCheckIDFunc(a0, a1, a2)
+}
+
+// ListStatusesBySerialNumber records the call and returns the results of ListStatusesBySerialNumberFunc.
+func (f *ComplianceChecksService) ListStatusesBySerialNumber(a0 context.Context, a1 string, a2 *goztl.ListOptions) (r0 []goztl.ComplianceCheckStatus, r1 *goztl.Response, r2 error) {
+	f.record("ListStatusesBySerialNumber", a1, a2)
+	if f.ListStatusesBySerialNumberFunc == nil {
+		r2 = errNotStubbed("ComplianceChecksService", "ListStatusesBySerialNumber")
+		return
+	}
+	return f.ListStatusesBySerialNumberFunc(a0, a1, a2)
+}
+
+// GWSConnectionsService is a fake goztl.GWSConnectionsService.
+type GWSConnectionsService struct {
+	Recorder
+	ListFunc      func(context.Context, *goztl.ListOptions) ([]goztl.GWSConnection, *goztl.Response, error)
+	GetByIDFunc   func(context.Context, string) (*goztl.GWSConnection, *goztl.Response, error)
+	GetByNameFunc func(context.Context, string) (*goztl.GWSConnection, *goztl.Response, error)
+}
+
+var _ goztl.GWSConnectionsService = &GWSConnectionsService{}
+
+// List records the call and returns the results of ListFunc.
+func (f *GWSConnectionsService) List(a0 context.Context, a1 *goztl.ListOptions) (r0 []goztl.GWSConnection, r1 *goztl.Response, r2 error) {
+	f.record("List", a1)
+	if f.ListFunc == nil {
+		r2 = errNotStubbed("GWSConnectionsService", "List")
+		return
+	}
+	return f.ListFunc(a0, a1)
+}
+
+// GetByID records the call and returns the results of GetByIDFunc.
+func (f *GWSConnectionsService) GetByID(a0 context.Context, a1 string) (r0 *goztl.GWSConnection, r1 *goztl.Response, r2 error) {
+	f.record("GetByID", a1)
+	if f.GetByIDFunc == nil {
+		r2 = errNotStubbed("GWSConnectionsService", "GetByID")
+		return
+	}
+	return f.GetByIDFunc(a0, a1)
+}
+
+// GetByName records the call and returns the results of GetByNameFunc.
+func (f *GWSConnectionsService) GetByName(a0 context.Context, a1 string) (r0 *goztl.GWSConnection, r1 *goztl.Response, r2 error) {
+	f.record("GetByName", a1)
+	if f.GetByNameFunc == nil {
+		r2 = errNotStubbed("GWSConnectionsService", "GetByName")
+		return
+	}
+	return f.GetByNameFunc(a0, a1)
+}
+
+// GWSGroupTagMappingsService is a fake goztl.GWSGroupTagMappingsService.
+type GWSGroupTagMappingsService struct {
+	Recorder
+	ListFunc              func(context.Context, *goztl.ListOptions) ([]goztl.GWSGroupTagMapping, *goztl.Response, error)
+	GetByIDFunc           func(context.Context, string) (*goztl.GWSGroupTagMapping, *goztl.Response, error)
+	GetByConnectionIDFunc func(context.Context, string) ([]goztl.GWSGroupTagMapping, *goztl.Response, error)
+	GetByGroupEmailFunc   func(context.Context, string) ([]goztl.GWSGroupTagMapping, *goztl.Response, error)
+	CreateFunc            func(context.Context, *goztl.GWSGroupTagMappingRequest) (*goztl.GWSGroupTagMapping, *goztl.Response, error)
+	UpdateFunc            func(context.Context, string, *goztl.GWSGroupTagMappingRequest) (*goztl.GWSGroupTagMapping, *goztl.Response, error)
+	DeleteFunc            func(context.Context, string) (*goztl.Response, error)
+}
+
+var _ goztl.GWSGroupTagMappingsService = &GWSGroupTagMappingsService{}
+
+// List records the call and returns the results of ListFunc.
+func (f *GWSGroupTagMappingsService) List(a0 context.Context, a1 *goztl.ListOptions) (r0 []goztl.GWSGroupTagMapping, r1 *goztl.Response, r2 error) {
+	f.record("List", a1)
+	if f.ListFunc == nil {
+		r2 = errNotStubbed("GWSGroupTagMappingsService", "List")
+		return
+	}
+	return f.ListFunc(a0, a1)
+}
+
+// GetByID records the call and returns the results of GetByIDFunc.
+func (f *GWSGroupTagMappingsService) GetByID(a0 context.Context, a1 string) (r0 *goztl.GWSGroupTagMapping, r1 *goztl.Response, r2 error) {
+	f.record("GetByID", a1)
+	if f.GetByIDFunc == nil {
+		r2 = errNotStubbed("GWSGroupTagMappingsService", "GetByID")
+		return
+	}
+	return f.GetByIDFunc(a0, a1)
+}
+
+// GetByConnectionID records the call and returns the results of GetByConnectionIDFunc.
+func (f *GWSGroupTagMappingsService) GetByConnectionID(a0 context.Context, a1 string) (r0 []goztl.GWSGroupTagMapping, r1 *goztl.Response, r2 error) {
+	f.record("GetByConnectionID", a1)
+	if f.GetByConnectionIDFunc == nil {
+		r2 = errNotStubbed("GWSGroupTagMappingsService", "GetByConnectionID")
+		return
+	}
+	return f.GetByConnectionIDFunc(a0, a1)
+}
+
+// GetByGroupEmail records the call and returns the results of GetByGroupEmailFunc.
+func (f *GWSGroupTagMappingsService) GetByGroupEmail(a0 context.Context, a1 string) (r0 []goztl.GWSGroupTagMapping, r1 *goztl.Response, r2 error) {
+	f.record("GetByGroupEmail", a1)
+	if f.GetByGroupEmailFunc == nil {
+		r2 = errNotStubbed("GWSGroupTagMappingsService", "GetByGroupEmail")
+		return
+	}
+	return f.GetByGroupEmailFunc(a0, a1)
+}
+
+// Create records the call and returns the results of CreateFunc.
+func (f *GWSGroupTagMappingsService) Create(a0 context.Context, a1 *goztl.GWSGroupTagMappingRequest) (r0 *goztl.GWSGroupTagMapping, r1 *goztl.Response, r2 error) {
+	f.record("Create", a1)
+	if f.CreateFunc == nil {
+		r2 = errNotStubbed("GWSGroupTagMappingsService", "Create")
+		return
+	}
+	return f.CreateFunc(a0, a1)
+}
+
+// Update records the call and returns the results of UpdateFunc.
+func (f *GWSGroupTagMappingsService) Update(a0 context.Context, a1 string, a2 *goztl.GWSGroupTagMappingRequest) (r0 *goztl.GWSGroupTagMapping, r1 *goztl.Response, r2 error) {
+	f.record("Update", a1, a2)
+	if f.UpdateFunc == nil {
+		r2 = errNotStubbed("GWSGroupTagMappingsService", "Update")
+		return
+	}
+	return f.UpdateFunc(a0, a1, a2)
+}
+
+// Delete records the call and returns the results of DeleteFunc.
+func (f *GWSGroupTagMappingsService) Delete(a0 context.Context, a1 string) (r0 *goztl.Response, r1 error) {
+	f.record("Delete", a1)
+	if f.DeleteFunc == nil {
+		r1 = errNotStubbed("GWSGroupTagMappingsService", "Delete")
+		return
+	}
+	return f.DeleteFunc(a0, a1)
+}
+
+// InventoryMachinesService is a fake goztl.InventoryMachinesService.
+type InventoryMachinesService struct {
+	Recorder
+	ListFunc              func(context.Context, *goztl.ListOptions) ([]goztl.InventoryMachine, *goztl.Response, error)
+	SearchFunc            func(context.Context, *goztl.InventoryMachineFilter, *goztl.ListOptions) ([]goztl.InventoryMachine, *goztl.Response, error)
+	GetBySerialNumberFunc func(context.Context, string) (*goztl.InventoryMachine, *goztl.Response, error)
+	UpdateTagsFunc        func(context.Context, *goztl.InventoryMachineTagsRequest) (*goztl.InventoryMachineTagsResult, *goztl.Response, error)
+	ArchiveFunc           func(context.Context, []string) (*goztl.InventoryMachinesArchiveResult, *goztl.Response, error)
+	UnarchiveFunc         func(context.Context, []string) (*goztl.InventoryMachinesArchiveResult, *goztl.Response, error)
+	ArchiveDryRunFunc     func(context.Context, time.Time) (int, *goztl.Response, error)
+	CleanupFunc           func(context.Context, int) (*goztl.Task, *goztl.Response, error)
+	ExportFunc            func(context.Context, goztl.InventoryExportKind, *goztl.InventoryExportOptions) (*goztl.Task, *goztl.Response, error)
+	DownloadExportFunc    func(context.Context, goztl.InventoryExportKind, *goztl.InventoryExportOptions, io.Writer) (*goztl.InventoryExportFile, *goztl.Response, error)
+}
+
+var _ goztl.InventoryMachinesService = &InventoryMachinesService{}
+
+// List records the call and returns the results of ListFunc.
+func (f *InventoryMachinesService) List(a0 context.Context, a1 *goztl.ListOptions) (r0 []goztl.InventoryMachine, r1 *goztl.Response, r2 error) {
+	f.record("List", a1)
+	if f.ListFunc == nil {
+		r2 = errNotStubbed("InventoryMachinesService", "List")
+		return
+	}
+	return f.ListFunc(a0, a1)
+}
+
+// Search records the call and returns the results of SearchFunc.
+func (f *InventoryMachinesService) Search(a0 context.Context, a1 *goztl.InventoryMachineFilter, a2 *goztl.ListOptions) (r0 []goztl.InventoryMachine, r1 *goztl.Response, r2 error) {
+	f.record("Search", a1, a2)
+	if f.SearchFunc == nil {
+		r2 = errNotStubbed("InventoryMachinesService", "Search")
+		return
+	}
+	return f.SearchFunc(a0, a1, a2)
+}
+
+// GetBySerialNumber records the call and returns the results of GetBySerialNumberFunc.
+func (f *InventoryMachinesService) GetBySerialNumber(a0 context.Context, a1 string) (r0 *goztl.InventoryMachine, r1 *goztl.Response, r2 error) {
+	f.record("GetBySerialNumber", a1)
+	if f.GetBySerialNumberFunc == nil {
+		r2 = errNotStubbed("InventoryMachinesService", "GetBySerialNumber")
+		return
+	}
+	return f.GetBySerialNumberFunc(a0, a1)
+}
+
+// UpdateTags records the call and returns the results of UpdateTagsFunc.
+func (f *InventoryMachinesService) UpdateTags(a0 context.Context, a1 *goztl.InventoryMachineTagsRequest) (r0 *goztl.InventoryMachineTagsResult, r1 *goztl.Response, r2 error) {
+	f.record("UpdateTags", a1)
+	if f.UpdateTagsFunc == nil {
+		r2 = errNotStubbed("InventoryMachinesService", "UpdateTags")
+		return
+	}
+	return f.UpdateTagsFunc(a0, a1)
+}
+
+// Archive records the call and returns the results of ArchiveFunc.
+func (f *InventoryMachinesService) Archive(a0 context.Context, a1 []string) (r0 *goztl.InventoryMachinesArchiveResult, r1 *goztl.Response, r2 error) {
+	f.record("Archive", a1)
+	if f.ArchiveFunc == nil {
+		r2 = errNotStubbed("InventoryMachinesService", "Archive")
+		return
+	}
+	return f.ArchiveFunc(a0, a1)
+}
+
+// Unarchive records the call and returns the results of UnarchiveFunc.
+func (f *InventoryMachinesService) Unarchive(a0 context.Context, a1 []string) (r0 *goztl.InventoryMachinesArchiveResult, r1 *goztl.Response, r2 error) {
+	f.record("Unarchive", a1)
+	if f.UnarchiveFunc == nil {
+		r2 = errNotStubbed("InventoryMachinesService", "Unarchive")
+		return
+	}
+	return f.UnarchiveFunc(a0, a1)
+}
+
+// ArchiveDryRun records the call and returns the results of ArchiveDryRunFunc.
+func (f *InventoryMachinesService) ArchiveDryRun(a0 context.Context, a1 time.Time) (r0 int, r1 *goztl.Response, r2 error) {
+	f.record("ArchiveDryRun", a1)
+	if f.ArchiveDryRunFunc == nil {
+		r2 = errNotStubbed("InventoryMachinesService", "ArchiveDryRun")
+		return
+	}
+	return f.ArchiveDryRunFunc(a0, a1)
+}
+
+// Cleanup records the call and returns the results of CleanupFunc.
+func (f *InventoryMachinesService) Cleanup(a0 context.Context, a1 int) (r0 *goztl.Task, r1 *goztl.Response, r2 error) {
+	f.record("Cleanup", a1)
+	if f.CleanupFunc == nil {
+		r2 = errNotStubbed("InventoryMachinesService", "Cleanup")
+		return
+	}
+	return f.CleanupFunc(a0, a1)
+}
+
+// Export records the call and returns the results of ExportFunc.
+func (f *InventoryMachinesService) Export(a0 context.Context, a1 goztl.InventoryExportKind, a2 *goztl.InventoryExportOptions) (r0 *goztl.Task, r1 *goztl.Response, r2 error) {
+	f.record("Export", a1, a2)
+	if f.ExportFunc == nil {
+		r2 = errNotStubbed("InventoryMachinesService", "Export")
+		return
+	}
+	return f.ExportFunc(a0, a1, a2)
+}
+
+// DownloadExport records the call and returns the results of DownloadExportFunc.
+func (f *InventoryMachinesService) DownloadExport(a0 context.Context, a1 goztl.InventoryExportKind, a2 *goztl.InventoryExportOptions, a3 io.Writer) (r0 *goztl.InventoryExportFile, r1 *goztl.Response, r2 error) {
+	f.record("DownloadExport", a1, a2, a3)
+	if f.DownloadExportFunc == nil {
+		r2 = errNotStubbed("InventoryMachinesService", "DownloadExport")
+		return
+	}
+	return f.DownloadExportFunc(a0, a1, a2, a3)
+}
+
+// JMESPathChecksService is a fake goztl.JMESPathChecksService.
+type JMESPathChecksService struct {
+	Recorder
+	ListFunc      func(context.Context, *goztl.ListOptions) ([]goztl.JMESPathCheck, *goztl.Response, error)
+	GetByIDFunc   func(context.Context, int) (*goztl.JMESPathCheck, *goztl.Response, error)
+	GetByNameFunc func(context.Context, string) (*goztl.JMESPathCheck, *goztl.Response, error)
+	CreateFunc    func(context.Context, *goztl.JMESPathCheckCreateRequest) (*goztl.JMESPathCheck, *goztl.Response, error)
+	UpdateFunc    func(context.Context, int, *goztl.JMESPathCheckUpdateRequest) (*goztl.JMESPathCheck, *goztl.Response, error)
+	DeleteFunc    func(context.Context, int) (*goztl.Response, error)
+}
+
+var _ goztl.JMESPathChecksService = &JMESPathChecksService{}
+
+// List records the call and returns the results of ListFunc.
+func (f *JMESPathChecksService) List(a0 context.Context, a1 *goztl.ListOptions) (r0 []goztl.JMESPathCheck, r1 *goztl.Response, r2 error) {
+	f.record("List", a1)
+	if f.ListFunc == nil {
+		r2 = errNotStubbed("JMESPathChecksService", "List")
+		return
+	}
+	return f.ListFunc(a0, a1)
+}
+
+// GetByID records the call and returns the results of GetByIDFunc.
+func (f *JMESPathChecksService) GetByID(a0 context.Context, a1 int) (r0 *goztl.JMESPathCheck, r1 *goztl.Response, r2 error) {
+	f.record("GetByID", a1)
+	if f.GetByIDFunc == nil {
+		r2 = errNotStubbed("JMESPathChecksService", "GetByID")
+		return
+	}
+	return f.GetByIDFunc(a0, a1)
+}
+
+// GetByName records the call and returns the results of GetByNameFunc.
+func (f *JMESPathChecksService) GetByName(a0 context.Context, a1 string) (r0 *goztl.JMESPathCheck, r1 *goztl.Response, r2 error) {
+	f.record("GetByName", a1)
+	if f.GetByNameFunc == nil {
+		r2 = errNotStubbed("JMESPathChecksService", "GetByName")
+		return
+	}
+	return f.GetByNameFunc(a0, a1)
+}
+
+// Create records the call and returns the results of CreateFunc.
+func (f *JMESPathChecksService) Create(a0 context.Context, a1 *goztl.JMESPathCheckCreateRequest) (r0 *goztl.JMESPathCheck, r1 *goztl.Response, r2 error) {
+	f.record("Create", a1)
+	if f.CreateFunc == nil {
+		r2 = errNotStubbed("JMESPathChecksService", "Create")
+		return
+	}
+	return f.CreateFunc(a0, a1)
+}
+
+// Update records the call and returns the results of UpdateFunc.
+func (f *JMESPathChecksService) Update(a0 context.Context, a1 int, a2 *goztl.JMESPathCheckUpdateRequest) (r0 *goztl.JMESPathCheck, r1 *goztl.Response, r2 error) {
+	f.record("Update", a1, a2)
+	if f.UpdateFunc == nil {
+		r2 = errNotStubbed("JMESPathChecksService", "Update")
+		return
+	}
+	return f.UpdateFunc(a0, a1, a2)
+}
+
+// Delete records the call and returns the results of DeleteFunc.
+func (f *JMESPathChecksService) Delete(a0 context.Context, a1 int) (r0 *goztl.Response, r1 error) {
+	f.record("Delete", a1)
+	if f.DeleteFunc == nil {
+		r1 = errNotStubbed("JMESPathChecksService", "Delete")
+		return
+	}
+	return f.DeleteFunc(a0, a1)
+}
+
+// MDMACMEIssuersService is a fake goztl.MDMACMEIssuersService.
+type MDMACMEIssuersService struct {
+	Recorder
+	ListFunc      func(context.Context, *goztl.ListOptions) ([]goztl.MDMACMEIssuer, *goztl.Response, error)
+	GetByIDFunc   func(context.Context, string) (*goztl.MDMACMEIssuer, *goztl.Response, error)
+	GetByNameFunc func(context.Context, string) (*goztl.MDMACMEIssuer, *goztl.Response, error)
+	CreateFunc    func(context.Context, *goztl.MDMACMEIssuerRequest) (*goztl.MDMACMEIssuer, *goztl.Response, error)
+	UpdateFunc    func(context.Context, string, *goztl.MDMACMEIssuerRequest) (*goztl.MDMACMEIssuer, *goztl.Response, error)
+	DeleteFunc    func(context.Context, string) (*goztl.Response, error)
+}
+
+var _ goztl.MDMACMEIssuersService = &MDMACMEIssuersService{}
+
+// List records the call and returns the results of ListFunc.
+func (f *MDMACMEIssuersService) List(a0 context.Context, a1 *goztl.ListOptions) (r0 []goztl.MDMACMEIssuer, r1 *goztl.Response, r2 error) {
+	f.record("List", a1)
+	if f.ListFunc == nil {
+		r2 = errNotStubbed("MDMACMEIssuersService", "List")
+		return
+	}
+	return f.ListFunc(a0, a1)
+}
+
+// GetByID records the call and returns the results of GetByIDFunc.
+func (f *MDMACMEIssuersService) GetByID(a0 context.Context, a1 string) (r0 *goztl.MDMACMEIssuer, r1 *goztl.Response, r2 error) {
+	f.record("GetByID", a1)
+	if f.GetByIDFunc == nil {
+		r2 = errNotStubbed("MDMACMEIssuersService", "GetByID")
+		return
+	}
+	return f.GetByIDFunc(a0, a1)
+}
+
+// GetByName records the call and returns the results of GetByNameFunc.
+func (f *MDMACMEIssuersService) GetByName(a0 context.Context, a1 string) (r0 *goztl.MDMACMEIssuer, r1 *goztl.Response, r2 error) {
+	f.record("GetByName", a1)
+	if f.GetByNameFunc == nil {
+		r2 = errNotStubbed("MDMACMEIssuersService", "GetByName")
+		return
+	}
+	return f.GetByNameFunc(a0, a1)
+}
+
+// Create records the call and returns the results of CreateFunc.
+func (f *MDMACMEIssuersService) Create(a0 context.Context, a1 *goztl.MDMACMEIssuerRequest) (r0 *goztl.MDMACMEIssuer, r1 *goztl.Response, r2 error) {
+	f.record("Create", a1)
+	if f.CreateFunc == nil {
+		r2 = errNotStubbed("MDMACMEIssuersService", "Create")
+		return
+	}
+	return f.CreateFunc(a0, a1)
+}
+
+// Update records the call and returns the results of UpdateFunc.
+func (f *MDMACMEIssuersService) Update(a0 context.Context, a1 string, a2 *goztl.MDMACMEIssuerRequest) (r0 *goztl.MDMACMEIssuer, r1 *goztl.Response, r2 error) {
+	f.record("Update", a1, a2)
+	if f.UpdateFunc == nil {
+		r2 = errNotStubbed("MDMACMEIssuersService", "Update")
+		return
+	}
+	return f.UpdateFunc(a0, a1, a2)
+}
+
+// Delete records the call and returns the results of DeleteFunc.
+func (f *MDMACMEIssuersService) Delete(a0 context.Context, a1 string) (r0 *goztl.Response, r1 error) {
+	f.record("Delete", a1)
+	if f.DeleteFunc == nil {
+		r1 = errNotStubbed("MDMACMEIssuersService", "Delete")
+		return
+	}
+	return f.DeleteFunc(a0, a1)
+}
+
+// MDMArtifactsService is a fake goztl.MDMArtifactsService.
+type MDMArtifactsService struct {
+	Recorder
+	ListFunc      func(context.Context, *goztl.ListOptions) ([]goztl.MDMArtifact, *goztl.Response, error)
+	GetByIDFunc   func(context.Context, string) (*goztl.MDMArtifact, *goztl.Response, error)
+	GetByNameFunc func(context.Context, string) (*goztl.MDMArtifact, *goztl.Response, error)
+	CreateFunc    func(context.Context, *goztl.MDMArtifactRequest) (*goztl.MDMArtifact, *goztl.Response, error)
+	UpdateFunc    func(context.Context, string, *goztl.MDMArtifactRequest) (*goztl.MDMArtifact, *goztl.Response, error)
+	DeleteFunc    func(context.Context, string) (*goztl.Response, error)
+}
+
+var _ goztl.MDMArtifactsService = &MDMArtifactsService{}
+
+// List records the call and returns the results of ListFunc.
+func (f *MDMArtifactsService) List(a0 context.Context, a1 *goztl.ListOptions) (r0 []goztl.MDMArtifact, r1 *goztl.Response, r2 error) {
+	f.record("List", a1)
+	if f.ListFunc == nil {
+		r2 = errNotStubbed("MDMArtifactsService", "List")
+		return
+	}
+	return f.ListFunc(a0, a1)
+}
+
+// GetByID records the call and returns the results of GetByIDFunc.
+func (f *MDMArtifactsService) GetByID(a0 context.Context, a1 string) (r0 *goztl.MDMArtifact, r1 *goztl.Response, r2 error) {
+	f.record("GetByID", a1)
+	if f.GetByIDFunc == nil {
+		r2 = errNotStubbed("MDMArtifactsService", "GetByID")
+		return
+	}
+	return f.GetByIDFunc(a0, a1)
+}
+
+// GetByName records the call and returns the results of GetByNameFunc.
+func (f *MDMArtifactsService) GetByName(a0 context.Context, a1 string) (r0 *goztl.MDMArtifact, r1 *goztl.Response, r2 error) {
+	f.record("GetByName", a1)
+	if f.GetByNameFunc == nil {
+		r2 = errNotStubbed("MDMArtifactsService", "GetByName")
+		return
+	}
+	return f.GetByNameFunc(a0, a1)
+}
+
+// Create records the call and returns the results of CreateFunc.
+func (f *MDMArtifactsService) Create(a0 context.Context, a1 *goztl.MDMArtifactRequest) (r0 *goztl.MDMArtifact, r1 *goztl.Response, r2 error) {
+	f.record("Create", a1)
+	if f.CreateFunc == nil {
+		r2 = errNotStubbed("MDMArtifactsService", "Create")
+		return
+	}
+	return f.CreateFunc(a0, a1)
+}
+
+// Update records the call and returns the results of UpdateFunc.
+func (f *MDMArtifactsService) Update(a0 context.Context, a1 string, a2 *goztl.MDMArtifactRequest) (r0 *goztl.MDMArtifact, r1 *goztl.Response, r2 error) {
+	f.record("Update", a1, a2)
+	if f.UpdateFunc == nil {
+		r2 = errNotStubbed("MDMArtifactsService", "Update")
+		return
+	}
+	return f.UpdateFunc(a0, a1, a2)
+}
+
+// Delete records the call and returns the results of DeleteFunc.
+func (f *MDMArtifactsService) Delete(a0 context.Context, a1 string) (r0 *goztl.Response, r1 error) {
+	f.record("Delete", a1)
+	if f.DeleteFunc == nil {
+		r1 = errNotStubbed("MDMArtifactsService", "Delete")
+		return
+	}
+	return f.DeleteFunc(a0, a1)
+}
+
+// MDMBlueprintArtifactsService is a fake goztl.MDMBlueprintArtifactsService.
+type MDMBlueprintArtifactsService struct {
+	Recorder
+	ListFunc    func(context.Context, *goztl.ListOptions) ([]goztl.MDMBlueprintArtifact, *goztl.Response, error)
+	GetByIDFunc func(context.Context, int) (*goztl.MDMBlueprintArtifact, *goztl.Response, error)
+	CreateFunc  func(context.Context, *goztl.MDMBlueprintArtifactRequest) (*goztl.MDMBlueprintArtifact, *goztl.Response, error)
+	UpdateFunc  func(context.Context, int, *goztl.MDMBlueprintArtifactRequest) (*goztl.MDMBlueprintArtifact, *goztl.Response, error)
+	DeleteFunc  func(context.Context, int) (*goztl.Response, error)
+}
+
+var _ goztl.MDMBlueprintArtifactsService = &MDMBlueprintArtifactsService{}
+
+// List records the call and returns the results of ListFunc.
+func (f *MDMBlueprintArtifactsService) List(a0 context.Context, a1 *goztl.ListOptions) (r0 []goztl.MDMBlueprintArtifact, r1 *goztl.Response, r2 error) {
+	f.record("List", a1)
+	if f.ListFunc == nil {
+		r2 = errNotStubbed("MDMBlueprintArtifactsService", "List")
+		return
+	}
+	return f.ListFunc(a0, a1)
+}
+
+// GetByID records the call and returns the results of GetByIDFunc.
+func (f *MDMBlueprintArtifactsService) GetByID(a0 context.Context, a1 int) (r0 *goztl.MDMBlueprintArtifact, r1 *goztl.Response, r2 error) {
+	f.record("GetByID", a1)
+	if f.GetByIDFunc == nil {
+		r2 = errNotStubbed("MDMBlueprintArtifactsService", "GetByID")
+		return
+	}
+	return f.GetByIDFunc(a0, a1)
+}
+
+// Create records the call and returns the results of CreateFunc.
+func (f *MDMBlueprintArtifactsService) Create(a0 context.Context, a1 *goztl.MDMBlueprintArtifactRequest) (r0 *goztl.MDMBlueprintArtifact, r1 *goztl.Response, r2 error) {
+	f.record("Create", a1)
+	if f.CreateFunc == nil {
+		r2 = errNotStubbed("MDMBlueprintArtifactsService", "Create")
+		return
+	}
+	return f.CreateFunc(a0, a1)
+}
+
+// Update records the call and returns the results of UpdateFunc.
+func (f *MDMBlueprintArtifactsService) Update(a0 context.Context, a1 int, a2 *goztl.MDMBlueprintArtifactRequest) (r0 *goztl.MDMBlueprintArtifact, r1 *goztl.Response, r2 error) {
+	f.record("Update", a1, a2)
+	if f.UpdateFunc == nil {
+		r2 = errNotStubbed("MDMBlueprintArtifactsService", "Update")
+		return
+	}
+	return f.UpdateFunc(a0, a1, a2)
+}
+
+// Delete records the call and returns the results of DeleteFunc.
+func (f *MDMBlueprintArtifactsService) Delete(a0 context.Context, a1 int) (r0 *goztl.Response, r1 error) {
+	f.record("Delete", a1)
+	if f.DeleteFunc == nil {
+		r1 = errNotStubbed("MDMBlueprintArtifactsService", "Delete")
+		return
+	}
+	return f.DeleteFunc(a0, a1)
+}
+
+// MDMBlueprintsService is a fake goztl.MDMBlueprintsService.
+type MDMBlueprintsService struct {
+	Recorder
+	ListFunc      func(context.Context, *goztl.ListOptions) ([]goztl.MDMBlueprint, *goztl.Response, error)
+	GetByIDFunc   func(context.Context, int) (*goztl.MDMBlueprint, *goztl.Response, error)
+	GetByNameFunc func(context.Context, string) (*goztl.MDMBlueprint, *goztl.Response, error)
+	CreateFunc    func(context.Context, *goztl.MDMBlueprintRequest) (*goztl.MDMBlueprint, *goztl.Response, error)
+	UpdateFunc    func(context.Context, int, *goztl.MDMBlueprintRequest) (*goztl.MDMBlueprint, *goztl.Response, error)
+	DeleteFunc    func(context.Context, int) (*goztl.Response, error)
+}
+
+var _ goztl.MDMBlueprintsService = &MDMBlueprintsService{}
+
+// List records the call and returns the results of ListFunc.
+func (f *MDMBlueprintsService) List(a0 context.Context, a1 *goztl.ListOptions) (r0 []goztl.MDMBlueprint, r1 *goztl.Response, r2 error) {
+	f.record("List", a1)
+	if f.ListFunc == nil {
+		r2 = errNotStubbed("MDMBlueprintsService", "List")
+		return
+	}
+	return f.ListFunc(a0, a1)
+}
+
+// GetByID records the call and returns the results of GetByIDFunc.
+func (f *MDMBlueprintsService) GetByID(a0 context.Context, a1 int) (r0 *goztl.MDMBlueprint, r1 *goztl.Response, r2 error) {
+	f.record("GetByID", a1)
+	if f.GetByIDFunc == nil {
+		r2 = errNotStubbed("MDMBlueprintsService", "GetByID")
+		return
+	}
+	return f.GetByIDFunc(a0, a1)
+}
+
+// GetByName records the call and returns the results of GetByNameFunc.
+func (f *MDMBlueprintsService) GetByName(a0 context.Context, a1 string) (r0 *goztl.MDMBlueprint, r1 *goztl.Response, r2 error) {
+	f.record("GetByName", a1)
+	if f.GetByNameFunc == nil {
+		r2 = errNotStubbed("MDMBlueprintsService", "GetByName")
+		return
+	}
+	return f.GetByNameFunc(a0, a1)
+}
+
+// Create records the call and returns the results of CreateFunc.
+func (f *MDMBlueprintsService) Create(a0 context.Context, a1 *goztl.MDMBlueprintRequest) (r0 *goztl.MDMBlueprint, r1 *goztl.Response, r2 error) {
+	f.record("Create", a1)
+	if f.CreateFunc == nil {
+		r2 = errNotStubbed("MDMBlueprintsService", "Create")
+		return
+	}
+	return f.CreateFunc(a0, a1)
+}
+
+// Update records the call and returns the results of UpdateFunc.
+func (f *MDMBlueprintsService) Update(a0 context.Context, a1 int, a2 *goztl.MDMBlueprintRequest) (r0 *goztl.MDMBlueprint, r1 *goztl.Response, r2 error) {
+	f.record("Update", a1, a2)
+	if f.UpdateFunc == nil {
+		r2 = errNotStubbed("MDMBlueprintsService", "Update")
+		return
+	}
+	return f.UpdateFunc(a0, a1, a2)
+}
+
+// Delete records the call and returns the results of DeleteFunc.
+func (f *MDMBlueprintsService) Delete(a0 context.Context, a1 int) (r0 *goztl.Response, r1 error) {
+	f.record("Delete", a1)
+	if f.DeleteFunc == nil {
+		r1 = errNotStubbed("MDMBlueprintsService", "Delete")
+		return
+	}
+	return f.DeleteFunc(a0, a1)
+}
+
+// MDMCertAssetsService is a fake goztl.MDMCertAssetsService.
+type MDMCertAssetsService struct {
+	Recorder
+	ListFunc    func(context.Context, *goztl.ListOptions) ([]goztl.MDMCertAsset, *goztl.Response, error)
+	GetByIDFunc func(context.Context, string) (*goztl.MDMCertAsset, *goztl.Response, error)
+	CreateFunc  func(context.Context, *goztl.MDMCertAssetRequest) (*goztl.MDMCertAsset, *goztl.Response, error)
+	UpdateFunc  func(context.Context, string, *goztl.MDMCertAssetRequest) (*goztl.MDMCertAsset, *goztl.Response, error)
+	DeleteFunc  func(context.Context, string) (*goztl.Response, error)
+}
+
+var _ goztl.MDMCertAssetsService = &MDMCertAssetsService{}
+
+// List records the call and returns the results of ListFunc.
+func (f *MDMCertAssetsService) List(a0 context.Context, a1 *goztl.ListOptions) (r0 []goztl.MDMCertAsset, r1 *goztl.Response, r2 error) {
+	f.record("List", a1)
+	if f.ListFunc == nil {
+		r2 = errNotStubbed("MDMCertAssetsService", "List")
+		return
+	}
+	return f.ListFunc(a0, a1)
+}
+
+// GetByID records the call and returns the results of GetByIDFunc.
+func (f *MDMCertAssetsService) GetByID(a0 context.Context, a1 string) (r0 *goztl.MDMCertAsset, r1 *goztl.Response, r2 error) {
+	f.record("GetByID", a1)
+	if f.GetByIDFunc == nil {
+		r2 = errNotStubbed("MDMCertAssetsService", "GetByID")
+		return
+	}
+	return f.GetByIDFunc(a0, a1)
+}
+
+// Create records the call and returns the results of CreateFunc.
+func (f *MDMCertAssetsService) Create(a0 context.Context, a1 *goztl.MDMCertAssetRequest) (r0 *goztl.MDMCertAsset, r1 *goztl.Response, r2 error) {
+	f.record("Create", a1)
+	if f.CreateFunc == nil {
+		r2 = errNotStubbed("MDMCertAssetsService", "Create")
+		return
+	}
+	return f.CreateFunc(a0, a1)
+}
+
+// Update records the call and returns the results of UpdateFunc.
+func (f *MDMCertAssetsService) Update(a0 context.Context, a1 string, a2 *goztl.MDMCertAssetRequest) (r0 *goztl.MDMCertAsset, r1 *goztl.Response, r2 error) {
+	f.record("Update", a1, a2)
+	if f.UpdateFunc == nil {
+		r2 = errNotStubbed("MDMCertAssetsService", "Update")
+		return
+	}
+	return f.UpdateFunc(a0, a1, a2)
+}
+
+// Delete records the call and returns the results of DeleteFunc.
+func (f *MDMCertAssetsService) Delete(a0 context.Context, a1 string) (r0 *goztl.Response, r1 error) {
+	f.record("Delete", a1)
+	if f.DeleteFunc == nil {
+		r1 = errNotStubbed("MDMCertAssetsService", "Delete")
+		return
+	}
+	return f.DeleteFunc(a0, a1)
+}
+
+// MDMDEPEnrollmentCustomViewsService is a fake goztl.MDMDEPEnrollmentCustomViewsService.
+type MDMDEPEnrollmentCustomViewsService struct {
+	Recorder
+	ListFunc    func(context.Context, *goztl.ListOptions) ([]goztl.MDMDEPEnrollmentCustomView, *goztl.Response, error)
+	GetByIDFunc func(context.Context, string) (*goztl.MDMDEPEnrollmentCustomView, *goztl.Response, error)
+	CreateFunc  func(context.Context, *goztl.MDMDEPEnrollmentCustomViewRequest) (*goztl.MDMDEPEnrollmentCustomView, *goztl.Response, error)
+	UpdateFunc  func(context.Context, string, *goztl.MDMDEPEnrollmentCustomViewRequest) (*goztl.MDMDEPEnrollmentCustomView, *goztl.Response, error)
+	DeleteFunc  func(context.Context, string) (*goztl.Response, error)
+}
+
+var _ goztl.MDMDEPEnrollmentCustomViewsService = &MDMDEPEnrollmentCustomViewsService{}
+
+// List records the call and returns the results of ListFunc.
+func (f *MDMDEPEnrollmentCustomViewsService) List(a0 context.Context, a1 *goztl.ListOptions) (r0 []goztl.MDMDEPEnrollmentCustomView, r1 *goztl.Response, r2 error) {
+	f.record("List", a1)
+	if f.ListFunc == nil {
+		r2 = errNotStubbed("MDMDEPEnrollmentCustomViewsService", "List")
+		return
+	}
+	return f.ListFunc(a0, a1)
+}
+
+// GetByID records the call and returns the results of GetByIDFunc.
+func (f *MDMDEPEnrollmentCustomViewsService) GetByID(a0 context.Context, a1 string) (r0 *goztl.MDMDEPEnrollmentCustomView, r1 *goztl.Response, r2 error) {
+	f.record("GetByID", a1)
+	if f.GetByIDFunc == nil {
+		r2 = errNotStubbed("MDMDEPEnrollmentCustomViewsService", "GetByID")
+		return
+	}
+	return f.GetByIDFunc(a0, a1)
+}
+
+// Create records the call and returns the results of CreateFunc.
+func (f *MDMDEPEnrollmentCustomViewsService) Create(a0 context.Context, a1 *goztl.MDMDEPEnrollmentCustomViewRequest) (r0 *goztl.MDMDEPEnrollmentCustomView, r1 *goztl.Response, r2 error) {
+	f.record("Create", a1)
+	if f.CreateFunc == nil {
+		r2 = errNotStubbed("MDMDEPEnrollmentCustomViewsService", "Create")
+		return
+	}
+	return f.CreateFunc(a0, a1)
+}
+
+// Update records the call and returns the results of UpdateFunc.
+func (f *MDMDEPEnrollmentCustomViewsService) Update(a0 context.Context, a1 string, a2 *goztl.MDMDEPEnrollmentCustomViewRequest) (r0 *goztl.MDMDEPEnrollmentCustomView, r1 *goztl.Response, r2 error) {
+	f.record("Update", a1, a2)
+	if f.UpdateFunc == nil {
+		r2 = errNotStubbed("MDMDEPEnrollmentCustomViewsService", "Update")
+		return
+	}
+	return f.UpdateFunc(a0, a1, a2)
+}
+
+// Delete records the call and returns the results of DeleteFunc.
+func (f *MDMDEPEnrollmentCustomViewsService) Delete(a0 context.Context, a1 string) (r0 *goztl.Response, r1 error) {
+	f.record("Delete", a1)
+	if f.DeleteFunc == nil {
+		r1 = errNotStubbed("MDMDEPEnrollmentCustomViewsService", "Delete")
+		return
+	}
+	return f.DeleteFunc(a0, a1)
+}
+
+// MDMDEPEnrollmentsService is a fake goztl.MDMDEPEnrollmentsService.
+type MDMDEPEnrollmentsService struct {
+	Recorder
+	ListFunc      func(context.Context, *goztl.ListOptions) ([]goztl.MDMDEPEnrollment, *goztl.Response, error)
+	GetByIDFunc   func(context.Context, int) (*goztl.MDMDEPEnrollment, *goztl.Response, error)
+	GetByNameFunc func(context.Context, string) (*goztl.MDMDEPEnrollment, *goztl.Response, error)
+	CreateFunc    func(context.Context, *goztl.MDMDEPEnrollmentRequest) (*goztl.MDMDEPEnrollment, *goztl.Response, error)
+	UpdateFunc    func(context.Context, int, *goztl.MDMDEPEnrollmentRequest) (*goztl.MDMDEPEnrollment, *goztl.Response, error)
+	DeleteFunc    func(context.Context, int) (*goztl.Response, error)
+}
+
+var _ goztl.MDMDEPEnrollmentsService = &MDMDEPEnrollmentsService{}
+
+// List records the call and returns the results of ListFunc.
+func (f *MDMDEPEnrollmentsService) List(a0 context.Context, a1 *goztl.ListOptions) (r0 []goztl.MDMDEPEnrollment, r1 *goztl.Response, r2 error) {
+	f.record("List", a1)
+	if f.ListFunc == nil {
+		r2 = errNotStubbed("MDMDEPEnrollmentsService", "List")
+		return
+	}
+	return f.ListFunc(a0, a1)
+}
+
+// GetByID records the call and returns the results of GetByIDFunc.
+func (f *MDMDEPEnrollmentsService) GetByID(a0 context.Context, a1 int) (r0 *goztl.MDMDEPEnrollment, r1 *goztl.Response, r2 error) {
+	f.record("GetByID", a1)
+	if f.GetByIDFunc == nil {
+		r2 = errNotStubbed("MDMDEPEnrollmentsService", "GetByID")
+		return
+	}
+	return f.GetByIDFunc(a0, a1)
+}
+
+// GetByName records the call and returns the results of GetByNameFunc.
+func (f *MDMDEPEnrollmentsService) GetByName(a0 context.Context, a1 string) (r0 *goztl.MDMDEPEnrollment, r1 *goztl.Response, r2 error) {
+	f.record("GetByName", a1)
+	if f.GetByNameFunc == nil {
+		r2 = errNotStubbed("MDMDEPEnrollmentsService", "GetByName")
+		return
+	}
+	return f.GetByNameFunc(a0, a1)
+}
+
+// Create records the call and returns the results of CreateFunc.
+func (f *MDMDEPEnrollmentsService) Create(a0 context.Context, a1 *goztl.MDMDEPEnrollmentRequest) (r0 *goztl.MDMDEPEnrollment, r1 *goztl.Response, r2 error) {
+	f.record("Create", a1)
+	if f.CreateFunc == nil {
+		r2 = errNotStubbed("MDMDEPEnrollmentsService", "Create")
+		return
+	}
+	return f.CreateFunc(a0, a1)
+}
+
+// Update records the call and returns the results of UpdateFunc.
+func (f *MDMDEPEnrollmentsService) Update(a0 context.Context, a1 int, a2 *goztl.MDMDEPEnrollmentRequest) (r0 *goztl.MDMDEPEnrollment, r1 *goztl.Response, r2 error) {
+	f.record("Update", a1, a2)
+	if f.UpdateFunc == nil {
+		r2 = errNotStubbed("MDMDEPEnrollmentsService", "Update")
+		return
+	}
+	return f.UpdateFunc(a0, a1, a2)
+}
+
+// Delete records the call and returns the results of DeleteFunc.
+func (f *MDMDEPEnrollmentsService) Delete(a0 context.Context, a1 int) (r0 *goztl.Response, r1 error) {
+	f.record("Delete", a1)
+	if f.DeleteFunc == nil {
+		r1 = errNotStubbed("MDMDEPEnrollmentsService", "Delete")
+		return
+	}
+	return f.DeleteFunc(a0, a1)
+}
+
+// MDMDEPVirtualServersService is a fake goztl.MDMDEPVirtualServersService.
+type MDMDEPVirtualServersService struct {
+	Recorder
+	ListFunc      func(context.Context, *goztl.ListOptions) ([]goztl.MDMDEPVirtualServer, *goztl.Response, error)
+	GetByIDFunc   func(context.Context, int) (*goztl.MDMDEPVirtualServer, *goztl.Response, error)
+	GetByNameFunc func(context.Context, string) ([]goztl.MDMDEPVirtualServer, *goztl.Response, error)
+}
+
+var _ goztl.MDMDEPVirtualServersService = &MDMDEPVirtualServersService{}
+
+// List records the call and returns the results of ListFunc.
+func (f *MDMDEPVirtualServersService) List(a0 context.Context, a1 *goztl.ListOptions) (r0 []goztl.MDMDEPVirtualServer, r1 *goztl.Response, r2 error) {
+	f.record("List", a1)
+	if f.ListFunc == nil {
+		r2 = errNotStubbed("MDMDEPVirtualServersService", "List")
+		return
+	}
+	return f.ListFunc(a0, a1)
+}
+
+// GetByID records the call and returns the results of GetByIDFunc.
+func (f *MDMDEPVirtualServersService) GetByID(a0 context.Context, a1 int) (r0 *goztl.MDMDEPVirtualServer, r1 *goztl.Response, r2 error) {
+	f.record("GetByID", a1)
+	if f.GetByIDFunc == nil {
+		r2 = errNotStubbed("MDMDEPVirtualServersService", "GetByID")
+		return
+	}
+	return f.GetByIDFunc(a0, a1)
+}
+
+// GetByName records the call and returns the results of GetByNameFunc.
+func (f *MDMDEPVirtualServersService) GetByName(a0 context.Context, a1 string) (r0 []goztl.MDMDEPVirtualServer, r1 *goztl.Response, r2 error) {
+	f.record("GetByName", a1)
+	if f.GetByNameFunc == nil {
+		r2 = errNotStubbed("MDMDEPVirtualServersService", "GetByName")
+		return
+	}
+	return f.GetByNameFunc(a0, a1)
+}
+
+// MDMDataAssetsService is a fake goztl.MDMDataAssetsService.
+type MDMDataAssetsService struct {
+	Recorder
+	ListFunc    func(context.Context, *goztl.ListOptions) ([]goztl.MDMDataAsset, *goztl.Response, error)
+	GetByIDFunc func(context.Context, string) (*goztl.MDMDataAsset, *goztl.Response, error)
+	CreateFunc  func(context.Context, *goztl.MDMDataAssetRequest) (*goztl.MDMDataAsset, *goztl.Response, error)
+	UpdateFunc  func(context.Context, string, *goztl.MDMDataAssetRequest) (*goztl.MDMDataAsset, *goztl.Response, error)
+	DeleteFunc  func(context.Context, string) (*goztl.Response, error)
+	OptionsFunc func(context.Context) (*goztl.EndpointOptions, *goztl.Response, error)
+}
+
+var _ goztl.MDMDataAssetsService = &MDMDataAssetsService{}
+
+// List records the call and returns the results of ListFunc.
+func (f *MDMDataAssetsService) List(a0 context.Context, a1 *goztl.ListOptions) (r0 []goztl.MDMDataAsset, r1 *goztl.Response, r2 error) {
+	f.record("List", a1)
+	if f.ListFunc == nil {
+		r2 = errNotStubbed("MDMDataAssetsService", "List")
+		return
+	}
+	return f.ListFunc(a0, a1)
+}
+
+// GetByID records the call and returns the results of GetByIDFunc.
+func (f *MDMDataAssetsService) GetByID(a0 context.Context, a1 string) (r0 *goztl.MDMDataAsset, r1 *goztl.Response, r2 error) {
+	f.record("GetByID", a1)
+	if f.GetByIDFunc == nil {
+		r2 = errNotStubbed("MDMDataAssetsService", "GetByID")
+		return
+	}
+	return f.GetByIDFunc(a0, a1)
+}
+
+// Create records the call and returns the results of CreateFunc.
+func (f *MDMDataAssetsService) Create(a0 context.Context, a1 *goztl.MDMDataAssetRequest) (r0 *goztl.MDMDataAsset, r1 *goztl.Response, r2 error) {
+	f.record("Create", a1)
+	if f.CreateFunc == nil {
+		r2 = errNotStubbed("MDMDataAssetsService", "Create")
+		return
+	}
+	return f.CreateFunc(a0, a1)
+}
+
+// Update records the call and returns the results of UpdateFunc.
+func (f *MDMDataAssetsService) Update(a0 context.Context, a1 string, a2 *goztl.MDMDataAssetRequest) (r0 *goztl.MDMDataAsset, r1 *goztl.Response, r2 error) {
+	f.record("Update", a1, a2)
+	if f.UpdateFunc == nil {
+		r2 = errNotStubbed("MDMDataAssetsService", "Update")
+		return
+	}
+	return f.UpdateFunc(a0, a1, a2)
+}
+
+// Delete records the call and returns the results of DeleteFunc.
+func (f *MDMDataAssetsService) Delete(a0 context.Context, a1 string) (r0 *goztl.Response, r1 error) {
+	f.record("Delete", a1)
+	if f.DeleteFunc == nil {
+		r1 = errNotStubbed("MDMDataAssetsService", "Delete")
+		return
+	}
+	return f.DeleteFunc(a0, a1)
+}
+
+// Options records the call and returns the results of OptionsFunc.
+func (f *MDMDataAssetsService) Options(a0 context.Context) (r0 *goztl.EndpointOptions, r1 *goztl.Response, r2 error) {
+	f.record("Options")
+	if f.OptionsFunc == nil {
+		r2 = errNotStubbed("MDMDataAssetsService", "Options")
+		return
+	}
+	return f.OptionsFunc(a0)
+}
+
+// MDMDeclarationsService is a fake goztl.MDMDeclarationsService.
+type MDMDeclarationsService struct {
+	Recorder
+	ListFunc    func(context.Context, *goztl.ListOptions) ([]goztl.MDMDeclaration, *goztl.Response, error)
+	GetByIDFunc func(context.Context, string) (*goztl.MDMDeclaration, *goztl.Response, error)
+	CreateFunc  func(context.Context, *goztl.MDMDeclarationRequest) (*goztl.MDMDeclaration, *goztl.Response, error)
+	UpdateFunc  func(context.Context, string, *goztl.MDMDeclarationRequest) (*goztl.MDMDeclaration, *goztl.Response, error)
+	DeleteFunc  func(context.Context, string) (*goztl.Response, error)
+}
+
+var _ goztl.MDMDeclarationsService = &MDMDeclarationsService{}
+
+// List records the call and returns the results of ListFunc.
+func (f *MDMDeclarationsService) List(a0 context.Context, a1 *goztl.ListOptions) (r0 []goztl.MDMDeclaration, r1 *goztl.Response, r2 error) {
+	f.record("List", a1)
+	if f.ListFunc == nil {
+		r2 = errNotStubbed("MDMDeclarationsService", "List")
+		return
+	}
+	return f.ListFunc(a0, a1)
+}
+
+// GetByID records the call and returns the results of GetByIDFunc.
+func (f *MDMDeclarationsService) GetByID(a0 context.Context, a1 string) (r0 *goztl.MDMDeclaration, r1 *goztl.Response, r2 error) {
+	f.record("GetByID", a1)
+	if f.GetByIDFunc == nil {
+		r2 = errNotStubbed("MDMDeclarationsService", "GetByID")
+		return
+	}
+	return f.GetByIDFunc(a0, a1)
+}
+
+// Create records the call and returns the results of CreateFunc.
+func (f *MDMDeclarationsService) Create(a0 context.Context, a1 *goztl.MDMDeclarationRequest) (r0 *goztl.MDMDeclaration, r1 *goztl.Response, r2 error) {
+	f.record("Create", a1)
+	if f.CreateFunc == nil {
+		r2 = errNotStubbed("MDMDeclarationsService", "Create")
+		return
+	}
+	return f.CreateFunc(a0, a1)
+}
+
+// Update records the call and returns the results of UpdateFunc.
+func (f *MDMDeclarationsService) Update(a0 context.Context, a1 string, a2 *goztl.MDMDeclarationRequest) (r0 *goztl.MDMDeclaration, r1 *goztl.Response, r2 error) {
+	f.record("Update", a1, a2)
+	if f.UpdateFunc == nil {
+		r2 = errNotStubbed("MDMDeclarationsService", "Update")
+		return
+	}
+	return f.UpdateFunc(a0, a1, a2)
+}
+
+// Delete records the call and returns the results of DeleteFunc.
+func (f *MDMDeclarationsService) Delete(a0 context.Context, a1 string) (r0 *goztl.Response, r1 error) {
+	f.record("Delete", a1)
+	if f.DeleteFunc == nil {
+		r1 = errNotStubbed("MDMDeclarationsService", "Delete")
+		return
+	}
+	return f.DeleteFunc(a0, a1)
+}
+
+// MDMEnrollmentCustomViewsService is a fake goztl.MDMEnrollmentCustomViewsService.
+type MDMEnrollmentCustomViewsService struct {
+	Recorder
+	ListFunc      func(context.Context, *goztl.ListOptions) ([]goztl.MDMEnrollmentCustomView, *goztl.Response, error)
+	GetByIDFunc   func(context.Context, string) (*goztl.MDMEnrollmentCustomView, *goztl.Response, error)
+	GetByNameFunc func(context.Context, string) (*goztl.MDMEnrollmentCustomView, *goztl.Response, error)
+	CreateFunc    func(context.Context, *goztl.MDMEnrollmentCustomViewRequest) (*goztl.MDMEnrollmentCustomView, *goztl.Response, error)
+	UpdateFunc    func(context.Context, string, *goztl.MDMEnrollmentCustomViewRequest) (*goztl.MDMEnrollmentCustomView, *goztl.Response, error)
+	DeleteFunc    func(context.Context, string) (*goztl.Response, error)
+}
+
+var _ goztl.MDMEnrollmentCustomViewsService = &MDMEnrollmentCustomViewsService{}
+
+// List records the call and returns the results of ListFunc.
+func (f *MDMEnrollmentCustomViewsService) List(a0 context.Context, a1 *goztl.ListOptions) (r0 []goztl.MDMEnrollmentCustomView, r1 *goztl.Response, r2 error) {
+	f.record("List", a1)
+	if f.ListFunc == nil {
+		r2 = errNotStubbed("MDMEnrollmentCustomViewsService", "List")
+		return
+	}
+	return f.ListFunc(a0, a1)
+}
+
+// GetByID records the call and returns the results of GetByIDFunc.
+func (f *MDMEnrollmentCustomViewsService) GetByID(a0 context.Context, a1 string) (r0 *goztl.MDMEnrollmentCustomView, r1 *goztl.Response, r2 error) {
+	f.record("GetByID", a1)
+	if f.GetByIDFunc == nil {
+		r2 = errNotStubbed("MDMEnrollmentCustomViewsService", "GetByID")
+		return
+	}
+	return f.GetByIDFunc(a0, a1)
+}
+
+// GetByName records the call and returns the results of GetByNameFunc.
+func (f *MDMEnrollmentCustomViewsService) GetByName(a0 context.Context, a1 string) (r0 *goztl.MDMEnrollmentCustomView, r1 *goztl.Response, r2 error) {
+	f.record("GetByName", a1)
+	if f.GetByNameFunc == nil {
+		r2 = errNotStubbed("MDMEnrollmentCustomViewsService", "GetByName")
+		return
+	}
+	return f.GetByNameFunc(a0, a1)
+}
+
+// Create records the call and returns the results of CreateFunc.
+func (f *MDMEnrollmentCustomViewsService) Create(a0 context.Context, a1 *goztl.MDMEnrollmentCustomViewRequest) (r0 *goztl.MDMEnrollmentCustomView, r1 *goztl.Response, r2 error) {
+	f.record("Create", a1)
+	if f.CreateFunc == nil {
+		r2 = errNotStubbed("MDMEnrollmentCustomViewsService", "Create")
+		return
+	}
+	return f.CreateFunc(a0, a1)
+}
+
+// Update records the call and returns the results of UpdateFunc.
+func (f *MDMEnrollmentCustomViewsService) Update(a0 context.Context, a1 string, a2 *goztl.MDMEnrollmentCustomViewRequest) (r0 *goztl.MDMEnrollmentCustomView, r1 *goztl.Response, r2 error) {
+	f.record("Update", a1, a2)
+	if f.UpdateFunc == nil {
+		r2 = errNotStubbed("MDMEnrollmentCustomViewsService", "Update")
+		return
+	}
+	return f.UpdateFunc(a0, a1, a2)
+}
+
+// Delete records the call and returns the results of DeleteFunc.
+func (f *MDMEnrollmentCustomViewsService) Delete(a0 context.Context, a1 string) (r0 *goztl.Response, r1 error) {
+	f.record("Delete", a1)
+	if f.DeleteFunc == nil {
+		r1 = errNotStubbed("MDMEnrollmentCustomViewsService", "Delete")
+		return
+	}
+	return f.DeleteFunc(a0, a1)
+}
+
+// MDMEnterpriseAppsService is a fake goztl.MDMEnterpriseAppsService.
+type MDMEnterpriseAppsService struct {
+	Recorder
+	ListFunc    func(context.Context, *goztl.ListOptions) ([]goztl.MDMEnterpriseApp, *goztl.Response, error)
+	GetByIDFunc func(context.Context, string) (*goztl.MDMEnterpriseApp, *goztl.Response, error)
+	CreateFunc  func(context.Context, *goztl.MDMEnterpriseAppRequest) (*goztl.MDMEnterpriseApp, *goztl.Response, error)
+	UpdateFunc  func(context.Context, string, *goztl.MDMEnterpriseAppRequest) (*goztl.MDMEnterpriseApp, *goztl.Response, error)
+	DeleteFunc  func(context.Context, string) (*goztl.Response, error)
+}
+
+var _ goztl.MDMEnterpriseAppsService = &MDMEnterpriseAppsService{}
+
+// List records the call and returns the results of ListFunc.
+func (f *MDMEnterpriseAppsService) List(a0 context.Context, a1 *goztl.ListOptions) (r0 []goztl.MDMEnterpriseApp, r1 *goztl.Response, r2 error) {
+	f.record("List", a1)
+	if f.ListFunc == nil {
+		r2 = errNotStubbed("MDMEnterpriseAppsService", "List")
+		return
+	}
+	return f.ListFunc(a0, a1)
+}
+
+// GetByID records the call and returns the results of GetByIDFunc.
+func (f *MDMEnterpriseAppsService) GetByID(a0 context.Context, a1 string) (r0 *goztl.MDMEnterpriseApp, r1 *goztl.Response, r2 error) {
+	f.record("GetByID", a1)
+	if f.GetByIDFunc == nil {
+		r2 = errNotStubbed("MDMEnterpriseAppsService", "GetByID")
+		return
+	}
+	return f.GetByIDFunc(a0, a1)
+}
+
+// Create records the call and returns the results of CreateFunc.
+func (f *MDMEnterpriseAppsService) Create(a0 context.Context, a1 *goztl.MDMEnterpriseAppRequest) (r0 *goztl.MDMEnterpriseApp, r1 *goztl.Response, r2 error) {
+	f.record("Create", a1)
+	if f.CreateFunc == nil {
+		r2 = errNotStubbed("MDMEnterpriseAppsService", "Create")
+		return
+	}
+	return f.CreateFunc(a0, a1)
+}
+
+// Update records the call and returns the results of UpdateFunc.
+func (f *MDMEnterpriseAppsService) Update(a0 context.Context, a1 string, a2 *goztl.MDMEnterpriseAppRequest) (r0 *goztl.MDMEnterpriseApp, r1 *goztl.Response, r2 error) {
+	f.record("Update", a1, a2)
+	if f.UpdateFunc == nil {
+		r2 = errNotStubbed("MDMEnterpriseAppsService", "Update")
+		return
+	}
+	return f.UpdateFunc(a0, a1, a2)
+}
+
+// Delete records the call and returns the results of DeleteFunc.
+func (f *MDMEnterpriseAppsService) Delete(a0 context.Context, a1 string) (r0 *goztl.Response, r1 error) {
+	f.record("Delete", a1)
+	if f.DeleteFunc == nil {
+		r1 = errNotStubbed("MDMEnterpriseAppsService", "Delete")
+		return
+	}
+	return f.DeleteFunc(a0, a1)
+}
+
+// MDMFileVaultConfigsService is a fake goztl.MDMFileVaultConfigsService.
+type MDMFileVaultConfigsService struct {
+	Recorder
+	ListFunc      func(context.Context, *goztl.ListOptions) ([]goztl.MDMFileVaultConfig, *goztl.Response, error)
+	GetByIDFunc   func(context.Context, int) (*goztl.MDMFileVaultConfig, *goztl.Response, error)
+	GetByNameFunc func(context.Context, string) (*goztl.MDMFileVaultConfig, *goztl.Response, error)
+	CreateFunc    func(context.Context, *goztl.MDMFileVaultConfigRequest) (*goztl.MDMFileVaultConfig, *goztl.Response, error)
+	UpdateFunc    func(context.Context, int, *goztl.MDMFileVaultConfigRequest) (*goztl.MDMFileVaultConfig, *goztl.Response, error)
+	DeleteFunc    func(context.Context, int) (*goztl.Response, error)
+}
+
+var _ goztl.MDMFileVaultConfigsService = &MDMFileVaultConfigsService{}
+
+// List records the call and returns the results of ListFunc.
+func (f *MDMFileVaultConfigsService) List(a0 context.Context, a1 *goztl.ListOptions) (r0 []goztl.MDMFileVaultConfig, r1 *goztl.Response, r2 error) {
+	f.record("List", a1)
+	if f.ListFunc == nil {
+		r2 = errNotStubbed("MDMFileVaultConfigsService", "List")
+		return
+	}
+	return f.ListFunc(a0, a1)
+}
+
+// GetByID records the call and returns the results of GetByIDFunc.
+func (f *MDMFileVaultConfigsService) GetByID(a0 context.Context, a1 int) (r0 *goztl.MDMFileVaultConfig, r1 *goztl.Response, r2 error) {
+	f.record("GetByID", a1)
+	if f.GetByIDFunc == nil {
+		r2 = errNotStubbed("MDMFileVaultConfigsService", "GetByID")
+		return
+	}
+	return f.GetByIDFunc(a0, a1)
+}
+
+// GetByName records the call and returns the results of GetByNameFunc.
+func (f *MDMFileVaultConfigsService) GetByName(a0 context.Context, a1 string) (r0 *goztl.MDMFileVaultConfig, r1 *goztl.Response, r2 error) {
+	f.record("GetByName", a1)
+	if f.GetByNameFunc == nil {
+		r2 = errNotStubbed("MDMFileVaultConfigsService", "GetByName")
+		return
+	}
+	return f.GetByNameFunc(a0, a1)
+}
+
+// Create records the call and returns the results of CreateFunc.
+func (f *MDMFileVaultConfigsService) Create(a0 context.Context, a1 *goztl.MDMFileVaultConfigRequest) (r0 *goztl.MDMFileVaultConfig, r1 *goztl.Response, r2 error) {
+	f.record("Create", a1)
+	if f.CreateFunc == nil {
+		r2 = errNotStubbed("MDMFileVaultConfigsService", "Create")
+		return
+	}
+	return f.CreateFunc(a0, a1)
+}
+
+// Update records the call and returns the results of UpdateFunc.
+func (f *MDMFileVaultConfigsService) Update(a0 context.Context, a1 int, a2 *goztl.MDMFileVaultConfigRequest) (r0 *goztl.MDMFileVaultConfig, r1 *goztl.Response, r2 error) {
+	f.record("Update", a1, a2)
+	if f.UpdateFunc == nil {
+		r2 = errNotStubbed("MDMFileVaultConfigsService", "Update")
+		return
+	}
+	return f.UpdateFunc(a0, a1, a2)
+}
+
+// Delete records the call and returns the results of DeleteFunc.
+func (f *MDMFileVaultConfigsService) Delete(a0 context.Context, a1 int) (r0 *goztl.Response, r1 error) {
+	f.record("Delete", a1)
+	if f.DeleteFunc == nil {
+		r1 = errNotStubbed("MDMFileVaultConfigsService", "Delete")
+		return
+	}
+	return f.DeleteFunc(a0, a1)
+}
+
+// MDMLocationAssetsService is a fake goztl.MDMLocationAssetsService.
+type MDMLocationAssetsService struct {
+	Recorder
+	ListFunc func(context.Context, *goztl.ListOptions) ([]goztl.MDMLocationAsset, *goztl.Response, error)
+	GetFunc  func(context.Context, int, string, string) (*goztl.MDMLocationAsset, *goztl.Response, error)
+}
+
+var _ goztl.MDMLocationAssetsService = &MDMLocationAssetsService{}
+
+// List records the call and returns the results of ListFunc.
+func (f *MDMLocationAssetsService) List(a0 context.Context, a1 *goztl.ListOptions) (r0 []goztl.MDMLocationAsset, r1 *goztl.Response, r2 error) {
+	f.record("List", a1)
+	if f.ListFunc == nil {
+		r2 = errNotStubbed("MDMLocationAssetsService", "List")
+		return
+	}
+	return f.ListFunc(a0, a1)
+}
+
+// Get records the call and returns the results of GetFunc.
+func (f *MDMLocationAssetsService) Get(a0 context.Context, a1 int, a2 string, a3 string) (r0 *goztl.MDMLocationAsset, r1 *goztl.Response, r2 error) {
+	f.record("Get", a1, a2, a3)
+	if f.GetFunc == nil {
+		r2 = errNotStubbed("MDMLocationAssetsService", "Get")
+		return
+	}
+	return f.GetFunc(a0, a1, a2, a3)
+}
+
+// MDMLocationsService is a fake goztl.MDMLocationsService.
+type MDMLocationsService struct {
+	Recorder
+	ListFunc           func(context.Context, *goztl.ListOptions) ([]goztl.MDMLocation, *goztl.Response, error)
+	GetByIDFunc        func(context.Context, int) (*goztl.MDMLocation, *goztl.Response, error)
+	GetByMDMInfoIDFunc func(context.Context, string) (*goztl.MDMLocation, *goztl.Response, error)
+	GetByNameFunc      func(context.Context, string) (*goztl.MDMLocation, *goztl.Response, error)
+}
+
+var _ goztl.MDMLocationsService = &MDMLocationsService{}
+
+// List records the call and returns the results of ListFunc.
+func (f *MDMLocationsService) List(a0 context.Context, a1 *goztl.ListOptions) (r0 []goztl.MDMLocation, r1 *goztl.Response, r2 error) {
+	f.record("List", a1)
+	if f.ListFunc == nil {
+		r2 = errNotStubbed("MDMLocationsService", "List")
+		return
+	}
+	return f.ListFunc(a0, a1)
+}
+
+// GetByID records the call and returns the results of GetByIDFunc.
+func (f *MDMLocationsService) GetByID(a0 context.Context, a1 int) (r0 *goztl.MDMLocation, r1 *goztl.Response, r2 error) {
+	f.record("GetByID", a1)
+	if f.GetByIDFunc == nil {
+		r2 = errNotStubbed("MDMLocationsService", "GetByID")
+		return
+	}
+	return f.GetByIDFunc(a0, a1)
+}
+
+// GetByMDMInfoID records the call and returns the results of GetByMDMInfoIDFunc.
+func (f *MDMLocationsService) GetByMDMInfoID(a0 context.Context, a1 string) (r0 *goztl.MDMLocation, r1 *goztl.Response, r2 error) {
+	f.record("GetByMDMInfoID", a1)
+	if f.GetByMDMInfoIDFunc == nil {
+		r2 = errNotStubbed("MDMLocationsService", "GetByMDMInfoID")
+		return
+	}
+	return f.GetByMDMInfoIDFunc(a0, a1)
+}
+
+// GetByName records the call and returns the results of GetByNameFunc.
+func (f *MDMLocationsService) GetByName(a0 context.Context, a1 string) (r0 *goztl.MDMLocation, r1 *goztl.Response, r2 error) {
+	f.record("GetByName", a1)
+	if f.GetByNameFunc == nil {
+		r2 = errNotStubbed("MDMLocationsService", "GetByName")
+		return
+	}
+	return f.GetByNameFunc(a0, a1)
+}
+
+// MDMOTAEnrollmentsService is a fake goztl.MDMOTAEnrollmentsService.
+type MDMOTAEnrollmentsService struct {
+	Recorder
+	ListFunc      func(context.Context, *goztl.ListOptions) ([]goztl.MDMOTAEnrollment, *goztl.Response, error)
+	GetByIDFunc   func(context.Context, int) (*goztl.MDMOTAEnrollment, *goztl.Response, error)
+	GetByNameFunc func(context.Context, string) (*goztl.MDMOTAEnrollment, *goztl.Response, error)
+	CreateFunc    func(context.Context, *goztl.MDMOTAEnrollmentRequest) (*goztl.MDMOTAEnrollment, *goztl.Response, error)
+	UpdateFunc    func(context.Context, int, *goztl.MDMOTAEnrollmentRequest) (*goztl.MDMOTAEnrollment, *goztl.Response, error)
+	DeleteFunc    func(context.Context, int) (*goztl.Response, error)
+}
+
+var _ goztl.MDMOTAEnrollmentsService = &MDMOTAEnrollmentsService{}
+
+// List records the call and returns the results of ListFunc.
+func (f *MDMOTAEnrollmentsService) List(a0 context.Context, a1 *goztl.ListOptions) (r0 []goztl.MDMOTAEnrollment, r1 *goztl.Response, r2 error) {
+	f.record("List", a1)
+	if f.ListFunc == nil {
+		r2 = errNotStubbed("MDMOTAEnrollmentsService", "List")
+		return
+	}
+	return f.ListFunc(a0, a1)
+}
+
+// GetByID records the call and returns the results of GetByIDFunc.
+func (f *MDMOTAEnrollmentsService) GetByID(a0 context.Context, a1 int) (r0 *goztl.MDMOTAEnrollment, r1 *goztl.Response, r2 error) {
+	f.record("GetByID", a1)
+	if f.GetByIDFunc == nil {
+		r2 = errNotStubbed("MDMOTAEnrollmentsService", "GetByID")
+		return
+	}
+	return f.GetByIDFunc(a0, a1)
+}
+
+// GetByName records the call and returns the results of GetByNameFunc.
+func (f *MDMOTAEnrollmentsService) GetByName(a0 context.Context, a1 string) (r0 *goztl.MDMOTAEnrollment, r1 *goztl.Response, r2 error) {
+	f.record("GetByName", a1)
+	if f.GetByNameFunc == nil {
+		r2 = errNotStubbed("MDMOTAEnrollmentsService", "GetByName")
+		return
+	}
+	return f.GetByNameFunc(a0, a1)
+}
+
+// Create records the call and returns the results of CreateFunc.
+func (f *MDMOTAEnrollmentsService) Create(a0 context.Context, a1 *goztl.MDMOTAEnrollmentRequest) (r0 *goztl.MDMOTAEnrollment, r1 *goztl.Response, r2 error) {
+	f.record("Create", a1)
+	if f.CreateFunc == nil {
+		r2 = errNotStubbed("MDMOTAEnrollmentsService", "Create")
+		return
+	}
+	return f.CreateFunc(a0, a1)
+}
+
+// Update records the call and returns the results of UpdateFunc.
+func (f *MDMOTAEnrollmentsService) Update(a0 context.Context, a1 int, a2 *goztl.MDMOTAEnrollmentRequest) (r0 *goztl.MDMOTAEnrollment, r1 *goztl.Response, r2 error) {
+	f.record("Update", a1, a2)
+	if f.UpdateFunc == nil {
+		r2 = errNotStubbed("MDMOTAEnrollmentsService", "Update")
+		return
+	}
+	return f.UpdateFunc(a0, a1, a2)
+}
+
+// Delete records the call and returns the results of DeleteFunc.
+func (f *MDMOTAEnrollmentsService) Delete(a0 context.Context, a1 int) (r0 *goztl.Response, r1 error) {
+	f.record("Delete", a1)
+	if f.DeleteFunc == nil {
+		r1 = errNotStubbed("MDMOTAEnrollmentsService", "Delete")
+		return
+	}
+	return f.DeleteFunc(a0, a1)
+}
+
+// MDMPackagesService is a fake goztl.MDMPackagesService.
+type MDMPackagesService struct {
+	Recorder
+	ListFunc      func(context.Context, *goztl.ListOptions) ([]goztl.MDMPackage, *goztl.Response, error)
+	GetByIDFunc   func(context.Context, string) (*goztl.MDMPackage, *goztl.Response, error)
+	GetByNameFunc func(context.Context, string) ([]goztl.MDMPackage, *goztl.Response, error)
+	CreateFunc    func(context.Context, *goztl.MDMPackageCreateRequest) (*goztl.MDMPackage, *goztl.Response, error)
+	UpdateFunc    func(context.Context, string, *goztl.MDMPackageUpdateRequest) (*goztl.MDMPackage, *goztl.Response, error)
+	DeleteFunc    func(context.Context, string) (*goztl.Response, error)
+}
+
+var _ goztl.MDMPackagesService = &MDMPackagesService{}
+
+// List records the call and returns the results of ListFunc.
+func (f *MDMPackagesService) List(a0 context.Context, a1 *goztl.ListOptions) (r0 []goztl.MDMPackage, r1 *goztl.Response, r2 error) {
+	f.record("List", a1)
+	if f.ListFunc == nil {
+		r2 = errNotStubbed("MDMPackagesService", "List")
+		return
+	}
+	return f.ListFunc(a0, a1)
+}
+
+// GetByID records the call and returns the results of GetByIDFunc.
+func (f *MDMPackagesService) GetByID(a0 context.Context, a1 string) (r0 *goztl.MDMPackage, r1 *goztl.Response, r2 error) {
+	f.record("GetByID", a1)
+	if f.GetByIDFunc == nil {
+		r2 = errNotStubbed("MDMPackagesService", "GetByID")
+		return
+	}
+	return f.GetByIDFunc(a0, a1)
+}
+
+// GetByName records the call and returns the results of GetByNameFunc.
+func (f *MDMPackagesService) GetByName(a0 context.Context, a1 string) (r0 []goztl.MDMPackage, r1 *goztl.Response, r2 error) {
+	f.record("GetByName", a1)
+	if f.GetByNameFunc == nil {
+		r2 = errNotStubbed("MDMPackagesService", "GetByName")
+		return
+	}
+	return f.GetByNameFunc(a0, a1)
+}
+
+// Create records the call and returns the results of CreateFunc.
+func (f *MDMPackagesService) Create(a0 context.Context, a1 *goztl.MDMPackageCreateRequest) (r0 *goztl.MDMPackage, r1 *goztl.Response, r2 error) {
+	f.record("Create", a1)
+	if f.CreateFunc == nil {
+		r2 = errNotStubbed("MDMPackagesService", "Create")
+		return
+	}
+	return f.CreateFunc(a0, a1)
+}
+
+// Update records the call and returns the results of UpdateFunc.
+func (f *MDMPackagesService) Update(a0 context.Context, a1 string, a2 *goztl.MDMPackageUpdateRequest) (r0 *goztl.MDMPackage, r1 *goztl.Response, r2 error) {
+	f.record("Update", a1, a2)
+	if f.UpdateFunc == nil {
+		r2 = errNotStubbed("MDMPackagesService", "Update")
+		return
+	}
+	return f.UpdateFunc(a0, a1, a2)
+}
+
+// Delete records the call and returns the results of DeleteFunc.
+func (f *MDMPackagesService) Delete(a0 context.Context, a1 string) (r0 *goztl.Response, r1 error) {
+	f.record("Delete", a1)
+	if f.DeleteFunc == nil {
+		r1 = errNotStubbed("MDMPackagesService", "Delete")
+		return
+	}
+	return f.DeleteFunc(a0, a1)
+}
+
+// MDMProfilesService is a fake goztl.MDMProfilesService.
+type MDMProfilesService struct {
+	Recorder
+	ListFunc    func(context.Context, *goztl.ListOptions) ([]goztl.MDMProfile, *goztl.Response, error)
+	GetByIDFunc func(context.Context, string) (*goztl.MDMProfile, *goztl.Response, error)
+	CreateFunc  func(context.Context, *goztl.MDMProfileRequest) (*goztl.MDMProfile, *goztl.Response, error)
+	UpdateFunc  func(context.Context, string, *goztl.MDMProfileRequest) (*goztl.MDMProfile, *goztl.Response, error)
+	DeleteFunc  func(context.Context, string) (*goztl.Response, error)
+}
+
+var _ goztl.MDMProfilesService = &MDMProfilesService{}
+
+// List records the call and returns the results of ListFunc.
+func (f *MDMProfilesService) List(a0 context.Context, a1 *goztl.ListOptions) (r0 []goztl.MDMProfile, r1 *goztl.Response, r2 error) {
+	f.record("List", a1)
+	if f.ListFunc == nil {
+		r2 = errNotStubbed("MDMProfilesService", "List")
+		return
+	}
+	return f.ListFunc(a0, a1)
+}
+
+// GetByID records the call and returns the results of GetByIDFunc.
+func (f *MDMProfilesService) GetByID(a0 context.Context, a1 string) (r0 *goztl.MDMProfile, r1 *goztl.Response, r2 error) {
+	f.record("GetByID", a1)
+	if f.GetByIDFunc == nil {
+		r2 = errNotStubbed("MDMProfilesService", "GetByID")
+		return
+	}
+	return f.GetByIDFunc(a0, a1)
+}
+
+// Create records the call and returns the results of CreateFunc.
+func (f *MDMProfilesService) Create(a0 context.Context, a1 *goztl.MDMProfileRequest) (r0 *goztl.MDMProfile, r1 *goztl.Response, r2 error) {
+	f.record("Create", a1)
+	if f.CreateFunc == nil {
+		r2 = errNotStubbed("MDMProfilesService", "Create")
+		return
+	}
+	return f.CreateFunc(a0, a1)
+}
+
+// Update records the call and returns the results of UpdateFunc.
+func (f *MDMProfilesService) Update(a0 context.Context, a1 string, a2 *goztl.MDMProfileRequest) (r0 *goztl.MDMProfile, r1 *goztl.Response, r2 error) {
+	f.record("Update", a1, a2)
+	if f.UpdateFunc == nil {
+		r2 = errNotStubbed("MDMProfilesService", "Update")
+		return
+	}
+	return f.UpdateFunc(a0, a1, a2)
+}
+
+// Delete records the call and returns the results of DeleteFunc.
+func (f *MDMProfilesService) Delete(a0 context.Context, a1 string) (r0 *goztl.Response, r1 error) {
+	f.record("Delete", a1)
+	if f.DeleteFunc == nil {
+		r1 = errNotStubbed("MDMProfilesService", "Delete")
+		return
+	}
+	return f.DeleteFunc(a0, a1)
+}
+
+// MDMProvisioningProfilesService is a fake goztl.MDMProvisioningProfilesService.
+type MDMProvisioningProfilesService struct {
+	Recorder
+	ListFunc    func(context.Context, *goztl.ListOptions) ([]goztl.MDMProvisioningProfile, *goztl.Response, error)
+	GetByIDFunc func(context.Context, string) (*goztl.MDMProvisioningProfile, *goztl.Response, error)
+	CreateFunc  func(context.Context, *goztl.MDMProvisioningProfileRequest) (*goztl.MDMProvisioningProfile, *goztl.Response, error)
+	UpdateFunc  func(context.Context, string, *goztl.MDMProvisioningProfileRequest) (*goztl.MDMProvisioningProfile, *goztl.Response, error)
+	DeleteFunc  func(context.Context, string) (*goztl.Response, error)
+}
+
+var _ goztl.MDMProvisioningProfilesService = &MDMProvisioningProfilesService{}
+
+// List records the call and returns the results of ListFunc.
+func (f *MDMProvisioningProfilesService) List(a0 context.Context, a1 *goztl.ListOptions) (r0 []goztl.MDMProvisioningProfile, r1 *goztl.Response, r2 error) {
+	f.record("List", a1)
+	if f.ListFunc == nil {
+		r2 = errNotStubbed("MDMProvisioningProfilesService", "List")
+		return
+	}
+	return f.ListFunc(a0, a1)
+}
+
+// GetByID records the call and returns the results of GetByIDFunc.
+func (f *MDMProvisioningProfilesService) GetByID(a0 context.Context, a1 string) (r0 *goztl.MDMProvisioningProfile, r1 *goztl.Response, r2 error) {
+	f.record("GetByID", a1)
+	if f.GetByIDFunc == nil {
+		r2 = errNotStubbed("MDMProvisioningProfilesService", "GetByID")
+		return
+	}
+	return f.GetByIDFunc(a0, a1)
+}
+
+// Create records the call and returns the results of CreateFunc.
+func (f *MDMProvisioningProfilesService) Create(a0 context.Context, a1 *goztl.MDMProvisioningProfileRequest) (r0 *goztl.MDMProvisioningProfile, r1 *goztl.Response, r2 error) {
+	f.record("Create", a1)
+	if f.CreateFunc == nil {
+		r2 = errNotStubbed("MDMProvisioningProfilesService", "Create")
+		return
+	}
+	return f.CreateFunc(a0, a1)
+}
+
+// Update records the call and returns the results of UpdateFunc.
+func (f *MDMProvisioningProfilesService) Update(a0 context.Context, a1 string, a2 *goztl.MDMProvisioningProfileRequest) (r0 *goztl.MDMProvisioningProfile, r1 *goztl.Response, r2 error) {
+	f.record("Update", a1, a2)
+	if f.UpdateFunc == nil {
+		r2 = errNotStubbed("MDMProvisioningProfilesService", "Update")
+		return
+	}
+	return f.UpdateFunc(a0, a1, a2)
+}
+
+// Delete records the call and returns the results of DeleteFunc.
+func (f *MDMProvisioningProfilesService) Delete(a0 context.Context, a1 string) (r0 *goztl.Response, r1 error) {
+	f.record("Delete", a1)
+	if f.DeleteFunc == nil {
+		r1 = errNotStubbed("MDMProvisioningProfilesService", "Delete")
+		return
+	}
+	return f.DeleteFunc(a0, a1)
+}
+
+// MDMPushCertificatesService is a fake goztl.MDMPushCertificatesService.
+type MDMPushCertificatesService struct {
+	Recorder
+	ListFunc      func(context.Context, *goztl.ListOptions) ([]goztl.MDMPushCertificate, *goztl.Response, error)
+	GetByIDFunc   func(context.Context, int) (*goztl.MDMPushCertificate, *goztl.Response, error)
+	GetByNameFunc func(context.Context, string) (*goztl.MDMPushCertificate, *goztl.Response, error)
+}
+
+var _ goztl.MDMPushCertificatesService = &MDMPushCertificatesService{}
+
+// List records the call and returns the results of ListFunc.
+func (f *MDMPushCertificatesService) List(a0 context.Context, a1 *goztl.ListOptions) (r0 []goztl.MDMPushCertificate, r1 *goztl.Response, r2 error) {
+	f.record("List", a1)
+	if f.ListFunc == nil {
+		r2 = errNotStubbed("MDMPushCertificatesService", "List")
+		return
+	}
+	return f.ListFunc(a0, a1)
+}
+
+// GetByID records the call and returns the results of GetByIDFunc.
+func (f *MDMPushCertificatesService) GetByID(a0 context.Context, a1 int) (r0 *goztl.MDMPushCertificate, r1 *goztl.Response, r2 error) {
+	f.record("GetByID", a1)
+	if f.GetByIDFunc == nil {
+		r2 = errNotStubbed("MDMPushCertificatesService", "GetByID")
+		return
+	}
+	return f.GetByIDFunc(a0, a1)
+}
+
+// GetByName records the call and returns the results of GetByNameFunc.
+func (f *MDMPushCertificatesService) GetByName(a0 context.Context, a1 string) (r0 *goztl.MDMPushCertificate, r1 *goztl.Response, r2 error) {
+	f.record("GetByName", a1)
+	if f.GetByNameFunc == nil {
+		r2 = errNotStubbed("MDMPushCertificatesService", "GetByName")
+		return
+	}
+	return f.GetByNameFunc(a0, a1)
+}
+
+// MDMRecoveryPasswordConfigsService is a fake goztl.MDMRecoveryPasswordConfigsService.
+type MDMRecoveryPasswordConfigsService struct {
+	Recorder
+	ListFunc      func(context.Context, *goztl.ListOptions) ([]goztl.MDMRecoveryPasswordConfig, *goztl.Response, error)
+	GetByIDFunc   func(context.Context, int) (*goztl.MDMRecoveryPasswordConfig, *goztl.Response, error)
+	GetByNameFunc func(context.Context, string) (*goztl.MDMRecoveryPasswordConfig, *goztl.Response, error)
+	CreateFunc    func(context.Context, *goztl.MDMRecoveryPasswordConfigRequest) (*goztl.MDMRecoveryPasswordConfig, *goztl.Response, error)
+	UpdateFunc    func(context.Context, int, *goztl.MDMRecoveryPasswordConfigRequest) (*goztl.MDMRecoveryPasswordConfig, *goztl.Response, error)
+	DeleteFunc    func(context.Context, int) (*goztl.Response, error)
+}
+
+var _ goztl.MDMRecoveryPasswordConfigsService = &MDMRecoveryPasswordConfigsService{}
+
+// List records the call and returns the results of ListFunc.
+func (f *MDMRecoveryPasswordConfigsService) List(a0 context.Context, a1 *goztl.ListOptions) (r0 []goztl.MDMRecoveryPasswordConfig, r1 *goztl.Response, r2 error) {
+	f.record("List", a1)
+	if f.ListFunc == nil {
+		r2 = errNotStubbed("MDMRecoveryPasswordConfigsService", "List")
+		return
+	}
+	return f.ListFunc(a0, a1)
+}
+
+// GetByID records the call and returns the results of GetByIDFunc.
+func (f *MDMRecoveryPasswordConfigsService) GetByID(a0 context.Context, a1 int) (r0 *goztl.MDMRecoveryPasswordConfig, r1 *goztl.Response, r2 error) {
+	f.record("GetByID", a1)
+	if f.GetByIDFunc == nil {
+		r2 = errNotStubbed("MDMRecoveryPasswordConfigsService", "GetByID")
+		return
+	}
+	return f.GetByIDFunc(a0, a1)
+}
+
+// GetByName records the call and returns the results of GetByNameFunc.
+func (f *MDMRecoveryPasswordConfigsService) GetByName(a0 context.Context, a1 string) (r0 *goztl.MDMRecoveryPasswordConfig, r1 *goztl.Response, r2 error) {
+	f.record("GetByName", a1)
+	if f.GetByNameFunc == nil {
+		r2 = errNotStubbed("MDMRecoveryPasswordConfigsService", "GetByName")
+		return
+	}
+	return f.GetByNameFunc(a0, a1)
+}
+
+// Create records the call and returns the results of CreateFunc.
+func (f *MDMRecoveryPasswordConfigsService) Create(a0 context.Context, a1 *goztl.MDMRecoveryPasswordConfigRequest) (r0 *goztl.MDMRecoveryPasswordConfig, r1 *goztl.Response, r2 error) {
+	f.record("Create", a1)
+	if f.CreateFunc == nil {
+		r2 = errNotStubbed("MDMRecoveryPasswordConfigsService", "Create")
+		return
+	}
+	return f.CreateFunc(a0, a1)
+}
+
+// Update records the call and returns the results of UpdateFunc.
+func (f *MDMRecoveryPasswordConfigsService) Update(a0 context.Context, a1 int, a2 *goztl.MDMRecoveryPasswordConfigRequest) (r0 *goztl.MDMRecoveryPasswordConfig, r1 *goztl.Response, r2 error) {
+	f.record("Update", a1, a2)
+	if f.UpdateFunc == nil {
+		r2 = errNotStubbed("MDMRecoveryPasswordConfigsService", "Update")
+		return
+	}
+	return f.UpdateFunc(a0, a1, a2)
+}
+
+// Delete records the call and returns the results of DeleteFunc.
+func (f *MDMRecoveryPasswordConfigsService) Delete(a0 context.Context, a1 int) (r0 *goztl.Response, r1 error) {
+	f.record("Delete", a1)
+	if f.DeleteFunc == nil {
+		r1 = errNotStubbed("MDMRecoveryPasswordConfigsService", "Delete")
+		return
+	}
+	return f.DeleteFunc(a0, a1)
+}
+
+// MDMSCEPIssuersService is a fake goztl.MDMSCEPIssuersService.
+type MDMSCEPIssuersService struct {
+	Recorder
+	ListFunc      func(context.Context, *goztl.ListOptions) ([]goztl.MDMSCEPIssuer, *goztl.Response, error)
+	GetByIDFunc   func(context.Context, string) (*goztl.MDMSCEPIssuer, *goztl.Response, error)
+	GetByNameFunc func(context.Context, string) (*goztl.MDMSCEPIssuer, *goztl.Response, error)
+	CreateFunc    func(context.Context, *goztl.MDMSCEPIssuerRequest) (*goztl.MDMSCEPIssuer, *goztl.Response, error)
+	UpdateFunc    func(context.Context, string, *goztl.MDMSCEPIssuerRequest) (*goztl.MDMSCEPIssuer, *goztl.Response, error)
+	DeleteFunc    func(context.Context, string) (*goztl.Response, error)
+}
+
+var _ goztl.MDMSCEPIssuersService = &MDMSCEPIssuersService{}
+
+// List records the call and returns the results of ListFunc.
+func (f *MDMSCEPIssuersService) List(a0 context.Context, a1 *goztl.ListOptions) (r0 []goztl.MDMSCEPIssuer, r1 *goztl.Response, r2 error) {
+	f.record("List", a1)
+	if f.ListFunc == nil {
+		r2 = errNotStubbed("MDMSCEPIssuersService", "List")
+		return
+	}
+	return f.ListFunc(a0, a1)
+}
+
+// GetByID records the call and returns the results of GetByIDFunc.
+func (f *MDMSCEPIssuersService) GetByID(a0 context.Context, a1 string) (r0 *goztl.MDMSCEPIssuer, r1 *goztl.Response, r2 error) {
+	f.record("GetByID", a1)
+	if f.GetByIDFunc == nil {
+		r2 = errNotStubbed("MDMSCEPIssuersService", "GetByID")
+		return
+	}
+	return f.GetByIDFunc(a0, a1)
+}
+
+// GetByName records the call and returns the results of GetByNameFunc.
+func (f *MDMSCEPIssuersService) GetByName(a0 context.Context, a1 string) (r0 *goztl.MDMSCEPIssuer, r1 *goztl.Response, r2 error) {
+	f.record("GetByName", a1)
+	if f.GetByNameFunc == nil {
+		r2 = errNotStubbed("MDMSCEPIssuersService", "GetByName")
+		return
+	}
+	return f.GetByNameFunc(a0, a1)
+}
+
+// Create records the call and returns the results of CreateFunc.
+func (f *MDMSCEPIssuersService) Create(a0 context.Context, a1 *goztl.MDMSCEPIssuerRequest) (r0 *goztl.MDMSCEPIssuer, r1 *goztl.Response, r2 error) {
+	f.record("Create", a1)
+	if f.CreateFunc == nil {
+		r2 = errNotStubbed("MDMSCEPIssuersService", "Create")
+		return
+	}
+	return f.CreateFunc(a0, a1)
+}
+
+// Update records the call and returns the results of UpdateFunc.
+func (f *MDMSCEPIssuersService) Update(a0 context.Context, a1 string, a2 *goztl.MDMSCEPIssuerRequest) (r0 *goztl.MDMSCEPIssuer, r1 *goztl.Response, r2 error) {
+	f.record("Update", a1, a2)
+	if f.UpdateFunc == nil {
+		r2 = errNotStubbed("MDMSCEPIssuersService", "Update")
+		return
+	}
+	return f.UpdateFunc(a0, a1, a2)
+}
+
+// Delete records the call and returns the results of DeleteFunc.
+func (f *MDMSCEPIssuersService) Delete(a0 context.Context, a1 string) (r0 *goztl.Response, r1 error) {
+	f.record("Delete", a1)
+	if f.DeleteFunc == nil {
+		r1 = errNotStubbed("MDMSCEPIssuersService", "Delete")
+		return
+	}
+	return f.DeleteFunc(a0, a1)
+}
+
+// MDMSoftwareUpdateEnforcementsService is a fake goztl.MDMSoftwareUpdateEnforcementsService.
+type MDMSoftwareUpdateEnforcementsService struct {
+	Recorder
+	ListFunc      func(context.Context, *goztl.ListOptions) ([]goztl.MDMSoftwareUpdateEnforcement, *goztl.Response, error)
+	GetByIDFunc   func(context.Context, int) (*goztl.MDMSoftwareUpdateEnforcement, *goztl.Response, error)
+	GetByNameFunc func(context.Context, string) (*goztl.MDMSoftwareUpdateEnforcement, *goztl.Response, error)
+	CreateFunc    func(context.Context, *goztl.MDMSoftwareUpdateEnforcementRequest) (*goztl.MDMSoftwareUpdateEnforcement, *goztl.Response, error)
+	UpdateFunc    func(context.Context, int, *goztl.MDMSoftwareUpdateEnforcementRequest) (*goztl.MDMSoftwareUpdateEnforcement, *goztl.Response, error)
+	DeleteFunc    func(context.Context, int) (*goztl.Response, error)
+}
+
+var _ goztl.MDMSoftwareUpdateEnforcementsService = &MDMSoftwareUpdateEnforcementsService{}
+
+// List records the call and returns the results of ListFunc.
+func (f *MDMSoftwareUpdateEnforcementsService) List(a0 context.Context, a1 *goztl.ListOptions) (r0 []goztl.MDMSoftwareUpdateEnforcement, r1 *goztl.Response, r2 error) {
+	f.record("List", a1)
+	if f.ListFunc == nil {
+		r2 = errNotStubbed("MDMSoftwareUpdateEnforcementsService", "List")
+		return
+	}
+	return f.ListFunc(a0, a1)
+}
+
+// GetByID records the call and returns the results of GetByIDFunc.
+func (f *MDMSoftwareUpdateEnforcementsService) GetByID(a0 context.Context, a1 int) (r0 *goztl.MDMSoftwareUpdateEnforcement, r1 *goztl.Response, r2 error) {
+	f.record("GetByID", a1)
+	if f.GetByIDFunc == nil {
+		r2 = errNotStubbed("MDMSoftwareUpdateEnforcementsService", "GetByID")
+		return
+	}
+	return f.GetByIDFunc(a0, a1)
+}
+
+// GetByName records the call and returns the results of GetByNameFunc.
+func (f *MDMSoftwareUpdateEnforcementsService) GetByName(a0 context.Context, a1 string) (r0 *goztl.MDMSoftwareUpdateEnforcement, r1 *goztl.Response, r2 error) {
+	f.record("GetByName", a1)
+	if f.GetByNameFunc == nil {
+		r2 = errNotStubbed("MDMSoftwareUpdateEnforcementsService", "GetByName")
+		return
+	}
+	return f.GetByNameFunc(a0, a1)
+}
+
+// Create records the call and returns the results of CreateFunc.
+func (f *MDMSoftwareUpdateEnforcementsService) Create(a0 context.Context, a1 *goztl.MDMSoftwareUpdateEnforcementRequest) (r0 *goztl.MDMSoftwareUpdateEnforcement, r1 *goztl.Response, r2 error) {
+	f.record("Create", a1)
+	if f.CreateFunc == nil {
+		r2 = errNotStubbed("MDMSoftwareUpdateEnforcementsService", "Create")
+		return
+	}
+	return f.CreateFunc(a0, a1)
+}
+
+// Update records the call and returns the results of UpdateFunc.
+func (f *MDMSoftwareUpdateEnforcementsService) Update(a0 context.Context, a1 int, a2 *goztl.MDMSoftwareUpdateEnforcementRequest) (r0 *goztl.MDMSoftwareUpdateEnforcement, r1 *goztl.Response, r2 error) {
+	f.record("Update", a1, a2)
+	if f.UpdateFunc == nil {
+		r2 = errNotStubbed("MDMSoftwareUpdateEnforcementsService", "Update")
+		return
+	}
+	return f.UpdateFunc(a0, a1, a2)
+}
+
+// Delete records the call and returns the results of DeleteFunc.
+func (f *MDMSoftwareUpdateEnforcementsService) Delete(a0 context.Context, a1 int) (r0 *goztl.Response, r1 error) {
+	f.record("Delete", a1)
+	if f.DeleteFunc == nil {
+		r1 = errNotStubbed("MDMSoftwareUpdateEnforcementsService", "Delete")
+		return
+	}
+	return f.DeleteFunc(a0, a1)
+}
+
+// MDMStoreAppsService is a fake goztl.MDMStoreAppsService.
+type MDMStoreAppsService struct {
+	Recorder
+	ListFunc    func(context.Context, *goztl.ListOptions) ([]goztl.MDMStoreApp, *goztl.Response, error)
+	GetByIDFunc func(context.Context, string) (*goztl.MDMStoreApp, *goztl.Response, error)
+	CreateFunc  func(context.Context, *goztl.MDMStoreAppRequest) (*goztl.MDMStoreApp, *goztl.Response, error)
+	UpdateFunc  func(context.Context, string, *goztl.MDMStoreAppRequest) (*goztl.MDMStoreApp, *goztl.Response, error)
+	DeleteFunc  func(context.Context, string) (*goztl.Response, error)
+}
+
+var _ goztl.MDMStoreAppsService = &MDMStoreAppsService{}
+
+// List records the call and returns the results of ListFunc.
+func (f *MDMStoreAppsService) List(a0 context.Context, a1 *goztl.ListOptions) (r0 []goztl.MDMStoreApp, r1 *goztl.Response, r2 error) {
+	f.record("List", a1)
+	if f.ListFunc == nil {
+		r2 = errNotStubbed("MDMStoreAppsService", "List")
+		return
+	}
+	return f.ListFunc(a0, a1)
+}
+
+// GetByID records the call and returns the results of GetByIDFunc.
+func (f *MDMStoreAppsService) GetByID(a0 context.Context, a1 string) (r0 *goztl.MDMStoreApp, r1 *goztl.Response, r2 error) {
+	f.record("GetByID", a1)
+	if f.GetByIDFunc == nil {
+		r2 = errNotStubbed("MDMStoreAppsService", "GetByID")
+		return
+	}
+	return f.GetByIDFunc(a0, a1)
+}
+
+// Create records the call and returns the results of CreateFunc.
+func (f *MDMStoreAppsService) Create(a0 context.Context, a1 *goztl.MDMStoreAppRequest) (r0 *goztl.MDMStoreApp, r1 *goztl.Response, r2 error) {
+	f.record("Create", a1)
+	if f.CreateFunc == nil {
+		r2 = errNotStubbed("MDMStoreAppsService", "Create")
+		return
+	}
+	return f.CreateFunc(a0, a1)
+}
+
+// Update records the call and returns the results of UpdateFunc.
+func (f *MDMStoreAppsService) Update(a0 context.Context, a1 string, a2 *goztl.MDMStoreAppRequest) (r0 *goztl.MDMStoreApp, r1 *goztl.Response, r2 error) {
+	f.record("Update", a1, a2)
+	if f.UpdateFunc == nil {
+		r2 = errNotStubbed("MDMStoreAppsService", "Update")
+		return
+	}
+	return f.UpdateFunc(a0, a1, a2)
+}
+
+// Delete records the call and returns the results of DeleteFunc.
+func (f *MDMStoreAppsService) Delete(a0 context.Context, a1 string) (r0 *goztl.Response, r1 error) {
+	f.record("Delete", a1)
+	if f.DeleteFunc == nil {
+		r1 = errNotStubbed("MDMStoreAppsService", "Delete")
+		return
+	}
+	return f.DeleteFunc(a0, a1)
+}
+
+// MetaBusinessUnitsService is a fake goztl.MetaBusinessUnitsService.
+type MetaBusinessUnitsService struct {
+	Recorder
+	ListFunc      func(context.Context, *goztl.ListOptions) ([]goztl.MetaBusinessUnit, *goztl.Response, error)
+	GetByIDFunc   func(context.Context, int) (*goztl.MetaBusinessUnit, *goztl.Response, error)
+	GetByNameFunc func(context.Context, string) (*goztl.MetaBusinessUnit, *goztl.Response, error)
+	CreateFunc    func(context.Context, *goztl.MetaBusinessUnitCreateRequest) (*goztl.MetaBusinessUnit, *goztl.Response, error)
+	UpdateFunc    func(context.Context, int, *goztl.MetaBusinessUnitUpdateRequest) (*goztl.MetaBusinessUnit, *goztl.Response, error)
+	DeleteFunc    func(context.Context, int) (*goztl.Response, error)
+}
+
+var _ goztl.MetaBusinessUnitsService = &MetaBusinessUnitsService{}
+
+// List records the call and returns the results of ListFunc.
+func (f *MetaBusinessUnitsService) List(a0 context.Context, a1 *goztl.ListOptions) (r0 []goztl.MetaBusinessUnit, r1 *goztl.Response, r2 error) {
+	f.record("List", a1)
+	if f.ListFunc == nil {
+		r2 = errNotStubbed("MetaBusinessUnitsService", "List")
+		return
+	}
+	return f.ListFunc(a0, a1)
+}
+
+// GetByID records the call and returns the results of GetByIDFunc.
+func (f *MetaBusinessUnitsService) GetByID(a0 context.Context, a1 int) (r0 *goztl.MetaBusinessUnit, r1 *goztl.Response, r2 error) {
+	f.record("GetByID", a1)
+	if f.GetByIDFunc == nil {
+		r2 = errNotStubbed("MetaBusinessUnitsService", "GetByID")
+		return
+	}
+	return f.GetByIDFunc(a0, a1)
+}
+
+// GetByName records the call and returns the results of GetByNameFunc.
+func (f *MetaBusinessUnitsService) GetByName(a0 context.Context, a1 string) (r0 *goztl.MetaBusinessUnit, r1 *goztl.Response, r2 error) {
+	f.record("GetByName", a1)
+	if f.GetByNameFunc == nil {
+		r2 = errNotStubbed("MetaBusinessUnitsService", "GetByName")
+		return
+	}
+	return f.GetByNameFunc(a0, a1)
+}
+
+// Create records the call and returns the results of CreateFunc.
+func (f *MetaBusinessUnitsService) Create(a0 context.Context, a1 *goztl.MetaBusinessUnitCreateRequest) (r0 *goztl.MetaBusinessUnit, r1 *goztl.Response, r2 error) {
+	f.record("Create", a1)
+	if f.CreateFunc == nil {
+		r2 = errNotStubbed("MetaBusinessUnitsService", "Create")
+		return
+	}
+	return f.CreateFunc(a0, a1)
+}
+
+// Update records the call and returns the results of UpdateFunc.
+func (f *MetaBusinessUnitsService) Update(a0 context.Context, a1 int, a2 *goztl.MetaBusinessUnitUpdateRequest) (r0 *goztl.MetaBusinessUnit, r1 *goztl.Response, r2 error) {
+	f.record("Update", a1, a2)
+	if f.UpdateFunc == nil {
+		r2 = errNotStubbed("MetaBusinessUnitsService", "Update")
+		return
+	}
+	return f.UpdateFunc(a0, a1, a2)
+}
+
+// Delete records the call and returns the results of DeleteFunc.
+func (f *MetaBusinessUnitsService) Delete(a0 context.Context, a1 int) (r0 *goztl.Response, r1 error) {
+	f.record("Delete", a1)
+	if f.DeleteFunc == nil {
+		r1 = errNotStubbed("MetaBusinessUnitsService", "Delete")
+		return
+	}
+	return f.DeleteFunc(a0, a1)
+}
+
+// MonolithCatalogsService is a fake goztl.MonolithCatalogsService.
+type MonolithCatalogsService struct {
+	Recorder
+	ListFunc                     func(context.Context, *goztl.ListOptions) ([]goztl.MonolithCatalog, *goztl.Response, error)
+	GetByIDFunc                  func(context.Context, int) (*goztl.MonolithCatalog, *goztl.Response, error)
+	GetByNameAndRepositoryIDFunc func(context.Context, string, int) (*goztl.MonolithCatalog, *goztl.Response, error)
+	CreateFunc                   func(context.Context, *goztl.MonolithCatalogRequest) (*goztl.MonolithCatalog, *goztl.Response, error)
+	UpdateFunc                   func(context.Context, int, *goztl.MonolithCatalogRequest) (*goztl.MonolithCatalog, *goztl.Response, error)
+	DeleteFunc                   func(context.Context, int) (*goztl.Response, error)
+}
+
+var _ goztl.MonolithCatalogsService = &MonolithCatalogsService{}
+
+// List records the call and returns the results of ListFunc.
+func (f *MonolithCatalogsService) List(a0 context.Context, a1 *goztl.ListOptions) (r0 []goztl.MonolithCatalog, r1 *goztl.Response, r2 error) {
+	f.record("List", a1)
+	if f.ListFunc == nil {
+		r2 = errNotStubbed("MonolithCatalogsService", "List")
+		return
+	}
+	return f.ListFunc(a0, a1)
+}
+
+// GetByID records the call and returns the results of GetByIDFunc.
+func (f *MonolithCatalogsService) GetByID(a0 context.Context, a1 int) (r0 *goztl.MonolithCatalog, r1 *goztl.Response, r2 error) {
+	f.record("GetByID", a1)
+	if f.GetByIDFunc == nil {
+		r2 = errNotStubbed("MonolithCatalogsService", "GetByID")
+		return
+	}
+	return f.GetByIDFunc(a0, a1)
+}
+
+// GetByNameAndRepositoryID records the call and returns the results of GetByNameAndRepositoryIDFunc.
+func (f *MonolithCatalogsService) GetByNameAndRepositoryID(a0 context.Context, a1 string, a2 int) (r0 *goztl.MonolithCatalog, r1 *goztl.Response, r2 error) {
+	f.record("GetByNameAndRepositoryID", a1, a2)
+	if f.GetByNameAndRepositoryIDFunc == nil {
+		r2 = errNotStubbed("MonolithCatalogsService", "GetByNameAndRepositoryID")
+		return
+	}
+	return f.GetByNameAndRepositoryIDFunc(a0, a1, a2)
+}
+
+// Create records the call and returns the results of CreateFunc.
+func (f *MonolithCatalogsService) Create(a0 context.Context, a1 *goztl.MonolithCatalogRequest) (r0 *goztl.MonolithCatalog, r1 *goztl.Response, r2 error) {
+	f.record("Create", a1)
+	if f.CreateFunc == nil {
+		r2 = errNotStubbed("MonolithCatalogsService", "Create")
+		return
+	}
+	return f.CreateFunc(a0, a1)
+}
+
+// Update records the call and returns the results of UpdateFunc.
+func (f *MonolithCatalogsService) Update(a0 context.Context, a1 int, a2 *goztl.MonolithCatalogRequest) (r0 *goztl.MonolithCatalog, r1 *goztl.Response, r2 error) {
+	f.record("Update", a1, a2)
+	if f.UpdateFunc == nil {
+		r2 = errNotStubbed("MonolithCatalogsService", "Update")
+		return
+	}
+	return f.UpdateFunc(a0, a1, a2)
+}
+
+// Delete records the call and returns the results of DeleteFunc.
+func (f *MonolithCatalogsService) Delete(a0 context.Context, a1 int) (r0 *goztl.Response, r1 error) {
+	f.record("Delete", a1)
+	if f.DeleteFunc == nil {
+		r1 = errNotStubbed("MonolithCatalogsService", "Delete")
+		return
+	}
+	return f.DeleteFunc(a0, a1)
+}
+
+// MonolithConditionsService is a fake goztl.MonolithConditionsService.
+type MonolithConditionsService struct {
+	Recorder
+	ListFunc      func(context.Context, *goztl.ListOptions) ([]goztl.MonolithCondition, *goztl.Response, error)
+	GetByIDFunc   func(context.Context, int) (*goztl.MonolithCondition, *goztl.Response, error)
+	GetByNameFunc func(context.Context, string) (*goztl.MonolithCondition, *goztl.Response, error)
+	CreateFunc    func(context.Context, *goztl.MonolithConditionRequest) (*goztl.MonolithCondition, *goztl.Response, error)
+	UpdateFunc    func(context.Context, int, *goztl.MonolithConditionRequest) (*goztl.MonolithCondition, *goztl.Response, error)
+	DeleteFunc    func(context.Context, int) (*goztl.Response, error)
+}
+
+var _ goztl.MonolithConditionsService = &MonolithConditionsService{}
+
+// List records the call and returns the results of ListFunc.
+func (f *MonolithConditionsService) List(a0 context.Context, a1 *goztl.ListOptions) (r0 []goztl.MonolithCondition, r1 *goztl.Response, r2 error) {
+	f.record("List", a1)
+	if f.ListFunc == nil {
+		r2 = errNotStubbed("MonolithConditionsService", "List")
+		return
+	}
+	return f.ListFunc(a0, a1)
+}
+
+// GetByID records the call and returns the results of GetByIDFunc.
+func (f *MonolithConditionsService) GetByID(a0 context.Context, a1 int) (r0 *goztl.MonolithCondition, r1 *goztl.Response, r2 error) {
+	f.record("GetByID", a1)
+	if f.GetByIDFunc == nil {
+		r2 = errNotStubbed("MonolithConditionsService", "GetByID")
+		return
+	}
+	return f.GetByIDFunc(a0, a1)
+}
+
+// GetByName records the call and returns the results of GetByNameFunc.
+func (f *MonolithConditionsService) GetByName(a0 context.Context, a1 string) (r0 *goztl.MonolithCondition, r1 *goztl.Response, r2 error) {
+	f.record("GetByName", a1)
+	if f.GetByNameFunc == nil {
+		r2 = errNotStubbed("MonolithConditionsService", "GetByName")
+		return
+	}
+	return f.GetByNameFunc(a0, a1)
+}
+
+// Create records the call and returns the results of CreateFunc.
+func (f *MonolithConditionsService) Create(a0 context.Context, a1 *goztl.MonolithConditionRequest) (r0 *goztl.MonolithCondition, r1 *goztl.Response, r2 error) {
+	f.record("Create", a1)
+	if f.CreateFunc == nil {
+		r2 = errNotStubbed("MonolithConditionsService", "Create")
+		return
+	}
+	return f.CreateFunc(a0, a1)
+}
+
+// Update records the call and returns the results of UpdateFunc.
+func (f *MonolithConditionsService) Update(a0 context.Context, a1 int, a2 *goztl.MonolithConditionRequest) (r0 *goztl.MonolithCondition, r1 *goztl.Response, r2 error) {
+	f.record("Update", a1, a2)
+	if f.UpdateFunc == nil {
+		r2 = errNotStubbed("MonolithConditionsService", "Update")
+		return
+	}
+	return f.UpdateFunc(a0, a1, a2)
+}
+
+// Delete records the call and returns the results of DeleteFunc.
+func (f *MonolithConditionsService) Delete(a0 context.Context, a1 int) (r0 *goztl.Response, r1 error) {
+	f.record("Delete", a1)
+	if f.DeleteFunc == nil {
+		r1 = errNotStubbed("MonolithConditionsService", "Delete")
+		return
+	}
+	return f.DeleteFunc(a0, a1)
+}
+
+// MonolithEnrollmentsService is a fake goztl.MonolithEnrollmentsService.
+type MonolithEnrollmentsService struct {
+	Recorder
+	ListFunc            func(context.Context, *goztl.ListOptions) ([]goztl.MonolithEnrollment, *goztl.Response, error)
+	GetByIDFunc         func(context.Context, int) (*goztl.MonolithEnrollment, *goztl.Response, error)
+	GetByManifestIDFunc func(context.Context, int) ([]goztl.MonolithEnrollment, *goztl.Response, error)
+	CreateFunc          func(context.Context, *goztl.MonolithEnrollmentRequest) (*goztl.MonolithEnrollment, *goztl.Response, error)
+	UpdateFunc          func(context.Context, int, *goztl.MonolithEnrollmentRequest) (*goztl.MonolithEnrollment, *goztl.Response, error)
+	DeleteFunc          func(context.Context, int) (*goztl.Response, error)
+}
+
+var _ goztl.MonolithEnrollmentsService = &MonolithEnrollmentsService{}
+
+// List records the call and returns the results of ListFunc.
+func (f *MonolithEnrollmentsService) List(a0 context.Context, a1 *goztl.ListOptions) (r0 []goztl.MonolithEnrollment, r1 *goztl.Response, r2 error) {
+	f.record("List", a1)
+	if f.ListFunc == nil {
+		r2 = errNotStubbed("MonolithEnrollmentsService", "List")
+		return
+	}
+	return f.ListFunc(a0, a1)
+}
+
+// GetByID records the call and returns the results of GetByIDFunc.
+func (f *MonolithEnrollmentsService) GetByID(a0 context.Context, a1 int) (r0 *goztl.MonolithEnrollment, r1 *goztl.Response, r2 error) {
+	f.record("GetByID", a1)
+	if f.GetByIDFunc == nil {
+		r2 = errNotStubbed("MonolithEnrollmentsService", "GetByID")
+		return
+	}
+	return f.GetByIDFunc(a0, a1)
+}
+
+// GetByManifestID records the call and returns the results of GetByManifestIDFunc.
+func (f *MonolithEnrollmentsService) GetByManifestID(a0 context.Context, a1 int) (r0 []goztl.MonolithEnrollment, r1 *goztl.Response, r2 error) {
+	f.record("GetByManifestID", a1)
+	if f.GetByManifestIDFunc == nil {
+		r2 = errNotStubbed("MonolithEnrollmentsService", "GetByManifestID")
+		return
+	}
+	return f.GetByManifestIDFunc(a0, a1)
+}
+
+// Create records the call and returns the results of CreateFunc.
+func (f *MonolithEnrollmentsService) Create(a0 context.Context, a1 *goztl.MonolithEnrollmentRequest) (r0 *goztl.MonolithEnrollment, r1 *goztl.Response, r2 error) {
+	f.record("Create", a1)
+	if f.CreateFunc == nil {
+		r2 = errNotStubbed("MonolithEnrollmentsService", "Create")
+		return
+	}
+	return f.CreateFunc(a0, a1)
+}
+
+// Update records the call and returns the results of UpdateFunc.
+func (f *MonolithEnrollmentsService) Update(a0 context.Context, a1 int, a2 *goztl.MonolithEnrollmentRequest) (r0 *goztl.MonolithEnrollment, r1 *goztl.Response, r2 error) {
+	f.record("Update", a1, a2)
+	if f.UpdateFunc == nil {
+		r2 = errNotStubbed("MonolithEnrollmentsService", "Update")
+		return
+	}
+	return f.UpdateFunc(a0, a1, a2)
+}
+
+// Delete records the call and returns the results of DeleteFunc.
+func (f *MonolithEnrollmentsService) Delete(a0 context.Context, a1 int) (r0 *goztl.Response, r1 error) {
+	f.record("Delete", a1)
+	if f.DeleteFunc == nil {
+		r1 = errNotStubbed("MonolithEnrollmentsService", "Delete")
+		return
+	}
+	return f.DeleteFunc(a0, a1)
+}
+
+// MonolithManifestCatalogsService is a fake goztl.MonolithManifestCatalogsService.
+type MonolithManifestCatalogsService struct {
+	Recorder
+	ListFunc            func(context.Context, *goztl.ListOptions) ([]goztl.MonolithManifestCatalog, *goztl.Response, error)
+	GetByIDFunc         func(context.Context, int) (*goztl.MonolithManifestCatalog, *goztl.Response, error)
+	GetByCatalogIDFunc  func(context.Context, int) ([]goztl.MonolithManifestCatalog, *goztl.Response, error)
+	GetByManifestIDFunc func(context.Context, int) ([]goztl.MonolithManifestCatalog, *goztl.Response, error)
+	CreateFunc          func(context.Context, *goztl.MonolithManifestCatalogRequest) (*goztl.MonolithManifestCatalog, *goztl.Response, error)
+	UpdateFunc          func(context.Context, int, *goztl.MonolithManifestCatalogRequest) (*goztl.MonolithManifestCatalog, *goztl.Response, error)
+	DeleteFunc          func(context.Context, int) (*goztl.Response, error)
+}
+
+var _ goztl.MonolithManifestCatalogsService = &MonolithManifestCatalogsService{}
+
+// List records the call and returns the results of ListFunc.
+func (f *MonolithManifestCatalogsService) List(a0 context.Context, a1 *goztl.ListOptions) (r0 []goztl.MonolithManifestCatalog, r1 *goztl.Response, r2 error) {
+	f.record("List", a1)
+	if f.ListFunc == nil {
+		r2 = errNotStubbed("MonolithManifestCatalogsService", "List")
+		return
+	}
+	return f.ListFunc(a0, a1)
+}
+
+// GetByID records the call and returns the results of GetByIDFunc.
+func (f *MonolithManifestCatalogsService) GetByID(a0 context.Context, a1 int) (r0 *goztl.MonolithManifestCatalog, r1 *goztl.Response, r2 error) {
+	f.record("GetByID", a1)
+	if f.GetByIDFunc == nil {
+		r2 = errNotStubbed("MonolithManifestCatalogsService", "GetByID")
+		return
+	}
+	return f.GetByIDFunc(a0, a1)
+}
+
+// GetByCatalogID records the call and returns the results of GetByCatalogIDFunc.
+func (f *MonolithManifestCatalogsService) GetByCatalogID(a0 context.Context, a1 int) (r0 []goztl.MonolithManifestCatalog, r1 *goztl.Response, r2 error) {
+	f.record("GetByCatalogID", a1)
+	if f.GetByCatalogIDFunc == nil {
+		r2 = errNotStubbed("MonolithManifestCatalogsService", "GetByCatalogID")
+		return
+	}
+	return f.GetByCatalogIDFunc(a0, a1)
+}
+
+// GetByManifestID records the call and returns the results of GetByManifestIDFunc.
+func (f *MonolithManifestCatalogsService) GetByManifestID(a0 context.Context, a1 int) (r0 []goztl.MonolithManifestCatalog, r1 *goztl.Response, r2 error) {
+	f.record("GetByManifestID", a1)
+	if f.GetByManifestIDFunc == nil {
+		r2 = errNotStubbed("MonolithManifestCatalogsService", "GetByManifestID")
+		return
+	}
+	return f.GetByManifestIDFunc(a0, a1)
+}
+
+// Create records the call and returns the results of CreateFunc.
+func (f *MonolithManifestCatalogsService) Create(a0 context.Context, a1 *goztl.MonolithManifestCatalogRequest) (r0 *goztl.MonolithManifestCatalog, r1 *goztl.Response, r2 error) {
+	f.record("Create", a1)
+	if f.CreateFunc == nil {
+		r2 = errNotStubbed("MonolithManifestCatalogsService", "Create")
+		return
+	}
+	return f.CreateFunc(a0, a1)
+}
+
+// Update records the call and returns the results of UpdateFunc.
+func (f *MonolithManifestCatalogsService) Update(a0 context.Context, a1 int, a2 *goztl.MonolithManifestCatalogRequest) (r0 *goztl.MonolithManifestCatalog, r1 *goztl.Response, r2 error) {
+	f.record("Update", a1, a2)
+	if f.UpdateFunc == nil {
+		r2 = errNotStubbed("MonolithManifestCatalogsService", "Update")
+		return
+	}
+	return f.UpdateFunc(a0, a1, a2)
+}
+
+// Delete records the call and returns the results of DeleteFunc.
+func (f *MonolithManifestCatalogsService) Delete(a0 context.Context, a1 int) (r0 *goztl.Response, r1 error) {
+	f.record("Delete", a1)
+	if f.DeleteFunc == nil {
+		r1 = errNotStubbed("MonolithManifestCatalogsService", "Delete")
+		return
+	}
+	return f.DeleteFunc(a0, a1)
+}
+
+// MonolithManifestEnrollmentPackagesService is a fake goztl.MonolithManifestEnrollmentPackagesService.
+type MonolithManifestEnrollmentPackagesService struct {
+	Recorder
+	ListFunc            func(context.Context, *goztl.ListOptions) ([]goztl.MonolithManifestEnrollmentPackage, *goztl.Response, error)
+	GetByIDFunc         func(context.Context, int) (*goztl.MonolithManifestEnrollmentPackage, *goztl.Response, error)
+	GetByManifestIDFunc func(context.Context, int) ([]goztl.MonolithManifestEnrollmentPackage, *goztl.Response, error)
+	CreateFunc          func(context.Context, *goztl.MonolithManifestEnrollmentPackageRequest) (*goztl.MonolithManifestEnrollmentPackage, *goztl.Response, error)
+	UpdateFunc          func(context.Context, int, *goztl.MonolithManifestEnrollmentPackageRequest) (*goztl.MonolithManifestEnrollmentPackage, *goztl.Response, error)
+	DeleteFunc          func(context.Context, int) (*goztl.Response, error)
+}
+
+var _ goztl.MonolithManifestEnrollmentPackagesService = &MonolithManifestEnrollmentPackagesService{}
+
+// List records the call and returns the results of ListFunc.
+func (f *MonolithManifestEnrollmentPackagesService) List(a0 context.Context, a1 *goztl.ListOptions) (r0 []goztl.MonolithManifestEnrollmentPackage, r1 *goztl.Response, r2 error) {
+	f.record("List", a1)
+	if f.ListFunc == nil {
+		r2 = errNotStubbed("MonolithManifestEnrollmentPackagesService", "List")
+		return
+	}
+	return f.ListFunc(a0, a1)
+}
+
+// GetByID records the call and returns the results of GetByIDFunc.
+func (f *MonolithManifestEnrollmentPackagesService) GetByID(a0 context.Context, a1 int) (r0 *goztl.MonolithManifestEnrollmentPackage, r1 *goztl.Response, r2 error) {
+	f.record("GetByID", a1)
+	if f.GetByIDFunc == nil {
+		r2 = errNotStubbed("MonolithManifestEnrollmentPackagesService", "GetByID")
+		return
+	}
+	return f.GetByIDFunc(a0, a1)
+}
+
+// GetByManifestID records the call and returns the results of GetByManifestIDFunc.
+func (f *MonolithManifestEnrollmentPackagesService) GetByManifestID(a0 context.Context, a1 int) (r0 []goztl.MonolithManifestEnrollmentPackage, r1 *goztl.Response, r2 error) {
+	f.record("GetByManifestID", a1)
+	if f.GetByManifestIDFunc == nil {
+		r2 = errNotStubbed("MonolithManifestEnrollmentPackagesService", "GetByManifestID")
+		return
+	}
+	return f.GetByManifestIDFunc(a0, a1)
+}
+
+// Create records the call and returns the results of CreateFunc.
+func (f *MonolithManifestEnrollmentPackagesService) Create(a0 context.Context, a1 *goztl.MonolithManifestEnrollmentPackageRequest) (r0 *goztl.MonolithManifestEnrollmentPackage, r1 *goztl.Response, r2 error) {
+	f.record("Create", a1)
+	if f.CreateFunc == nil {
+		r2 = errNotStubbed("MonolithManifestEnrollmentPackagesService", "Create")
+		return
+	}
+	return f.CreateFunc(a0, a1)
+}
+
+// Update records the call and returns the results of UpdateFunc.
+func (f *MonolithManifestEnrollmentPackagesService) Update(a0 context.Context, a1 int, a2 *goztl.MonolithManifestEnrollmentPackageRequest) (r0 *goztl.MonolithManifestEnrollmentPackage, r1 *goztl.Response, r2 error) {
+	f.record("Update", a1, a2)
+	if f.UpdateFunc == nil {
+		r2 = errNotStubbed("MonolithManifestEnrollmentPackagesService", "Update")
+		return
+	}
+	return f.UpdateFunc(a0, a1, a2)
+}
+
+// Delete records the call and returns the results of DeleteFunc.
+func (f *MonolithManifestEnrollmentPackagesService) Delete(a0 context.Context, a1 int) (r0 *goztl.Response, r1 error) {
+	f.record("Delete", a1)
+	if f.DeleteFunc == nil {
+		r1 = errNotStubbed("MonolithManifestEnrollmentPackagesService", "Delete")
+		return
+	}
+	return f.DeleteFunc(a0, a1)
+}
+
+// MonolithManifestSubManifestsService is a fake goztl.MonolithManifestSubManifestsService.
+type MonolithManifestSubManifestsService struct {
+	Recorder
+	ListFunc               func(context.Context, *goztl.ListOptions) ([]goztl.MonolithManifestSubManifest, *goztl.Response, error)
+	GetByIDFunc            func(context.Context, int) (*goztl.MonolithManifestSubManifest, *goztl.Response, error)
+	GetByManifestIDFunc    func(context.Context, int) ([]goztl.MonolithManifestSubManifest, *goztl.Response, error)
+	GetBySubManifestIDFunc func(context.Context, int) ([]goztl.MonolithManifestSubManifest, *goztl.Response, error)
+	CreateFunc             func(context.Context, *goztl.MonolithManifestSubManifestRequest) (*goztl.MonolithManifestSubManifest, *goztl.Response, error)
+	UpdateFunc             func(context.Context, int, *goztl.MonolithManifestSubManifestRequest) (*goztl.MonolithManifestSubManifest, *goztl.Response, error)
+	DeleteFunc             func(context.Context, int) (*goztl.Response, error)
+}
+
+var _ goztl.MonolithManifestSubManifestsService = &MonolithManifestSubManifestsService{}
+
+// List records the call and returns the results of ListFunc.
+func (f *MonolithManifestSubManifestsService) List(a0 context.Context, a1 *goztl.ListOptions) (r0 []goztl.MonolithManifestSubManifest, r1 *goztl.Response, r2 error) {
+	f.record("List", a1)
+	if f.ListFunc == nil {
+		r2 = errNotStubbed("MonolithManifestSubManifestsService", "List")
+		return
+	}
+	return f.ListFunc(a0, a1)
+}
+
+// GetByID records the call and returns the results of GetByIDFunc.
+func (f *MonolithManifestSubManifestsService) GetByID(a0 context.Context, a1 int) (r0 *goztl.MonolithManifestSubManifest, r1 *goztl.Response, r2 error) {
+	f.record("GetByID", a1)
+	if f.GetByIDFunc == nil {
+		r2 = errNotStubbed("MonolithManifestSubManifestsService", "GetByID")
+		return
+	}
+	return f.GetByIDFunc(a0, a1)
+}
+
+// GetByManifestID records the call and returns the results of GetByManifestIDFunc.
+func (f *MonolithManifestSubManifestsService) GetByManifestID(a0 context.Context, a1 int) (r0 []goztl.MonolithManifestSubManifest, r1 *goztl.Response, r2 error) {
+	f.record("GetByManifestID", a1)
+	if f.GetByManifestIDFunc == nil {
+		r2 = errNotStubbed("MonolithManifestSubManifestsService", "GetByManifestID")
+		return
+	}
+	return f.GetByManifestIDFunc(a0, a1)
+}
+
+// GetBySubManifestID records the call and returns the results of GetBySubManifestIDFunc.
+func (f *MonolithManifestSubManifestsService) GetBySubManifestID(a0 context.Context, a1 int) (r0 []goztl.MonolithManifestSubManifest, r1 *goztl.Response, r2 error) {
+	f.record("GetBySubManifestID", a1)
+	if f.GetBySubManifestIDFunc == nil {
+		r2 = errNotStubbed("MonolithManifestSubManifestsService", "GetBySubManifestID")
+		return
+	}
+	return f.GetBySubManifestIDFunc(a0, a1)
+}
+
+// Create records the call and returns the results of CreateFunc.
+func (f *MonolithManifestSubManifestsService) Create(a0 context.Context, a1 *goztl.MonolithManifestSubManifestRequest) (r0 *goztl.MonolithManifestSubManifest, r1 *goztl.Response, r2 error) {
+	f.record("Create", a1)
+	if f.CreateFunc == nil {
+		r2 = errNotStubbed("MonolithManifestSubManifestsService", "Create")
+		return
+	}
+	return f.CreateFunc(a0, a1)
+}
+
+// Update records the call and returns the results of UpdateFunc.
+func (f *MonolithManifestSubManifestsService) Update(a0 context.Context, a1 int, a2 *goztl.MonolithManifestSubManifestRequest) (r0 *goztl.MonolithManifestSubManifest, r1 *goztl.Response, r2 error) {
+	f.record("Update", a1, a2)
+	if f.UpdateFunc == nil {
+		r2 = errNotStubbed("MonolithManifestSubManifestsService", "Update")
+		return
+	}
+	return f.UpdateFunc(a0, a1, a2)
+}
+
+// Delete records the call and returns the results of DeleteFunc.
+func (f *MonolithManifestSubManifestsService) Delete(a0 context.Context, a1 int) (r0 *goztl.Response, r1 error) {
+	f.record("Delete", a1)
+	if f.DeleteFunc == nil {
+		r1 = errNotStubbed("MonolithManifestSubManifestsService", "Delete")
+		return
+	}
+	return f.DeleteFunc(a0, a1)
+}
+
+// MonolithManifestsService is a fake goztl.MonolithManifestsService.
+type MonolithManifestsService struct {
+	Recorder
+	ListFunc      func(context.Context, *goztl.ListOptions) ([]goztl.MonolithManifest, *goztl.Response, error)
+	GetByIDFunc   func(context.Context, int) (*goztl.MonolithManifest, *goztl.Response, error)
+	GetByNameFunc func(context.Context, string) (*goztl.MonolithManifest, *goztl.Response, error)
+	CreateFunc    func(context.Context, *goztl.MonolithManifestRequest) (*goztl.MonolithManifest, *goztl.Response, error)
+	UpdateFunc    func(context.Context, int, *goztl.MonolithManifestRequest) (*goztl.MonolithManifest, *goztl.Response, error)
+	DeleteFunc    func(context.Context, int) (*goztl.Response, error)
+}
+
+var _ goztl.MonolithManifestsService = &MonolithManifestsService{}
+
+// List records the call and returns the results of ListFunc.
+func (f *MonolithManifestsService) List(a0 context.Context, a1 *goztl.ListOptions) (r0 []goztl.MonolithManifest, r1 *goztl.Response, r2 error) {
+	f.record("List", a1)
+	if f.ListFunc == nil {
+		r2 = errNotStubbed("MonolithManifestsService", "List")
+		return
+	}
+	return f.ListFunc(a0, a1)
+}
+
+// GetByID records the call and returns the results of GetByIDFunc.
+func (f *MonolithManifestsService) GetByID(a0 context.Context, a1 int) (r0 *goztl.MonolithManifest, r1 *goztl.Response, r2 error) {
+	f.record("GetByID", a1)
+	if f.GetByIDFunc == nil {
+		r2 = errNotStubbed("MonolithManifestsService", "GetByID")
+		return
+	}
+	return f.GetByIDFunc(a0, a1)
+}
+
+// GetByName records the call and returns the results of GetByNameFunc.
+func (f *MonolithManifestsService) GetByName(a0 context.Context, a1 string) (r0 *goztl.MonolithManifest, r1 *goztl.Response, r2 error) {
+	f.record("GetByName", a1)
+	if f.GetByNameFunc == nil {
+		r2 = errNotStubbed("MonolithManifestsService", "GetByName")
+		return
+	}
+	return f.GetByNameFunc(a0, a1)
+}
+
+// Create records the call and returns the results of CreateFunc.
+func (f *MonolithManifestsService) Create(a0 context.Context, a1 *goztl.MonolithManifestRequest) (r0 *goztl.MonolithManifest, r1 *goztl.Response, r2 error) {
+	f.record("Create", a1)
+	if f.CreateFunc == nil {
+		r2 = errNotStubbed("MonolithManifestsService", "Create")
+		return
+	}
+	return f.CreateFunc(a0, a1)
+}
+
+// Update records the call and returns the results of UpdateFunc.
+func (f *MonolithManifestsService) Update(a0 context.Context, a1 int, a2 *goztl.MonolithManifestRequest) (r0 *goztl.MonolithManifest, r1 *goztl.Response, r2 error) {
+	f.record("Update", a1, a2)
+	if f.UpdateFunc == nil {
+		r2 = errNotStubbed("MonolithManifestsService", "Update")
+		return
+	}
+	return f.UpdateFunc(a0, a1, a2)
+}
+
+// Delete records the call and returns the results of DeleteFunc.
+func (f *MonolithManifestsService) Delete(a0 context.Context, a1 int) (r0 *goztl.Response, r1 error) {
+	f.record("Delete", a1)
+	if f.DeleteFunc == nil {
+		r1 = errNotStubbed("MonolithManifestsService", "Delete")
+		return
+	}
+	return f.DeleteFunc(a0, a1)
+}
+
+// MonolithRepositoriesService is a fake goztl.MonolithRepositoriesService.
+type MonolithRepositoriesService struct {
+	Recorder
+	ListFunc      func(context.Context, *goztl.ListOptions) ([]goztl.MonolithRepository, *goztl.Response, error)
+	GetByIDFunc   func(context.Context, int) (*goztl.MonolithRepository, *goztl.Response, error)
+	GetByNameFunc func(context.Context, string) (*goztl.MonolithRepository, *goztl.Response, error)
+	CreateFunc    func(context.Context, *goztl.MonolithRepositoryRequest) (*goztl.MonolithRepository, *goztl.Response, error)
+	UpdateFunc    func(context.Context, int, *goztl.MonolithRepositoryRequest) (*goztl.MonolithRepository, *goztl.Response, error)
+	DeleteFunc    func(context.Context, int) (*goztl.Response, error)
+}
+
+var _ goztl.MonolithRepositoriesService = &MonolithRepositoriesService{}
+
+// List records the call and returns the results of ListFunc.
+func (f *MonolithRepositoriesService) List(a0 context.Context, a1 *goztl.ListOptions) (r0 []goztl.MonolithRepository, r1 *goztl.Response, r2 error) {
+	f.record("List", a1)
+	if f.ListFunc == nil {
+		r2 = errNotStubbed("MonolithRepositoriesService", "List")
+		return
+	}
+	return f.ListFunc(a0, a1)
+}
+
+// GetByID records the call and returns the results of GetByIDFunc.
+func (f *MonolithRepositoriesService) GetByID(a0 context.Context, a1 int) (r0 *goztl.MonolithRepository, r1 *goztl.Response, r2 error) {
+	f.record("GetByID", a1)
+	if f.GetByIDFunc == nil {
+		r2 = errNotStubbed("MonolithRepositoriesService", "GetByID")
+		return
+	}
+	return f.GetByIDFunc(a0, a1)
+}
+
+// GetByName records the call and returns the results of GetByNameFunc.
+func (f *MonolithRepositoriesService) GetByName(a0 context.Context, a1 string) (r0 *goztl.MonolithRepository, r1 *goztl.Response, r2 error) {
+	f.record("GetByName", a1)
+	if f.GetByNameFunc == nil {
+		r2 = errNotStubbed("MonolithRepositoriesService", "GetByName")
+		return
+	}
+	return f.GetByNameFunc(a0, a1)
+}
+
+// Create records the call and returns the results of CreateFunc.
+func (f *MonolithRepositoriesService) Create(a0 context.Context, a1 *goztl.MonolithRepositoryRequest) (r0 *goztl.MonolithRepository, r1 *goztl.Response, r2 error) {
+	f.record("Create", a1)
+	if f.CreateFunc == nil {
+		r2 = errNotStubbed("MonolithRepositoriesService", "Create")
+		return
+	}
+	return f.CreateFunc(a0, a1)
+}
+
+// Update records the call and returns the results of UpdateFunc.
+func (f *MonolithRepositoriesService) Update(a0 context.Context, a1 int, a2 *goztl.MonolithRepositoryRequest) (r0 *goztl.MonolithRepository, r1 *goztl.Response, r2 error) {
+	f.record("Update", a1, a2)
+	if f.UpdateFunc == nil {
+		r2 = errNotStubbed("MonolithRepositoriesService", "Update")
+		return
+	}
+	return f.UpdateFunc(a0, a1, a2)
+}
+
+// Delete records the call and returns the results of DeleteFunc.
+func (f *MonolithRepositoriesService) Delete(a0 context.Context, a1 int) (r0 *goztl.Response, r1 error) {
+	f.record("Delete", a1)
+	if f.DeleteFunc == nil {
+		r1 = errNotStubbed("MonolithRepositoriesService", "Delete")
+		return
+	}
+	return f.DeleteFunc(a0, a1)
+}
+
+// MonolithSubManifestPkgInfosService is a fake goztl.MonolithSubManifestPkgInfosService.
+type MonolithSubManifestPkgInfosService struct {
+	Recorder
+	ListFunc               func(context.Context, *goztl.ListOptions) ([]goztl.MonolithSubManifestPkgInfo, *goztl.Response, error)
+	GetByIDFunc            func(context.Context, int) (*goztl.MonolithSubManifestPkgInfo, *goztl.Response, error)
+	GetBySubManifestIDFunc func(context.Context, int) ([]goztl.MonolithSubManifestPkgInfo, *goztl.Response, error)
+	CreateFunc             func(context.Context, *goztl.MonolithSubManifestPkgInfoRequest) (*goztl.MonolithSubManifestPkgInfo, *goztl.Response, error)
+	UpdateFunc             func(context.Context, int, *goztl.MonolithSubManifestPkgInfoRequest) (*goztl.MonolithSubManifestPkgInfo, *goztl.Response, error)
+	DeleteFunc             func(context.Context, int) (*goztl.Response, error)
+}
+
+var _ goztl.MonolithSubManifestPkgInfosService = &MonolithSubManifestPkgInfosService{}
+
+// List records the call and returns the results of ListFunc.
+func (f *MonolithSubManifestPkgInfosService) List(a0 context.Context, a1 *goztl.ListOptions) (r0 []goztl.MonolithSubManifestPkgInfo, r1 *goztl.Response, r2 error) {
+	f.record("List", a1)
+	if f.ListFunc == nil {
+		r2 = errNotStubbed("MonolithSubManifestPkgInfosService", "List")
+		return
+	}
+	return f.ListFunc(a0, a1)
+}
+
+// GetByID records the call and returns the results of GetByIDFunc.
+func (f *MonolithSubManifestPkgInfosService) GetByID(a0 context.Context, a1 int) (r0 *goztl.MonolithSubManifestPkgInfo, r1 *goztl.Response, r2 error) {
+	f.record("GetByID", a1)
+	if f.GetByIDFunc == nil {
+		r2 = errNotStubbed("MonolithSubManifestPkgInfosService", "GetByID")
+		return
+	}
+	return f.GetByIDFunc(a0, a1)
+}
+
+// GetBySubManifestID records the call and returns the results of GetBySubManifestIDFunc.
+func (f *MonolithSubManifestPkgInfosService) GetBySubManifestID(a0 context.Context, a1 int) (r0 []goztl.MonolithSubManifestPkgInfo, r1 *goztl.Response, r2 error) {
+	f.record("GetBySubManifestID", a1)
+	if f.GetBySubManifestIDFunc == nil {
+		r2 = errNotStubbed("MonolithSubManifestPkgInfosService", "GetBySubManifestID")
+		return
+	}
+	return f.GetBySubManifestIDFunc(a0, a1)
+}
+
+// Create records the call and returns the results of CreateFunc.
+func (f *MonolithSubManifestPkgInfosService) Create(a0 context.Context, a1 *goztl.MonolithSubManifestPkgInfoRequest) (r0 *goztl.MonolithSubManifestPkgInfo, r1 *goztl.Response, r2 error) {
+	f.record("Create", a1)
+	if f.CreateFunc == nil {
+		r2 = errNotStubbed("MonolithSubManifestPkgInfosService", "Create")
+		return
+	}
+	return f.CreateFunc(a0, a1)
+}
+
+// Update records the call and returns the results of UpdateFunc.
+func (f *MonolithSubManifestPkgInfosService) Update(a0 context.Context, a1 int, a2 *goztl.MonolithSubManifestPkgInfoRequest) (r0 *goztl.MonolithSubManifestPkgInfo, r1 *goztl.Response, r2 error) {
+	f.record("Update", a1, a2)
+	if f.UpdateFunc == nil {
+		r2 = errNotStubbed("MonolithSubManifestPkgInfosService", "Update")
+		return
+	}
+	return f.UpdateFunc(a0, a1, a2)
+}
+
+// Delete records the call and returns the results of DeleteFunc.
+func (f *MonolithSubManifestPkgInfosService) Delete(a0 context.Context, a1 int) (r0 *goztl.Response, r1 error) {
+	f.record("Delete", a1)
+	if f.DeleteFunc == nil {
+		r1 = errNotStubbed("MonolithSubManifestPkgInfosService", "Delete")
+		return
+	}
+	return f.DeleteFunc(a0, a1)
+}
+
+// MonolithSubManifestsService is a fake goztl.MonolithSubManifestsService.
+type MonolithSubManifestsService struct {
+	Recorder
+	ListFunc      func(context.Context, *goztl.ListOptions) ([]goztl.MonolithSubManifest, *goztl.Response, error)
+	GetByIDFunc   func(context.Context, int) (*goztl.MonolithSubManifest, *goztl.Response, error)
+	GetByNameFunc func(context.Context, string) (*goztl.MonolithSubManifest, *goztl.Response, error)
+	CreateFunc    func(context.Context, *goztl.MonolithSubManifestRequest) (*goztl.MonolithSubManifest, *goztl.Response, error)
+	UpdateFunc    func(context.Context, int, *goztl.MonolithSubManifestRequest) (*goztl.MonolithSubManifest, *goztl.Response, error)
+	DeleteFunc    func(context.Context, int) (*goztl.Response, error)
+}
+
+var _ goztl.MonolithSubManifestsService = &MonolithSubManifestsService{}
+
+// List records the call and returns the results of ListFunc.
+func (f *MonolithSubManifestsService) List(a0 context.Context, a1 *goztl.ListOptions) (r0 []goztl.MonolithSubManifest, r1 *goztl.Response, r2 error) {
+	f.record("List", a1)
+	if f.ListFunc == nil {
+		r2 = errNotStubbed("MonolithSubManifestsService", "List")
+		return
+	}
+	return f.ListFunc(a0, a1)
+}
+
+// GetByID records the call and returns the results of GetByIDFunc.
+func (f *MonolithSubManifestsService) GetByID(a0 context.Context, a1 int) (r0 *goztl.MonolithSubManifest, r1 *goztl.Response, r2 error) {
+	f.record("GetByID", a1)
+	if f.GetByIDFunc == nil {
+		r2 = errNotStubbed("MonolithSubManifestsService", "GetByID")
+		return
+	}
+	return f.GetByIDFunc(a0, a1)
+}
+
+// GetByName records the call and returns the results of GetByNameFunc.
+func (f *MonolithSubManifestsService) GetByName(a0 context.Context, a1 string) (r0 *goztl.MonolithSubManifest, r1 *goztl.Response, r2 error) {
+	f.record("GetByName", a1)
+	if f.GetByNameFunc == nil {
+		r2 = errNotStubbed("MonolithSubManifestsService", "GetByName")
+		return
+	}
+	return f.GetByNameFunc(a0, a1)
+}
+
+// Create records the call and returns the results of CreateFunc.
+func (f *MonolithSubManifestsService) Create(a0 context.Context, a1 *goztl.MonolithSubManifestRequest) (r0 *goztl.MonolithSubManifest, r1 *goztl.Response, r2 error) {
+	f.record("Create", a1)
+	if f.CreateFunc == nil {
+		r2 = errNotStubbed("MonolithSubManifestsService", "Create")
+		return
+	}
+	return f.CreateFunc(a0, a1)
+}
+
+// Update records the call and returns the results of UpdateFunc.
+func (f *MonolithSubManifestsService) Update(a0 context.Context, a1 int, a2 *goztl.MonolithSubManifestRequest) (r0 *goztl.MonolithSubManifest, r1 *goztl.Response, r2 error) {
+	f.record("Update", a1, a2)
+	if f.UpdateFunc == nil {
+		r2 = errNotStubbed("MonolithSubManifestsService", "Update")
+		return
+	}
+	return f.UpdateFunc(a0, a1, a2)
+}
+
+// Delete records the call and returns the results of DeleteFunc.
+func (f *MonolithSubManifestsService) Delete(a0 context.Context, a1 int) (r0 *goztl.Response, r1 error) {
+	f.record("Delete", a1)
+	if f.DeleteFunc == nil {
+		r1 = errNotStubbed("MonolithSubManifestsService", "Delete")
+		return
+	}
+	return f.DeleteFunc(a0, a1)
+}
+
+// MunkiConfigurationsService is a fake goztl.MunkiConfigurationsService.
+type MunkiConfigurationsService struct {
+	Recorder
+	ListFunc      func(context.Context, *goztl.ListOptions) ([]goztl.MunkiConfiguration, *goztl.Response, error)
+	GetByIDFunc   func(context.Context, int) (*goztl.MunkiConfiguration, *goztl.Response, error)
+	GetByNameFunc func(context.Context, string) (*goztl.MunkiConfiguration, *goztl.Response, error)
+	CreateFunc    func(context.Context, *goztl.MunkiConfigurationRequest) (*goztl.MunkiConfiguration, *goztl.Response, error)
+	UpdateFunc    func(context.Context, int, *goztl.MunkiConfigurationRequest) (*goztl.MunkiConfiguration, *goztl.Response, error)
+	DeleteFunc    func(context.Context, int) (*goztl.Response, error)
+}
+
+var _ goztl.MunkiConfigurationsService = &MunkiConfigurationsService{}
+
+// List records the call and returns the results of ListFunc.
+func (f *MunkiConfigurationsService) List(a0 context.Context, a1 *goztl.ListOptions) (r0 []goztl.MunkiConfiguration, r1 *goztl.Response, r2 error) {
+	f.record("List", a1)
+	if f.ListFunc == nil {
+		r2 = errNotStubbed("MunkiConfigurationsService", "List")
+		return
+	}
+	return f.ListFunc(a0, a1)
+}
+
+// GetByID records the call and returns the results of GetByIDFunc.
+func (f *MunkiConfigurationsService) GetByID(a0 context.Context, a1 int) (r0 *goztl.MunkiConfiguration, r1 *goztl.Response, r2 error) {
+	f.record("GetByID", a1)
+	if f.GetByIDFunc == nil {
+		r2 = errNotStubbed("MunkiConfigurationsService", "GetByID")
+		return
+	}
+	return f.GetByIDFunc(a0, a1)
+}
+
+// GetByName records the call and returns the results of GetByNameFunc.
+func (f *MunkiConfigurationsService) GetByName(a0 context.Context, a1 string) (r0 *goztl.MunkiConfiguration, r1 *goztl.Response, r2 error) {
+	f.record("GetByName", a1)
+	if f.GetByNameFunc == nil {
+		r2 = errNotStubbed("MunkiConfigurationsService", "GetByName")
+		return
+	}
+	return f.GetByNameFunc(a0, a1)
+}
+
+// Create records the call and returns the results of CreateFunc.
+func (f *MunkiConfigurationsService) Create(a0 context.Context, a1 *goztl.MunkiConfigurationRequest) (r0 *goztl.MunkiConfiguration, r1 *goztl.Response, r2 error) {
+	f.record("Create", a1)
+	if f.CreateFunc == nil {
+		r2 = errNotStubbed("MunkiConfigurationsService", "Create")
+		return
+	}
+	return f.CreateFunc(a0, a1)
+}
+
+// Update records the call and returns the results of UpdateFunc.
+func (f *MunkiConfigurationsService) Update(a0 context.Context, a1 int, a2 *goztl.MunkiConfigurationRequest) (r0 *goztl.MunkiConfiguration, r1 *goztl.Response, r2 error) {
+	f.record("Update", a1, a2)
+	if f.UpdateFunc == nil {
+		r2 = errNotStubbed("MunkiConfigurationsService", "Update")
+		return
+	}
+	return f.UpdateFunc(a0, a1, a2)
+}
+
+// Delete records the call and returns the results of DeleteFunc.
+func (f *MunkiConfigurationsService) Delete(a0 context.Context, a1 int) (r0 *goztl.Response, r1 error) {
+	f.record("Delete", a1)
+	if f.DeleteFunc == nil {
+		r1 = errNotStubbed("MunkiConfigurationsService", "Delete")
+		return
+	}
+	return f.DeleteFunc(a0, a1)
+}
+
+// MunkiEnrollmentsService is a fake goztl.MunkiEnrollmentsService.
+type MunkiEnrollmentsService struct {
+	Recorder
+	ListFunc                 func(context.Context, *goztl.ListOptions) ([]goztl.MunkiEnrollment, *goztl.Response, error)
+	GetByIDFunc              func(context.Context, int) (*goztl.MunkiEnrollment, *goztl.Response, error)
+	GetByConfigurationIDFunc func(context.Context, int) ([]goztl.MunkiEnrollment, *goztl.Response, error)
+	CreateFunc               func(context.Context, *goztl.MunkiEnrollmentRequest) (*goztl.MunkiEnrollment, *goztl.Response, error)
+	UpdateFunc               func(context.Context, int, *goztl.MunkiEnrollmentRequest) (*goztl.MunkiEnrollment, *goztl.Response, error)
+	DeleteFunc               func(context.Context, int) (*goztl.Response, error)
+}
+
+var _ goztl.MunkiEnrollmentsService = &MunkiEnrollmentsService{}
+
+// List records the call and returns the results of ListFunc.
+func (f *MunkiEnrollmentsService) List(a0 context.Context, a1 *goztl.ListOptions) (r0 []goztl.MunkiEnrollment, r1 *goztl.Response, r2 error) {
+	f.record("List", a1)
+	if f.ListFunc == nil {
+		r2 = errNotStubbed("MunkiEnrollmentsService", "List")
+		return
+	}
+	return f.ListFunc(a0, a1)
+}
+
+// GetByID records the call and returns the results of GetByIDFunc.
+func (f *MunkiEnrollmentsService) GetByID(a0 context.Context, a1 int) (r0 *goztl.MunkiEnrollment, r1 *goztl.Response, r2 error) {
+	f.record("GetByID", a1)
+	if f.GetByIDFunc == nil {
+		r2 = errNotStubbed("MunkiEnrollmentsService", "GetByID")
+		return
+	}
+	return f.GetByIDFunc(a0, a1)
+}
+
+// GetByConfigurationID records the call and returns the results of GetByConfigurationIDFunc.
+func (f *MunkiEnrollmentsService) GetByConfigurationID(a0 context.Context, a1 int) (r0 []goztl.MunkiEnrollment, r1 *goztl.Response, r2 error) {
+	f.record("GetByConfigurationID", a1)
+	if f.GetByConfigurationIDFunc == nil {
+		r2 = errNotStubbed("MunkiEnrollmentsService", "GetByConfigurationID")
+		return
+	}
+	return f.GetByConfigurationIDFunc(a0, a1)
+}
+
+// Create records the call and returns the results of CreateFunc.
+func (f *MunkiEnrollmentsService) Create(a0 context.Context, a1 *goztl.MunkiEnrollmentRequest) (r0 *goztl.MunkiEnrollment, r1 *goztl.Response, r2 error) {
+	f.record("Create", a1)
+	if f.CreateFunc == nil {
+		r2 = errNotStubbed("MunkiEnrollmentsService", "Create")
+		return
+	}
+	return f.CreateFunc(a0, a1)
+}
+
+// Update records the call and returns the results of UpdateFunc.
+func (f *MunkiEnrollmentsService) Update(a0 context.Context, a1 int, a2 *goztl.MunkiEnrollmentRequest) (r0 *goztl.MunkiEnrollment, r1 *goztl.Response, r2 error) {
+	f.record("Update", a1, a2)
+	if f.UpdateFunc == nil {
+		r2 = errNotStubbed("MunkiEnrollmentsService", "Update")
+		return
+	}
+	return f.UpdateFunc(a0, a1, a2)
+}
+
+// Delete records the call and returns the results of DeleteFunc.
+func (f *MunkiEnrollmentsService) Delete(a0 context.Context, a1 int) (r0 *goztl.Response, r1 error) {
+	f.record("Delete", a1)
+	if f.DeleteFunc == nil {
+		r1 = errNotStubbed("MunkiEnrollmentsService", "Delete")
+		return
+	}
+	return f.DeleteFunc(a0, a1)
+}
+
+// MunkiScriptChecksService is a fake goztl.MunkiScriptChecksService.
+type MunkiScriptChecksService struct {
+	Recorder
+	ListFunc      func(context.Context, *goztl.ListOptions) ([]goztl.MunkiScriptCheck, *goztl.Response, error)
+	GetByIDFunc   func(context.Context, int) (*goztl.MunkiScriptCheck, *goztl.Response, error)
+	GetByNameFunc func(context.Context, string) (*goztl.MunkiScriptCheck, *goztl.Response, error)
+	CreateFunc    func(context.Context, *goztl.MunkiScriptCheckRequest) (*goztl.MunkiScriptCheck, *goztl.Response, error)
+	UpdateFunc    func(context.Context, int, *goztl.MunkiScriptCheckRequest) (*goztl.MunkiScriptCheck, *goztl.Response, error)
+	DeleteFunc    func(context.Context, int) (*goztl.Response, error)
+}
+
+var _ goztl.MunkiScriptChecksService = &MunkiScriptChecksService{}
+
+// List records the call and returns the results of ListFunc.
+func (f *MunkiScriptChecksService) List(a0 context.Context, a1 *goztl.ListOptions) (r0 []goztl.MunkiScriptCheck, r1 *goztl.Response, r2 error) {
+	f.record("List", a1)
+	if f.ListFunc == nil {
+		r2 = errNotStubbed("MunkiScriptChecksService", "List")
+		return
+	}
+	return f.ListFunc(a0, a1)
+}
+
+// GetByID records the call and returns the results of GetByIDFunc.
+func (f *MunkiScriptChecksService) GetByID(a0 context.Context, a1 int) (r0 *goztl.MunkiScriptCheck, r1 *goztl.Response, r2 error) {
+	f.record("GetByID", a1)
+	if f.GetByIDFunc == nil {
+		r2 = errNotStubbed("MunkiScriptChecksService", "GetByID")
+		return
+	}
+	return f.GetByIDFunc(a0, a1)
+}
+
+// GetByName records the call and returns the results of GetByNameFunc.
+func (f *MunkiScriptChecksService) GetByName(a0 context.Context, a1 string) (r0 *goztl.MunkiScriptCheck, r1 *goztl.Response, r2 error) {
+	f.record("GetByName", a1)
+	if f.GetByNameFunc == nil {
+		r2 = errNotStubbed("MunkiScriptChecksService", "GetByName")
+		return
+	}
+	return f.GetByNameFunc(a0, a1)
+}
+
+// Create records the call and returns the results of CreateFunc.
+func (f *MunkiScriptChecksService) Create(a0 context.Context, a1 *goztl.MunkiScriptCheckRequest) (r0 *goztl.MunkiScriptCheck, r1 *goztl.Response, r2 error) {
+	f.record("Create", a1)
+	if f.CreateFunc == nil {
+		r2 = errNotStubbed("MunkiScriptChecksService", "Create")
+		return
+	}
+	return f.CreateFunc(a0, a1)
+}
+
+// Update records the call and returns the results of UpdateFunc.
+func (f *MunkiScriptChecksService) Update(a0 context.Context, a1 int, a2 *goztl.MunkiScriptCheckRequest) (r0 *goztl.MunkiScriptCheck, r1 *goztl.Response, r2 error) {
+	f.record("Update", a1, a2)
+	if f.UpdateFunc == nil {
+		r2 = errNotStubbed("MunkiScriptChecksService", "Update")
+		return
+	}
+	return f.UpdateFunc(a0, a1, a2)
+}
+
+// Delete records the call and returns the results of DeleteFunc.
+func (f *MunkiScriptChecksService) Delete(a0 context.Context, a1 int) (r0 *goztl.Response, r1 error) {
+	f.record("Delete", a1)
+	if f.DeleteFunc == nil {
+		r1 = errNotStubbed("MunkiScriptChecksService", "Delete")
+		return
+	}
+	return f.DeleteFunc(a0, a1)
+}
+
+// OsqueryATCService is a fake goztl.OsqueryATCService.
+type OsqueryATCService struct {
+	Recorder
+	ListFunc      func(context.Context, *goztl.ListOptions) ([]goztl.OsqueryATC, *goztl.Response, error)
+	GetByIDFunc   func(context.Context, int) (*goztl.OsqueryATC, *goztl.Response, error)
+	GetByNameFunc func(context.Context, string) (*goztl.OsqueryATC, *goztl.Response, error)
+	CreateFunc    func(context.Context, *goztl.OsqueryATCRequest) (*goztl.OsqueryATC, *goztl.Response, error)
+	UpdateFunc    func(context.Context, int, *goztl.OsqueryATCRequest) (*goztl.OsqueryATC, *goztl.Response, error)
+	DeleteFunc    func(context.Context, int) (*goztl.Response, error)
+}
+
+var _ goztl.OsqueryATCService = &OsqueryATCService{}
+
+// List records the call and returns the results of ListFunc.
+func (f *OsqueryATCService) List(a0 context.Context, a1 *goztl.ListOptions) (r0 []goztl.OsqueryATC, r1 *goztl.Response, r2 error) {
+	f.record("List", a1)
+	if f.ListFunc == nil {
+		r2 = errNotStubbed("OsqueryATCService", "List")
+		return
+	}
+	return f.ListFunc(a0, a1)
+}
+
+// GetByID records the call and returns the results of GetByIDFunc.
+func (f *OsqueryATCService) GetByID(a0 context.Context, a1 int) (r0 *goztl.OsqueryATC, r1 *goztl.Response, r2 error) {
+	f.record("GetByID", a1)
+	if f.GetByIDFunc == nil {
+		r2 = errNotStubbed("OsqueryATCService", "GetByID")
+		return
+	}
+	return f.GetByIDFunc(a0, a1)
+}
+
+// GetByName records the call and returns the results of GetByNameFunc.
+func (f *OsqueryATCService) GetByName(a0 context.Context, a1 string) (r0 *goztl.OsqueryATC, r1 *goztl.Response, r2 error) {
+	f.record("GetByName", a1)
+	if f.GetByNameFunc == nil {
+		r2 = errNotStubbed("OsqueryATCService", "GetByName")
+		return
+	}
+	return f.GetByNameFunc(a0, a1)
+}
+
+// Create records the call and returns the results of CreateFunc.
+func (f *OsqueryATCService) Create(a0 context.Context, a1 *goztl.OsqueryATCRequest) (r0 *goztl.OsqueryATC, r1 *goztl.Response, r2 error) {
+	f.record("Create", a1)
+	if f.CreateFunc == nil {
+		r2 = errNotStubbed("OsqueryATCService", "Create")
+		return
+	}
+	return f.CreateFunc(a0, a1)
+}
+
+// Update records the call and returns the results of UpdateFunc.
+func (f *OsqueryATCService) Update(a0 context.Context, a1 int, a2 *goztl.OsqueryATCRequest) (r0 *goztl.OsqueryATC, r1 *goztl.Response, r2 error) {
+	f.record("Update", a1, a2)
+	if f.UpdateFunc == nil {
+		r2 = errNotStubbed("OsqueryATCService", "Update")
+		return
+	}
+	return f.UpdateFunc(a0, a1, a2)
+}
+
+// Delete records the call and returns the results of DeleteFunc.
+func (f *OsqueryATCService) Delete(a0 context.Context, a1 int) (r0 *goztl.Response, r1 error) {
+	f.record("Delete", a1)
+	if f.DeleteFunc == nil {
+		r1 = errNotStubbed("OsqueryATCService", "Delete")
+		return
+	}
+	return f.DeleteFunc(a0, a1)
+}
+
+// OsqueryConfigurationPacksService is a fake goztl.OsqueryConfigurationPacksService.
+type OsqueryConfigurationPacksService struct {
+	Recorder
+	ListFunc                 func(context.Context, *goztl.ListOptions) ([]goztl.OsqueryConfigurationPack, *goztl.Response, error)
+	GetByIDFunc              func(context.Context, int) (*goztl.OsqueryConfigurationPack, *goztl.Response, error)
+	GetByConfigurationIDFunc func(context.Context, int) ([]goztl.OsqueryConfigurationPack, *goztl.Response, error)
+	GetByPackIDFunc          func(context.Context, int) ([]goztl.OsqueryConfigurationPack, *goztl.Response, error)
+	CreateFunc               func(context.Context, *goztl.OsqueryConfigurationPackRequest) (*goztl.OsqueryConfigurationPack, *goztl.Response, error)
+	UpdateFunc               func(context.Context, int, *goztl.OsqueryConfigurationPackRequest) (*goztl.OsqueryConfigurationPack, *goztl.Response, error)
+	DeleteFunc               func(context.Context, int) (*goztl.Response, error)
+}
+
+var _ goztl.OsqueryConfigurationPacksService = &OsqueryConfigurationPacksService{}
+
+// List records the call and returns the results of ListFunc.
+func (f *OsqueryConfigurationPacksService) List(a0 context.Context, a1 *goztl.ListOptions) (r0 []goztl.OsqueryConfigurationPack, r1 *goztl.Response, r2 error) {
+	f.record("List", a1)
+	if f.ListFunc == nil {
+		r2 = errNotStubbed("OsqueryConfigurationPacksService", "List")
+		return
+	}
+	return f.ListFunc(a0, a1)
+}
+
+// GetByID records the call and returns the results of GetByIDFunc.
+func (f *OsqueryConfigurationPacksService) GetByID(a0 context.Context, a1 int) (r0 *goztl.OsqueryConfigurationPack, r1 *goztl.Response, r2 error) {
+	f.record("GetByID", a1)
+	if f.GetByIDFunc == nil {
+		r2 = errNotStubbed("OsqueryConfigurationPacksService", "GetByID")
+		return
+	}
+	return f.GetByIDFunc(a0, a1)
+}
+
+// GetByConfigurationID records the call and returns the results of GetByConfigurationIDFunc.
+func (f *OsqueryConfigurationPacksService) GetByConfigurationID(a0 context.Context, a1 int) (r0 []goztl.OsqueryConfigurationPack, r1 *goztl.Response, r2 error) {
+	f.record("GetByConfigurationID", a1)
+	if f.GetByConfigurationIDFunc == nil {
+		r2 = errNotStubbed("OsqueryConfigurationPacksService", "GetByConfigurationID")
+		return
+	}
+	return f.GetByConfigurationIDFunc(a0, a1)
+}
+
+// GetByPackID records the call and returns the results of GetByPackIDFunc.
+func (f *OsqueryConfigurationPacksService) GetByPackID(a0 context.Context, a1 int) (r0 []goztl.OsqueryConfigurationPack, r1 *goztl.Response, r2 error) {
+	f.record("GetByPackID", a1)
+	if f.GetByPackIDFunc == nil {
+		r2 = errNotStubbed("OsqueryConfigurationPacksService", "GetByPackID")
+		return
+	}
+	return f.GetByPackIDFunc(a0, a1)
+}
+
+// Create records the call and returns the results of CreateFunc.
+func (f *OsqueryConfigurationPacksService) Create(a0 context.Context, a1 *goztl.OsqueryConfigurationPackRequest) (r0 *goztl.OsqueryConfigurationPack, r1 *goztl.Response, r2 error) {
+	f.record("Create", a1)
+	if f.CreateFunc == nil {
+		r2 = errNotStubbed("OsqueryConfigurationPacksService", "Create")
+		return
+	}
+	return f.CreateFunc(a0, a1)
+}
+
+// Update records the call and returns the results of UpdateFunc.
+func (f *OsqueryConfigurationPacksService) Update(a0 context.Context, a1 int, a2 *goztl.OsqueryConfigurationPackRequest) (r0 *goztl.OsqueryConfigurationPack, r1 *goztl.Response, r2 error) {
+	f.record("Update", a1, a2)
+	if f.UpdateFunc == nil {
+		r2 = errNotStubbed("OsqueryConfigurationPacksService", "Update")
+		return
+	}
+	return f.UpdateFunc(a0, a1, a2)
+}
+
+// Delete records the call and returns the results of DeleteFunc.
+func (f *OsqueryConfigurationPacksService) Delete(a0 context.Context, a1 int) (r0 *goztl.Response, r1 error) {
+	f.record("Delete", a1)
+	if f.DeleteFunc == nil {
+		r1 = errNotStubbed("OsqueryConfigurationPacksService", "Delete")
+		return
+	}
+	return f.DeleteFunc(a0, a1)
+}
+
+// OsqueryConfigurationsService is a fake goztl.OsqueryConfigurationsService.
+type OsqueryConfigurationsService struct {
+	Recorder
+	ListFunc      func(context.Context, *goztl.ListOptions) ([]goztl.OsqueryConfiguration, *goztl.Response, error)
+	GetByIDFunc   func(context.Context, int) (*goztl.OsqueryConfiguration, *goztl.Response, error)
+	GetByNameFunc func(context.Context, string) (*goztl.OsqueryConfiguration, *goztl.Response, error)
+	CreateFunc    func(context.Context, *goztl.OsqueryConfigurationRequest) (*goztl.OsqueryConfiguration, *goztl.Response, error)
+	UpdateFunc    func(context.Context, int, *goztl.OsqueryConfigurationRequest) (*goztl.OsqueryConfiguration, *goztl.Response, error)
+	DeleteFunc    func(context.Context, int) (*goztl.Response, error)
+}
+
+var _ goztl.OsqueryConfigurationsService = &OsqueryConfigurationsService{}
+
+// List records the call and returns the results of ListFunc.
+func (f *OsqueryConfigurationsService) List(a0 context.Context, a1 *goztl.ListOptions) (r0 []goztl.OsqueryConfiguration, r1 *goztl.Response, r2 error) {
+	f.record("List", a1)
+	if f.ListFunc == nil {
+		r2 = errNotStubbed("OsqueryConfigurationsService", "List")
+		return
+	}
+	return f.ListFunc(a0, a1)
+}
+
+// GetByID records the call and returns the results of GetByIDFunc.
+func (f *OsqueryConfigurationsService) GetByID(a0 context.Context, a1 int) (r0 *goztl.OsqueryConfiguration, r1 *goztl.Response, r2 error) {
+	f.record("GetByID", a1)
+	if f.GetByIDFunc == nil {
+		r2 = errNotStubbed("OsqueryConfigurationsService", "GetByID")
+		return
+	}
+	return f.GetByIDFunc(a0, a1)
+}
+
+// GetByName records the call and returns the results of GetByNameFunc.
+func (f *OsqueryConfigurationsService) GetByName(a0 context.Context, a1 string) (r0 *goztl.OsqueryConfiguration, r1 *goztl.Response, r2 error) {
+	f.record("GetByName", a1)
+	if f.GetByNameFunc == nil {
+		r2 = errNotStubbed("OsqueryConfigurationsService", "GetByName")
+		return
+	}
+	return f.GetByNameFunc(a0, a1)
+}
+
+// Create records the call and returns the results of CreateFunc.
+func (f *OsqueryConfigurationsService) Create(a0 context.Context, a1 *goztl.OsqueryConfigurationRequest) (r0 *goztl.OsqueryConfiguration, r1 *goztl.Response, r2 error) {
+	f.record("Create", a1)
+	if f.CreateFunc == nil {
+		r2 = errNotStubbed("OsqueryConfigurationsService", "Create")
+		return
+	}
+	return f.CreateFunc(a0, a1)
+}
+
+// Update records the call and returns the results of UpdateFunc.
+func (f *OsqueryConfigurationsService) Update(a0 context.Context, a1 int, a2 *goztl.OsqueryConfigurationRequest) (r0 *goztl.OsqueryConfiguration, r1 *goztl.Response, r2 error) {
+	f.record("Update", a1, a2)
+	if f.UpdateFunc == nil {
+		r2 = errNotStubbed("OsqueryConfigurationsService", "Update")
+		return
+	}
+	return f.UpdateFunc(a0, a1, a2)
+}
+
+// Delete records the call and returns the results of DeleteFunc.
+func (f *OsqueryConfigurationsService) Delete(a0 context.Context, a1 int) (r0 *goztl.Response, r1 error) {
+	f.record("Delete", a1)
+	if f.DeleteFunc == nil {
+		r1 = errNotStubbed("OsqueryConfigurationsService", "Delete")
+		return
+	}
+	return f.DeleteFunc(a0, a1)
+}
+
+// OsqueryEnrollmentsService is a fake goztl.OsqueryEnrollmentsService.
+type OsqueryEnrollmentsService struct {
+	Recorder
+	ListFunc                 func(context.Context, *goztl.ListOptions) ([]goztl.OsqueryEnrollment, *goztl.Response, error)
+	GetByIDFunc              func(context.Context, int) (*goztl.OsqueryEnrollment, *goztl.Response, error)
+	GetByConfigurationIDFunc func(context.Context, int) ([]goztl.OsqueryEnrollment, *goztl.Response, error)
+	CreateFunc               func(context.Context, *goztl.OsqueryEnrollmentRequest) (*goztl.OsqueryEnrollment, *goztl.Response, error)
+	UpdateFunc               func(context.Context, int, *goztl.OsqueryEnrollmentRequest) (*goztl.OsqueryEnrollment, *goztl.Response, error)
+	DeleteFunc               func(context.Context, int) (*goztl.Response, error)
+}
+
+var _ goztl.OsqueryEnrollmentsService = &OsqueryEnrollmentsService{}
+
+// List records the call and returns the results of ListFunc.
+func (f *OsqueryEnrollmentsService) List(a0 context.Context, a1 *goztl.ListOptions) (r0 []goztl.OsqueryEnrollment, r1 *goztl.Response, r2 error) {
+	f.record("List", a1)
+	if f.ListFunc == nil {
+		r2 = errNotStubbed("OsqueryEnrollmentsService", "List")
+		return
+	}
+	return f.ListFunc(a0, a1)
+}
+
+// GetByID records the call and returns the results of GetByIDFunc.
+func (f *OsqueryEnrollmentsService) GetByID(a0 context.Context, a1 int) (r0 *goztl.OsqueryEnrollment, r1 *goztl.Response, r2 error) {
+	f.record("GetByID", a1)
+	if f.GetByIDFunc == nil {
+		r2 = errNotStubbed("OsqueryEnrollmentsService", "GetByID")
+		return
+	}
+	return f.GetByIDFunc(a0, a1)
+}
+
+// GetByConfigurationID records the call and returns the results of GetByConfigurationIDFunc.
+func (f *OsqueryEnrollmentsService) GetByConfigurationID(a0 context.Context, a1 int) (r0 []goztl.OsqueryEnrollment, r1 *goztl.Response, r2 error) {
+	f.record("GetByConfigurationID", a1)
+	if f.GetByConfigurationIDFunc == nil {
+		r2 = errNotStubbed("OsqueryEnrollmentsService", "GetByConfigurationID")
+		return
+	}
+	return f.GetByConfigurationIDFunc(a0, a1)
+}
+
+// Create records the call and returns the results of CreateFunc.
+func (f *OsqueryEnrollmentsService) Create(a0 context.Context, a1 *goztl.OsqueryEnrollmentRequest) (r0 *goztl.OsqueryEnrollment, r1 *goztl.Response, r2 error) {
+	f.record("Create", a1)
+	if f.CreateFunc == nil {
+		r2 = errNotStubbed("OsqueryEnrollmentsService", "Create")
+		return
+	}
+	return f.CreateFunc(a0, a1)
+}
+
+// Update records the call and returns the results of UpdateFunc.
+func (f *OsqueryEnrollmentsService) Update(a0 context.Context, a1 int, a2 *goztl.OsqueryEnrollmentRequest) (r0 *goztl.OsqueryEnrollment, r1 *goztl.Response, r2 error) {
+	f.record("Update", a1, a2)
+	if f.UpdateFunc == nil {
+		r2 = errNotStubbed("OsqueryEnrollmentsService", "Update")
+		return
+	}
+	return f.UpdateFunc(a0, a1, a2)
+}
+
+// Delete records the call and returns the results of DeleteFunc.
+func (f *OsqueryEnrollmentsService) Delete(a0 context.Context, a1 int) (r0 *goztl.Response, r1 error) {
+	f.record("Delete", a1)
+	if f.DeleteFunc == nil {
+		r1 = errNotStubbed("OsqueryEnrollmentsService", "Delete")
+		return
+	}
+	return f.DeleteFunc(a0, a1)
+}
+
+// OsqueryFileCategoriesService is a fake goztl.OsqueryFileCategoriesService.
+type OsqueryFileCategoriesService struct {
+	Recorder
+	ListFunc      func(context.Context, *goztl.ListOptions) ([]goztl.OsqueryFileCategory, *goztl.Response, error)
+	GetByIDFunc   func(context.Context, int) (*goztl.OsqueryFileCategory, *goztl.Response, error)
+	GetByNameFunc func(context.Context, string) (*goztl.OsqueryFileCategory, *goztl.Response, error)
+	CreateFunc    func(context.Context, *goztl.OsqueryFileCategoryRequest) (*goztl.OsqueryFileCategory, *goztl.Response, error)
+	UpdateFunc    func(context.Context, int, *goztl.OsqueryFileCategoryRequest) (*goztl.OsqueryFileCategory, *goztl.Response, error)
+	DeleteFunc    func(context.Context, int) (*goztl.Response, error)
+}
+
+var _ goztl.OsqueryFileCategoriesService = &OsqueryFileCategoriesService{}
+
+// List records the call and returns the results of ListFunc.
+func (f *OsqueryFileCategoriesService) List(a0 context.Context, a1 *goztl.ListOptions) (r0 []goztl.OsqueryFileCategory, r1 *goztl.Response, r2 error) {
+	f.record("List", a1)
+	if f.ListFunc == nil {
+		r2 = errNotStubbed("OsqueryFileCategoriesService", "List")
+		return
+	}
+	return f.ListFunc(a0, a1)
+}
+
+// GetByID records the call and returns the results of GetByIDFunc.
+func (f *OsqueryFileCategoriesService) GetByID(a0 context.Context, a1 int) (r0 *goztl.OsqueryFileCategory, r1 *goztl.Response, r2 error) {
+	f.record("GetByID", a1)
+	if f.GetByIDFunc == nil {
+		r2 = errNotStubbed("OsqueryFileCategoriesService", "GetByID")
+		return
+	}
+	return f.GetByIDFunc(a0, a1)
+}
+
+// GetByName records the call and returns the results of GetByNameFunc.
+func (f *OsqueryFileCategoriesService) GetByName(a0 context.Context, a1 string) (r0 *goztl.OsqueryFileCategory, r1 *goztl.Response, r2 error) {
+	f.record("GetByName", a1)
+	if f.GetByNameFunc == nil {
+		r2 = errNotStubbed("OsqueryFileCategoriesService", "GetByName")
+		return
+	}
+	return f.GetByNameFunc(a0, a1)
+}
+
+// Create records the call and returns the results of CreateFunc.
+func (f *OsqueryFileCategoriesService) Create(a0 context.Context, a1 *goztl.OsqueryFileCategoryRequest) (r0 *goztl.OsqueryFileCategory, r1 *goztl.Response, r2 error) {
+	f.record("Create", a1)
+	if f.CreateFunc == nil {
+		r2 = errNotStubbed("OsqueryFileCategoriesService", "Create")
+		return
+	}
+	return f.CreateFunc(a0, a1)
+}
+
+// Update records the call and returns the results of UpdateFunc.
+func (f *OsqueryFileCategoriesService) Update(a0 context.Context, a1 int, a2 *goztl.OsqueryFileCategoryRequest) (r0 *goztl.OsqueryFileCategory, r1 *goztl.Response, r2 error) {
+	f.record("Update", a1, a2)
+	if f.UpdateFunc == nil {
+		r2 = errNotStubbed("OsqueryFileCategoriesService", "Update")
+		return
+	}
+	return f.UpdateFunc(a0, a1, a2)
+}
+
+// Delete records the call and returns the results of DeleteFunc.
+func (f *OsqueryFileCategoriesService) Delete(a0 context.Context, a1 int) (r0 *goztl.Response, r1 error) {
+	f.record("Delete", a1)
+	if f.DeleteFunc == nil {
+		r1 = errNotStubbed("OsqueryFileCategoriesService", "Delete")
+		return
+	}
+	return f.DeleteFunc(a0, a1)
+}
+
+// OsqueryPacksService is a fake goztl.OsqueryPacksService.
+type OsqueryPacksService struct {
+	Recorder
+	ListFunc      func(context.Context, *goztl.ListOptions) ([]goztl.OsqueryPack, *goztl.Response, error)
+	GetByIDFunc   func(context.Context, int) (*goztl.OsqueryPack, *goztl.Response, error)
+	GetByNameFunc func(context.Context, string) (*goztl.OsqueryPack, *goztl.Response, error)
+	CreateFunc    func(context.Context, *goztl.OsqueryPackRequest) (*goztl.OsqueryPack, *goztl.Response, error)
+	UpdateFunc    func(context.Context, int, *goztl.OsqueryPackRequest) (*goztl.OsqueryPack, *goztl.Response, error)
+	DeleteFunc    func(context.Context, int) (*goztl.Response, error)
+}
+
+var _ goztl.OsqueryPacksService = &OsqueryPacksService{}
+
+// List records the call and returns the results of ListFunc.
+func (f *OsqueryPacksService) List(a0 context.Context, a1 *goztl.ListOptions) (r0 []goztl.OsqueryPack, r1 *goztl.Response, r2 error) {
+	f.record("List", a1)
+	if f.ListFunc == nil {
+		r2 = errNotStubbed("OsqueryPacksService", "List")
+		return
+	}
+	return f.ListFunc(a0, a1)
+}
+
+// GetByID records the call and returns the results of GetByIDFunc.
+func (f *OsqueryPacksService) GetByID(a0 context.Context, a1 int) (r0 *goztl.OsqueryPack, r1 *goztl.Response, r2 error) {
+	f.record("GetByID", a1)
+	if f.GetByIDFunc == nil {
+		r2 = errNotStubbed("OsqueryPacksService", "GetByID")
+		return
+	}
+	return f.GetByIDFunc(a0, a1)
+}
+
+// GetByName records the call and returns the results of GetByNameFunc.
+func (f *OsqueryPacksService) GetByName(a0 context.Context, a1 string) (r0 *goztl.OsqueryPack, r1 *goztl.Response, r2 error) {
+	f.record("GetByName", a1)
+	if f.GetByNameFunc == nil {
+		r2 = errNotStubbed("OsqueryPacksService", "GetByName")
+		return
+	}
+	return f.GetByNameFunc(a0, a1)
+}
+
+// Create records the call and returns the results of CreateFunc.
+func (f *OsqueryPacksService) Create(a0 context.Context, a1 *goztl.OsqueryPackRequest) (r0 *goztl.OsqueryPack, r1 *goztl.Response, r2 error) {
+	f.record("Create", a1)
+	if f.CreateFunc == nil {
+		r2 = errNotStubbed("OsqueryPacksService", "Create")
+		return
+	}
+	return f.CreateFunc(a0, a1)
+}
+
+// Update records the call and returns the results of UpdateFunc.
+func (f *OsqueryPacksService) Update(a0 context.Context, a1 int, a2 *goztl.OsqueryPackRequest) (r0 *goztl.OsqueryPack, r1 *goztl.Response, r2 error) {
+	f.record("Update", a1, a2)
+	if f.UpdateFunc == nil {
+		r2 = errNotStubbed("OsqueryPacksService", "Update")
+		return
+	}
+	return f.UpdateFunc(a0, a1, a2)
+}
+
+// Delete records the call and returns the results of DeleteFunc.
+func (f *OsqueryPacksService) Delete(a0 context.Context, a1 int) (r0 *goztl.Response, r1 error) {
+	f.record("Delete", a1)
+	if f.DeleteFunc == nil {
+		r1 = errNotStubbed("OsqueryPacksService", "Delete")
+		return
+	}
+	return f.DeleteFunc(a0, a1)
+}
+
+// OsqueryQueriesService is a fake goztl.OsqueryQueriesService.
+type OsqueryQueriesService struct {
+	Recorder
+	ListFunc        func(context.Context, *goztl.ListOptions) ([]goztl.OsqueryQuery, *goztl.Response, error)
+	GetByIDFunc     func(context.Context, int) (*goztl.OsqueryQuery, *goztl.Response, error)
+	GetByNameFunc   func(context.Context, string) (*goztl.OsqueryQuery, *goztl.Response, error)
+	GetByPackIDFunc func(context.Context, int) ([]goztl.OsqueryQuery, *goztl.Response, error)
+	CreateFunc      func(context.Context, *goztl.OsqueryQueryRequest) (*goztl.OsqueryQuery, *goztl.Response, error)
+	UpdateFunc      func(context.Context, int, *goztl.OsqueryQueryRequest) (*goztl.OsqueryQuery, *goztl.Response, error)
+	DeleteFunc      func(context.Context, int) (*goztl.Response, error)
+}
+
+var _ goztl.OsqueryQueriesService = &OsqueryQueriesService{}
+
+// List records the call and returns the results of ListFunc.
+func (f *OsqueryQueriesService) List(a0 context.Context, a1 *goztl.ListOptions) (r0 []goztl.OsqueryQuery, r1 *goztl.Response, r2 error) {
+	f.record("List", a1)
+	if f.ListFunc == nil {
+		r2 = errNotStubbed("OsqueryQueriesService", "List")
+		return
+	}
+	return f.ListFunc(a0, a1)
+}
+
+// GetByID records the call and returns the results of GetByIDFunc.
+func (f *OsqueryQueriesService) GetByID(a0 context.Context, a1 int) (r0 *goztl.OsqueryQuery, r1 *goztl.Response, r2 error) {
+	f.record("GetByID", a1)
+	if f.GetByIDFunc == nil {
+		r2 = errNotStubbed("OsqueryQueriesService", "GetByID")
+		return
+	}
+	return f.GetByIDFunc(a0, a1)
+}
+
+// GetByName records the call and returns the results of GetByNameFunc.
+func (f *OsqueryQueriesService) GetByName(a0 context.Context, a1 string) (r0 *goztl.OsqueryQuery, r1 *goztl.Response, r2 error) {
+	f.record("GetByName", a1)
+	if f.GetByNameFunc == nil {
+		r2 = errNotStubbed("OsqueryQueriesService", "GetByName")
+		return
+	}
+	return f.GetByNameFunc(a0, a1)
+}
+
+// GetByPackID records the call and returns the results of GetByPackIDFunc.
+func (f *OsqueryQueriesService) GetByPackID(a0 context.Context, a1 int) (r0 []goztl.OsqueryQuery, r1 *goztl.Response, r2 error) {
+	f.record("GetByPackID", a1)
+	if f.GetByPackIDFunc == nil {
+		r2 = errNotStubbed("OsqueryQueriesService", "GetByPackID")
+		return
+	}
+	return f.GetByPackIDFunc(a0, a1)
+}
+
+// Create records the call and returns the results of CreateFunc.
+func (f *OsqueryQueriesService) Create(a0 context.Context, a1 *goztl.OsqueryQueryRequest) (r0 *goztl.OsqueryQuery, r1 *goztl.Response, r2 error) {
+	f.record("Create", a1)
+	if f.CreateFunc == nil {
+		r2 = errNotStubbed("OsqueryQueriesService", "Create")
+		return
+	}
+	return f.CreateFunc(a0, a1)
+}
+
+// Update records the call and returns the results of UpdateFunc.
+func (f *OsqueryQueriesService) Update(a0 context.Context, a1 int, a2 *goztl.OsqueryQueryRequest) (r0 *goztl.OsqueryQuery, r1 *goztl.Response, r2 error) {
+	f.record("Update", a1, a2)
+	if f.UpdateFunc == nil {
+		r2 = errNotStubbed("OsqueryQueriesService", "Update")
+		return
+	}
+	return f.UpdateFunc(a0, a1, a2)
+}
+
+// Delete records the call and returns the results of DeleteFunc.
+func (f *OsqueryQueriesService) Delete(a0 context.Context, a1 int) (r0 *goztl.Response, r1 error) {
+	f.record("Delete", a1)
+	if f.DeleteFunc == nil {
+		r1 = errNotStubbed("OsqueryQueriesService", "Delete")
+		return
+	}
+	return f.DeleteFunc(a0, a1)
+}
+
+// ProbesActionsService is a fake goztl.ProbesActionsService.
+type ProbesActionsService struct {
+	Recorder
+	ListFunc      func(context.Context, *goztl.ListOptions) ([]goztl.ProbeAction, *goztl.Response, error)
+	GetByIDFunc   func(context.Context, string) (*goztl.ProbeAction, *goztl.Response, error)
+	GetByNameFunc func(context.Context, string) (*goztl.ProbeAction, *goztl.Response, error)
+	CreateFunc    func(context.Context, *goztl.ProbeActionRequest) (*goztl.ProbeAction, *goztl.Response, error)
+	UpdateFunc    func(context.Context, string, *goztl.ProbeActionRequest) (*goztl.ProbeAction, *goztl.Response, error)
+	DeleteFunc    func(context.Context, string) (*goztl.Response, error)
+}
+
+var _ goztl.ProbesActionsService = &ProbesActionsService{}
+
+// List records the call and returns the results of ListFunc.
+func (f *ProbesActionsService) List(a0 context.Context, a1 *goztl.ListOptions) (r0 []goztl.ProbeAction, r1 *goztl.Response, r2 error) {
+	f.record("List", a1)
+	if f.ListFunc == nil {
+		r2 = errNotStubbed("ProbesActionsService", "List")
+		return
+	}
+	return f.ListFunc(a0, a1)
+}
+
+// GetByID records the call and returns the results of GetByIDFunc.
+func (f *ProbesActionsService) GetByID(a0 context.Context, a1 string) (r0 *goztl.ProbeAction, r1 *goztl.Response, r2 error) {
+	f.record("GetByID", a1)
+	if f.GetByIDFunc == nil {
+		r2 = errNotStubbed("ProbesActionsService", "GetByID")
+		return
+	}
+	return f.GetByIDFunc(a0, a1)
+}
+
+// GetByName records the call and returns the results of GetByNameFunc.
+func (f *ProbesActionsService) GetByName(a0 context.Context, a1 string) (r0 *goztl.ProbeAction, r1 *goztl.Response, r2 error) {
+	f.record("GetByName", a1)
+	if f.GetByNameFunc == nil {
+		r2 = errNotStubbed("ProbesActionsService", "GetByName")
+		return
+	}
+	return f.GetByNameFunc(a0, a1)
+}
+
+// Create records the call and returns the results of CreateFunc.
+func (f *ProbesActionsService) Create(a0 context.Context, a1 *goztl.ProbeActionRequest) (r0 *goztl.ProbeAction, r1 *goztl.Response, r2 error) {
+	f.record("Create", a1)
+	if f.CreateFunc == nil {
+		r2 = errNotStubbed("ProbesActionsService", "Create")
+		return
+	}
+	return f.CreateFunc(a0, a1)
+}
+
+// Update records the call and returns the results of UpdateFunc.
+func (f *ProbesActionsService) Update(a0 context.Context, a1 string, a2 *goztl.ProbeActionRequest) (r0 *goztl.ProbeAction, r1 *goztl.Response, r2 error) {
+	f.record("Update", a1, a2)
+	if f.UpdateFunc == nil {
+		r2 = errNotStubbed("ProbesActionsService", "Update")
+		return
+	}
+	return f.UpdateFunc(a0, a1, a2)
+}
+
+// Delete records the call and returns the results of DeleteFunc.
+func (f *ProbesActionsService) Delete(a0 context.Context, a1 string) (r0 *goztl.Response, r1 error) {
+	f.record("Delete", a1)
+	if f.DeleteFunc == nil {
+		r1 = errNotStubbed("ProbesActionsService", "Delete")
+		return
+	}
+	return f.DeleteFunc(a0, a1)
+}
+
+// ProbesService is a fake goztl.ProbesService.
+type ProbesService struct {
+	Recorder
+	ListFunc      func(context.Context, *goztl.ListOptions) ([]goztl.Probe, *goztl.Response, error)
+	GetByIDFunc   func(context.Context, int) (*goztl.Probe, *goztl.Response, error)
+	GetByNameFunc func(context.Context, string) (*goztl.Probe, *goztl.Response, error)
+	CreateFunc    func(context.Context, *goztl.ProbeRequest) (*goztl.Probe, *goztl.Response, error)
+	UpdateFunc    func(context.Context, int, *goztl.ProbeRequest) (*goztl.Probe, *goztl.Response, error)
+	DeleteFunc    func(context.Context, int) (*goztl.Response, error)
+}
+
+var _ goztl.ProbesService = &ProbesService{}
+
+// List records the call and returns the results of ListFunc.
+func (f *ProbesService) List(a0 context.Context, a1 *goztl.ListOptions) (r0 []goztl.Probe, r1 *goztl.Response, r2 error) {
+	f.record("List", a1)
+	if f.ListFunc == nil {
+		r2 = errNotStubbed("ProbesService", "List")
+		return
+	}
+	return f.ListFunc(a0, a1)
+}
+
+// GetByID records the call and returns the results of GetByIDFunc.
+func (f *ProbesService) GetByID(a0 context.Context, a1 int) (r0 *goztl.Probe, r1 *goztl.Response, r2 error) {
+	f.record("GetByID", a1)
+	if f.GetByIDFunc == nil {
+		r2 = errNotStubbed("ProbesService", "GetByID")
+		return
+	}
+	return f.GetByIDFunc(a0, a1)
+}
+
+// GetByName records the call and returns the results of GetByNameFunc.
+func (f *ProbesService) GetByName(a0 context.Context, a1 string) (r0 *goztl.Probe, r1 *goztl.Response, r2 error) {
+	f.record("GetByName", a1)
+	if f.GetByNameFunc == nil {
+		r2 = errNotStubbed("ProbesService", "GetByName")
+		return
+	}
+	return f.GetByNameFunc(a0, a1)
+}
+
+// Create records the call and returns the results of CreateFunc.
+func (f *ProbesService) Create(a0 context.Context, a1 *goztl.ProbeRequest) (r0 *goztl.Probe, r1 *goztl.Response, r2 error) {
+	f.record("Create", a1)
+	if f.CreateFunc == nil {
+		r2 = errNotStubbed("ProbesService", "Create")
+		return
+	}
+	return f.CreateFunc(a0, a1)
+}
+
+// Update records the call and returns the results of UpdateFunc.
+func (f *ProbesService) Update(a0 context.Context, a1 int, a2 *goztl.ProbeRequest) (r0 *goztl.Probe, r1 *goztl.Response, r2 error) {
+	f.record("Update", a1, a2)
+	if f.UpdateFunc == nil {
+		r2 = errNotStubbed("ProbesService", "Update")
+		return
+	}
+	return f.UpdateFunc(a0, a1, a2)
+}
+
+// Delete records the call and returns the results of DeleteFunc.
+func (f *ProbesService) Delete(a0 context.Context, a1 int) (r0 *goztl.Response, r1 error) {
+	f.record("Delete", a1)
+	if f.DeleteFunc == nil {
+		r1 = errNotStubbed("ProbesService", "Delete")
+		return
+	}
+	return f.DeleteFunc(a0, a1)
+}
+
+// RealmsGroupsService is a fake goztl.RealmsGroupsService.
+type RealmsGroupsService struct {
+	Recorder
+	ListFunc             func(context.Context, *goztl.ListOptions) ([]goztl.RealmsGroup, *goztl.Response, error)
+	GetByUUIDFunc        func(context.Context, string) (*goztl.RealmsGroup, *goztl.Response, error)
+	GetByRealmUUIDFunc   func(context.Context, string) ([]goztl.RealmsGroup, *goztl.Response, error)
+	GetByDisplayNameFunc func(context.Context, string) ([]goztl.RealmsGroup, *goztl.Response, error)
+	CreateFunc           func(context.Context, *goztl.RealmsGroupRequest) (*goztl.RealmsGroup, *goztl.Response, error)
+	UpdateFunc           func(context.Context, string, *goztl.RealmsGroupRequest) (*goztl.RealmsGroup, *goztl.Response, error)
+	DeleteFunc           func(context.Context, string) (*goztl.Response, error)
+}
+
+var _ goztl.RealmsGroupsService = &RealmsGroupsService{}
+
+// List records the call and returns the results of ListFunc.
+func (f *RealmsGroupsService) List(a0 context.Context, a1 *goztl.ListOptions) (r0 []goztl.RealmsGroup, r1 *goztl.Response, r2 error) {
+	f.record("List", a1)
+	if f.ListFunc == nil {
+		r2 = errNotStubbed("RealmsGroupsService", "List")
+		return
+	}
+	return f.ListFunc(a0, a1)
+}
+
+// GetByUUID records the call and returns the results of GetByUUIDFunc.
+func (f *RealmsGroupsService) GetByUUID(a0 context.Context, a1 string) (r0 *goztl.RealmsGroup, r1 *goztl.Response, r2 error) {
+	f.record("GetByUUID", a1)
+	if f.GetByUUIDFunc == nil {
+		r2 = errNotStubbed("RealmsGroupsService", "GetByUUID")
+		return
+	}
+	return f.GetByUUIDFunc(a0, a1)
+}
+
+// GetByRealmUUID records the call and returns the results of GetByRealmUUIDFunc.
+func (f *RealmsGroupsService) GetByRealmUUID(a0 context.Context, a1 string) (r0 []goztl.RealmsGroup, r1 *goztl.Response, r2 error) {
+	f.record("GetByRealmUUID", a1)
+	if f.GetByRealmUUIDFunc == nil {
+		r2 = errNotStubbed("RealmsGroupsService", "GetByRealmUUID")
+		return
+	}
+	return f.GetByRealmUUIDFunc(a0, a1)
+}
+
+// GetByDisplayName records the call and returns the results of GetByDisplayNameFunc.
+func (f *RealmsGroupsService) GetByDisplayName(a0 context.Context, a1 string) (r0 []goztl.RealmsGroup, r1 *goztl.Response, r2 error) {
+	f.record("GetByDisplayName", a1)
+	if f.GetByDisplayNameFunc == nil {
+		r2 = errNotStubbed("RealmsGroupsService", "GetByDisplayName")
+		return
+	}
+	return f.GetByDisplayNameFunc(a0, a1)
+}
+
+// Create records the call and returns the results of CreateFunc.
+func (f *RealmsGroupsService) Create(a0 context.Context, a1 *goztl.RealmsGroupRequest) (r0 *goztl.RealmsGroup, r1 *goztl.Response, r2 error) {
+	f.record("Create", a1)
+	if f.CreateFunc == nil {
+		r2 = errNotStubbed("RealmsGroupsService", "Create")
+		return
+	}
+	return f.CreateFunc(a0, a1)
+}
+
+// Update records the call and returns the results of UpdateFunc.
+func (f *RealmsGroupsService) Update(a0 context.Context, a1 string, a2 *goztl.RealmsGroupRequest) (r0 *goztl.RealmsGroup, r1 *goztl.Response, r2 error) {
+	f.record("Update", a1, a2)
+	if f.UpdateFunc == nil {
+		r2 = errNotStubbed("RealmsGroupsService", "Update")
+		return
+	}
+	return f.UpdateFunc(a0, a1, a2)
+}
+
+// Delete records the call and returns the results of DeleteFunc.
+func (f *RealmsGroupsService) Delete(a0 context.Context, a1 string) (r0 *goztl.Response, r1 error) {
+	f.record("Delete", a1)
+	if f.DeleteFunc == nil {
+		r1 = errNotStubbed("RealmsGroupsService", "Delete")
+		return
+	}
+	return f.DeleteFunc(a0, a1)
+}
+
+// RealmsRealmsService is a fake goztl.RealmsRealmsService.
+type RealmsRealmsService struct {
+	Recorder
+	ListFunc      func(context.Context, *goztl.ListOptions) ([]goztl.RealmsRealm, *goztl.Response, error)
+	GetByUUIDFunc func(context.Context, string) (*goztl.RealmsRealm, *goztl.Response, error)
+	GetByNameFunc func(context.Context, string) (*goztl.RealmsRealm, *goztl.Response, error)
+	CreateFunc    func(context.Context, *goztl.RealmsRealmRequest) (*goztl.RealmsRealm, *goztl.Response, error)
+	UpdateFunc    func(context.Context, string, *goztl.RealmsRealmRequest) (*goztl.RealmsRealm, *goztl.Response, error)
+	DeleteFunc    func(context.Context, string) (*goztl.Response, error)
+}
+
+var _ goztl.RealmsRealmsService = &RealmsRealmsService{}
+
+// List records the call and returns the results of ListFunc.
+func (f *RealmsRealmsService) List(a0 context.Context, a1 *goztl.ListOptions) (r0 []goztl.RealmsRealm, r1 *goztl.Response, r2 error) {
+	f.record("List", a1)
+	if f.ListFunc == nil {
+		r2 = errNotStubbed("RealmsRealmsService", "List")
+		return
+	}
+	return f.ListFunc(a0, a1)
+}
+
+// GetByUUID records the call and returns the results of GetByUUIDFunc.
+func (f *RealmsRealmsService) GetByUUID(a0 context.Context, a1 string) (r0 *goztl.RealmsRealm, r1 *goztl.Response, r2 error) {
+	f.record("GetByUUID", a1)
+	if f.GetByUUIDFunc == nil {
+		r2 = errNotStubbed("RealmsRealmsService", "GetByUUID")
+		return
+	}
+	return f.GetByUUIDFunc(a0, a1)
+}
+
+// GetByName records the call and returns the results of GetByNameFunc.
+func (f *RealmsRealmsService) GetByName(a0 context.Context, a1 string) (r0 *goztl.RealmsRealm, r1 *goztl.Response, r2 error) {
+	f.record("GetByName", a1)
+	if f.GetByNameFunc == nil {
+		r2 = errNotStubbed("RealmsRealmsService", "GetByName")
+		return
+	}
+	return f.GetByNameFunc(a0, a1)
+}
+
+// Create records the call and returns the results of CreateFunc.
+func (f *RealmsRealmsService) Create(a0 context.Context, a1 *goztl.RealmsRealmRequest) (r0 *goztl.RealmsRealm, r1 *goztl.Response, r2 error) {
+	f.record("Create", a1)
+	if f.CreateFunc == nil {
+		r2 = errNotStubbed("RealmsRealmsService", "Create")
+		return
+	}
+	return f.CreateFunc(a0, a1)
+}
+
+// Update records the call and returns the results of UpdateFunc.
+func (f *RealmsRealmsService) Update(a0 context.Context, a1 string, a2 *goztl.RealmsRealmRequest) (r0 *goztl.RealmsRealm, r1 *goztl.Response, r2 error) {
+	f.record("Update", a1, a2)
+	if f.UpdateFunc == nil {
+		r2 = errNotStubbed("RealmsRealmsService", "Update")
+		return
+	}
+	return f.UpdateFunc(a0, a1, a2)
+}
+
+// Delete records the call and returns the results of DeleteFunc.
+func (f *RealmsRealmsService) Delete(a0 context.Context, a1 string) (r0 *goztl.Response, r1 error) {
+	f.record("Delete", a1)
+	if f.DeleteFunc == nil {
+		r1 = errNotStubbed("RealmsRealmsService", "Delete")
+		return
+	}
+	return f.DeleteFunc(a0, a1)
+}
+
+// RealmsRoleMappingsService is a fake goztl.RealmsRoleMappingsService.
+type RealmsRoleMappingsService struct {
+	Recorder
+	ListFunc                func(context.Context, *goztl.ListOptions) ([]goztl.RealmsRoleMapping, *goztl.Response, error)
+	GetByIDFunc             func(context.Context, string) (*goztl.RealmsRoleMapping, *goztl.Response, error)
+	GetByRealmGroupUUIDFunc func(context.Context, string) ([]goztl.RealmsRoleMapping, *goztl.Response, error)
+	GetByRoleIDFunc         func(context.Context, int) ([]goztl.RealmsRoleMapping, *goztl.Response, error)
+	CreateFunc              func(context.Context, *goztl.RealmsRoleMappingRequest) (*goztl.RealmsRoleMapping, *goztl.Response, error)
+	UpdateFunc              func(context.Context, string, *goztl.RealmsRoleMappingRequest) (*goztl.RealmsRoleMapping, *goztl.Response, error)
+	DeleteFunc              func(context.Context, string) (*goztl.Response, error)
+}
+
+var _ goztl.RealmsRoleMappingsService = &RealmsRoleMappingsService{}
+
+// List records the call and returns the results of ListFunc.
+func (f *RealmsRoleMappingsService) List(a0 context.Context, a1 *goztl.ListOptions) (r0 []goztl.RealmsRoleMapping, r1 *goztl.Response, r2 error) {
+	f.record("List", a1)
+	if f.ListFunc == nil {
+		r2 = errNotStubbed("RealmsRoleMappingsService", "List")
+		return
+	}
+	return f.ListFunc(a0, a1)
+}
+
+// GetByID records the call and returns the results of GetByIDFunc.
+func (f *RealmsRoleMappingsService) GetByID(a0 context.Context, a1 string) (r0 *goztl.RealmsRoleMapping, r1 *goztl.Response, r2 error) {
+	f.record("GetByID", a1)
+	if f.GetByIDFunc == nil {
+		r2 = errNotStubbed("RealmsRoleMappingsService", "GetByID")
+		return
+	}
+	return f.GetByIDFunc(a0, a1)
+}
+
+// GetByRealmGroupUUID records the call and returns the results of GetByRealmGroupUUIDFunc.
+func (f *RealmsRoleMappingsService) GetByRealmGroupUUID(a0 context.Context, a1 string) (r0 []goztl.RealmsRoleMapping, r1 *goztl.Response, r2 error) {
+	f.record("GetByRealmGroupUUID", a1)
+	if f.GetByRealmGroupUUIDFunc == nil {
+		r2 = errNotStubbed("RealmsRoleMappingsService", "GetByRealmGroupUUID")
+		return
+	}
+	return f.GetByRealmGroupUUIDFunc(a0, a1)
+}
+
+// GetByRoleID records the call and returns the results of GetByRoleIDFunc.
+func (f *RealmsRoleMappingsService) GetByRoleID(a0 context.Context, a1 int) (r0 []goztl.RealmsRoleMapping, r1 *goztl.Response, r2 error) {
+	f.record("GetByRoleID", a1)
+	if f.GetByRoleIDFunc == nil {
+		r2 = errNotStubbed("RealmsRoleMappingsService", "GetByRoleID")
+		return
+	}
+	return f.GetByRoleIDFunc(a0, a1)
+}
+
+// Create records the call and returns the results of CreateFunc.
+func (f *RealmsRoleMappingsService) Create(a0 context.Context, a1 *goztl.RealmsRoleMappingRequest) (r0 *goztl.RealmsRoleMapping, r1 *goztl.Response, r2 error) {
+	f.record("Create", a1)
+	if f.CreateFunc == nil {
+		r2 = errNotStubbed("RealmsRoleMappingsService", "Create")
+		return
+	}
+	return f.CreateFunc(a0, a1)
+}
+
+// Update records the call and returns the results of UpdateFunc.
+func (f *RealmsRoleMappingsService) Update(a0 context.Context, a1 string, a2 *goztl.RealmsRoleMappingRequest) (r0 *goztl.RealmsRoleMapping, r1 *goztl.Response, r2 error) {
+	f.record("Update", a1, a2)
+	if f.UpdateFunc == nil {
+		r2 = errNotStubbed("RealmsRoleMappingsService", "Update")
+		return
+	}
+	return f.UpdateFunc(a0, a1, a2)
+}
+
+// Delete records the call and returns the results of DeleteFunc.
+func (f *RealmsRoleMappingsService) Delete(a0 context.Context, a1 string) (r0 *goztl.Response, r1 error) {
+	f.record("Delete", a1)
+	if f.DeleteFunc == nil {
+		r1 = errNotStubbed("RealmsRoleMappingsService", "Delete")
+		return
+	}
+	return f.DeleteFunc(a0, a1)
+}
+
+// RealmsTagMappingsService is a fake goztl.RealmsTagMappingsService.
+type RealmsTagMappingsService struct {
+	Recorder
+	ListFunc                func(context.Context, *goztl.ListOptions) ([]goztl.RealmsTagMapping, *goztl.Response, error)
+	GetByIDFunc             func(context.Context, string) (*goztl.RealmsTagMapping, *goztl.Response, error)
+	GetByRealmGroupUUIDFunc func(context.Context, string) ([]goztl.RealmsTagMapping, *goztl.Response, error)
+	GetByTagIDFunc          func(context.Context, int) ([]goztl.RealmsTagMapping, *goztl.Response, error)
+	CreateFunc              func(context.Context, *goztl.RealmsTagMappingRequest) (*goztl.RealmsTagMapping, *goztl.Response, error)
+	UpdateFunc              func(context.Context, string, *goztl.RealmsTagMappingRequest) (*goztl.RealmsTagMapping, *goztl.Response, error)
+	DeleteFunc              func(context.Context, string) (*goztl.Response, error)
+}
+
+var _ goztl.RealmsTagMappingsService = &RealmsTagMappingsService{}
+
+// List records the call and returns the results of ListFunc.
+func (f *RealmsTagMappingsService) List(a0 context.Context, a1 *goztl.ListOptions) (r0 []goztl.RealmsTagMapping, r1 *goztl.Response, r2 error) {
+	f.record("List", a1)
+	if f.ListFunc == nil {
+		r2 = errNotStubbed("RealmsTagMappingsService", "List")
+		return
+	}
+	return f.ListFunc(a0, a1)
+}
+
+// GetByID records the call and returns the results of GetByIDFunc.
+func (f *RealmsTagMappingsService) GetByID(a0 context.Context, a1 string) (r0 *goztl.RealmsTagMapping, r1 *goztl.Response, r2 error) {
+	f.record("GetByID", a1)
+	if f.GetByIDFunc == nil {
+		r2 = errNotStubbed("RealmsTagMappingsService", "GetByID")
+		return
+	}
+	return f.GetByIDFunc(a0, a1)
+}
+
+// GetByRealmGroupUUID records the call and returns the results of GetByRealmGroupUUIDFunc.
+func (f *RealmsTagMappingsService) GetByRealmGroupUUID(a0 context.Context, a1 string) (r0 []goztl.RealmsTagMapping, r1 *goztl.Response, r2 error) {
+	f.record("GetByRealmGroupUUID", a1)
+	if f.GetByRealmGroupUUIDFunc == nil {
+		r2 = errNotStubbed("RealmsTagMappingsService", "GetByRealmGroupUUID")
+		return
+	}
+	return f.GetByRealmGroupUUIDFunc(a0, a1)
+}
+
+// GetByTagID records the call and returns the results of GetByTagIDFunc.
+func (f *RealmsTagMappingsService) GetByTagID(a0 context.Context, a1 int) (r0 []goztl.RealmsTagMapping, r1 *goztl.Response, r2 error) {
+	f.record("GetByTagID", a1)
+	if f.GetByTagIDFunc == nil {
+		r2 = errNotStubbed("RealmsTagMappingsService", "GetByTagID")
+		return
+	}
+	return f.GetByTagIDFunc(a0, a1)
+}
+
+// Create records the call and returns the results of CreateFunc.
+func (f *RealmsTagMappingsService) Create(a0 context.Context, a1 *goztl.RealmsTagMappingRequest) (r0 *goztl.RealmsTagMapping, r1 *goztl.Response, r2 error) {
+	f.record("Create", a1)
+	if f.CreateFunc == nil {
+		r2 = errNotStubbed("RealmsTagMappingsService", "Create")
+		return
+	}
+	return f.CreateFunc(a0, a1)
+}
+
+// Update records the call and returns the results of UpdateFunc.
+func (f *RealmsTagMappingsService) Update(a0 context.Context, a1 string, a2 *goztl.RealmsTagMappingRequest) (r0 *goztl.RealmsTagMapping, r1 *goztl.Response, r2 error) {
+	f.record("Update", a1, a2)
+	if f.UpdateFunc == nil {
+		r2 = errNotStubbed("RealmsTagMappingsService", "Update")
+		return
+	}
+	return f.UpdateFunc(a0, a1, a2)
+}
+
+// Delete records the call and returns the results of DeleteFunc.
+func (f *RealmsTagMappingsService) Delete(a0 context.Context, a1 string) (r0 *goztl.Response, r1 error) {
+	f.record("Delete", a1)
+	if f.DeleteFunc == nil {
+		r1 = errNotStubbed("RealmsTagMappingsService", "Delete")
+		return
+	}
+	return f.DeleteFunc(a0, a1)
+}
+
+// RolesService is a fake goztl.RolesService.
+type RolesService struct {
+	Recorder
+	ListFunc            func(context.Context, *goztl.ListOptions) ([]goztl.Role, *goztl.Response, error)
+	GetByIDFunc         func(context.Context, int) (*goztl.Role, *goztl.Response, error)
+	GetByNameFunc       func(context.Context, string) (*goztl.Role, *goztl.Response, error)
+	CreateFunc          func(context.Context, *goztl.RoleRequest) (*goztl.Role, *goztl.Response, error)
+	UpdateFunc          func(context.Context, int, *goztl.RoleRequest) (*goztl.Role, *goztl.Response, error)
+	DeleteFunc          func(context.Context, int) (*goztl.Response, error)
+	ListPermissionsFunc func(context.Context, int) ([]string, *goztl.Response, error)
+	SetPermissionsFunc  func(context.Context, int, []string) (*goztl.Role, *goztl.Response, error)
+}
+
+var _ goztl.RolesService = &RolesService{}
+
+// List records the call and returns the results of ListFunc.
+func (f *RolesService) List(a0 context.Context, a1 *goztl.ListOptions) (r0 []goztl.Role, r1 *goztl.Response, r2 error) {
+	f.record("List", a1)
+	if f.ListFunc == nil {
+		r2 = errNotStubbed("RolesService", "List")
+		return
+	}
+	return f.ListFunc(a0, a1)
+}
+
+// GetByID records the call and returns the results of GetByIDFunc.
+func (f *RolesService) GetByID(a0 context.Context, a1 int) (r0 *goztl.Role, r1 *goztl.Response, r2 error) {
+	f.record("GetByID", a1)
+	if f.GetByIDFunc == nil {
+		r2 = errNotStubbed("RolesService", "GetByID")
+		return
+	}
+	return f.GetByIDFunc(a0, a1)
+}
+
+// GetByName records the call and returns the results of GetByNameFunc.
+func (f *RolesService) GetByName(a0 context.Context, a1 string) (r0 *goztl.Role, r1 *goztl.Response, r2 error) {
+	f.record("GetByName", a1)
+	if f.GetByNameFunc == nil {
+		r2 = errNotStubbed("RolesService", "GetByName")
+		return
+	}
+	return f.GetByNameFunc(a0, a1)
+}
+
+// Create records the call and returns the results of CreateFunc.
+func (f *RolesService) Create(a0 context.Context, a1 *goztl.RoleRequest) (r0 *goztl.Role, r1 *goztl.Response, r2 error) {
+	f.record("Create", a1)
+	if f.CreateFunc == nil {
+		r2 = errNotStubbed("RolesService", "Create")
+		return
+	}
+	return f.CreateFunc(a0, a1)
+}
+
+// Update records the call and returns the results of UpdateFunc.
+func (f *RolesService) Update(a0 context.Context, a1 int, a2 *goztl.RoleRequest) (r0 *goztl.Role, r1 *goztl.Response, r2 error) {
+	f.record("Update", a1, a2)
+	if f.UpdateFunc == nil {
+		r2 = errNotStubbed("RolesService", "Update")
+		return
+	}
+	return f.UpdateFunc(a0, a1, a2)
+}
+
+// Delete records the call and returns the results of DeleteFunc.
+func (f *RolesService) Delete(a0 context.Context, a1 int) (r0 *goztl.Response, r1 error) {
+	f.record("Delete", a1)
+	if f.DeleteFunc == nil {
+		r1 = errNotStubbed("RolesService", "Delete")
+		return
+	}
+	return f.DeleteFunc(a0, a1)
+}
+
+// ListPermissions records the call and returns the results of ListPermissionsFunc.
+func (f *RolesService) ListPermissions(a0 context.Context, a1 int) (r0 []string, r1 *goztl.Response, r2 error) {
+	f.record("ListPermissions", a1)
+	if f.ListPermissionsFunc == nil {
+		r2 = errNotStubbed("RolesService", "ListPermissions")
+		return
+	}
+	return f.ListPermissionsFunc(a0, a1)
+}
+
+// SetPermissions records the call and returns the results of SetPermissionsFunc.
+func (f *RolesService) SetPermissions(a0 context.Context, a1 int, a2 []string) (r0 *goztl.Role, r1 *goztl.Response, r2 error) {
+	f.record("SetPermissions", a1, a2)
+	if f.SetPermissionsFunc == nil {
+		r2 = errNotStubbed("RolesService", "SetPermissions")
+		return
+	}
+	return f.SetPermissionsFunc(a0, a1, a2)
+}
+
+// SantaConfigurationsService is a fake goztl.SantaConfigurationsService.
+type SantaConfigurationsService struct {
+	Recorder
+	ListFunc      func(context.Context, *goztl.ListOptions) ([]goztl.SantaConfiguration, *goztl.Response, error)
+	GetByIDFunc   func(context.Context, int) (*goztl.SantaConfiguration, *goztl.Response, error)
+	GetByNameFunc func(context.Context, string) (*goztl.SantaConfiguration, *goztl.Response, error)
+	CreateFunc    func(context.Context, *goztl.SantaConfigurationRequest) (*goztl.SantaConfiguration, *goztl.Response, error)
+	UpdateFunc    func(context.Context, int, *goztl.SantaConfigurationRequest) (*goztl.SantaConfiguration, *goztl.Response, error)
+	DeleteFunc    func(context.Context, int) (*goztl.Response, error)
+}
+
+var _ goztl.SantaConfigurationsService = &SantaConfigurationsService{}
+
+// List records the call and returns the results of ListFunc.
+func (f *SantaConfigurationsService) List(a0 context.Context, a1 *goztl.ListOptions) (r0 []goztl.SantaConfiguration, r1 *goztl.Response, r2 error) {
+	f.record("List", a1)
+	if f.ListFunc == nil {
+		r2 = errNotStubbed("SantaConfigurationsService", "List")
+		return
+	}
+	return f.ListFunc(a0, a1)
+}
+
+// GetByID records the call and returns the results of GetByIDFunc.
+func (f *SantaConfigurationsService) GetByID(a0 context.Context, a1 int) (r0 *goztl.SantaConfiguration, r1 *goztl.Response, r2 error) {
+	f.record("GetByID", a1)
+	if f.GetByIDFunc == nil {
+		r2 = errNotStubbed("SantaConfigurationsService", "GetByID")
+		return
+	}
+	return f.GetByIDFunc(a0, a1)
+}
+
+// GetByName records the call and returns the results of GetByNameFunc.
+func (f *SantaConfigurationsService) GetByName(a0 context.Context, a1 string) (r0 *goztl.SantaConfiguration, r1 *goztl.Response, r2 error) {
+	f.record("GetByName", a1)
+	if f.GetByNameFunc == nil {
+		r2 = errNotStubbed("SantaConfigurationsService", "GetByName")
+		return
+	}
+	return f.GetByNameFunc(a0, a1)
+}
+
+// Create records the call and returns the results of CreateFunc.
+func (f *SantaConfigurationsService) Create(a0 context.Context, a1 *goztl.SantaConfigurationRequest) (r0 *goztl.SantaConfiguration, r1 *goztl.Response, r2 error) {
+	f.record("Create", a1)
+	if f.CreateFunc == nil {
+		r2 = errNotStubbed("SantaConfigurationsService", "Create")
+		return
+	}
+	return f.CreateFunc(a0, a1)
+}
+
+// Update records the call and returns the results of UpdateFunc.
+func (f *SantaConfigurationsService) Update(a0 context.Context, a1 int, a2 *goztl.SantaConfigurationRequest) (r0 *goztl.SantaConfiguration, r1 *goztl.Response, r2 error) {
+	f.record("Update", a1, a2)
+	if f.UpdateFunc == nil {
+		r2 = errNotStubbed("SantaConfigurationsService", "Update")
+		return
+	}
+	return f.UpdateFunc(a0, a1, a2)
+}
+
+// Delete records the call and returns the results of DeleteFunc.
+func (f *SantaConfigurationsService) Delete(a0 context.Context, a1 int) (r0 *goztl.Response, r1 error) {
+	f.record("Delete", a1)
+	if f.DeleteFunc == nil {
+		r1 = errNotStubbed("SantaConfigurationsService", "Delete")
+		return
+	}
+	return f.DeleteFunc(a0, a1)
+}
+
+// SantaEnrollmentsService is a fake goztl.SantaEnrollmentsService.
+type SantaEnrollmentsService struct {
+	Recorder
+	ListFunc                 func(context.Context, *goztl.ListOptions) ([]goztl.SantaEnrollment, *goztl.Response, error)
+	GetByIDFunc              func(context.Context, int) (*goztl.SantaEnrollment, *goztl.Response, error)
+	GetByConfigurationIDFunc func(context.Context, int) ([]goztl.SantaEnrollment, *goztl.Response, error)
+	CreateFunc               func(context.Context, *goztl.SantaEnrollmentRequest) (*goztl.SantaEnrollment, *goztl.Response, error)
+	UpdateFunc               func(context.Context, int, *goztl.SantaEnrollmentRequest) (*goztl.SantaEnrollment, *goztl.Response, error)
+	DeleteFunc               func(context.Context, int) (*goztl.Response, error)
+}
+
+var _ goztl.SantaEnrollmentsService = &SantaEnrollmentsService{}
+
+// List records the call and returns the results of ListFunc.
+func (f *SantaEnrollmentsService) List(a0 context.Context, a1 *goztl.ListOptions) (r0 []goztl.SantaEnrollment, r1 *goztl.Response, r2 error) {
+	f.record("List", a1)
+	if f.ListFunc == nil {
+		r2 = errNotStubbed("SantaEnrollmentsService", "List")
+		return
+	}
+	return f.ListFunc(a0, a1)
+}
+
+// GetByID records the call and returns the results of GetByIDFunc.
+func (f *SantaEnrollmentsService) GetByID(a0 context.Context, a1 int) (r0 *goztl.SantaEnrollment, r1 *goztl.Response, r2 error) {
+	f.record("GetByID", a1)
+	if f.GetByIDFunc == nil {
+		r2 = errNotStubbed("SantaEnrollmentsService", "GetByID")
+		return
+	}
+	return f.GetByIDFunc(a0, a1)
+}
+
+// GetByConfigurationID records the call and returns the results of GetByConfigurationIDFunc.
+func (f *SantaEnrollmentsService) GetByConfigurationID(a0 context.Context, a1 int) (r0 []goztl.SantaEnrollment, r1 *goztl.Response, r2 error) {
+	f.record("GetByConfigurationID", a1)
+	if f.GetByConfigurationIDFunc == nil {
+		r2 = errNotStubbed("SantaEnrollmentsService", "GetByConfigurationID")
+		return
+	}
+	return f.GetByConfigurationIDFunc(a0, a1)
+}
+
+// Create records the call and returns the results of CreateFunc.
+func (f *SantaEnrollmentsService) Create(a0 context.Context, a1 *goztl.SantaEnrollmentRequest) (r0 *goztl.SantaEnrollment, r1 *goztl.Response, r2 error) {
+	f.record("Create", a1)
+	if f.CreateFunc == nil {
+		r2 = errNotStubbed("SantaEnrollmentsService", "Create")
+		return
+	}
+	return f.CreateFunc(a0, a1)
+}
+
+// Update records the call and returns the results of UpdateFunc.
+func (f *SantaEnrollmentsService) Update(a0 context.Context, a1 int, a2 *goztl.SantaEnrollmentRequest) (r0 *goztl.SantaEnrollment, r1 *goztl.Response, r2 error) {
+	f.record("Update", a1, a2)
+	if f.UpdateFunc == nil {
+		r2 = errNotStubbed("SantaEnrollmentsService", "Update")
+		return
+	}
+	return f.UpdateFunc(a0, a1, a2)
+}
+
+// Delete records the call and returns the results of DeleteFunc.
+func (f *SantaEnrollmentsService) Delete(a0 context.Context, a1 int) (r0 *goztl.Response, r1 error) {
+	f.record("Delete", a1)
+	if f.DeleteFunc == nil {
+		r1 = errNotStubbed("SantaEnrollmentsService", "Delete")
+		return
+	}
+	return f.DeleteFunc(a0, a1)
+}
+
+// SantaRulesService is a fake goztl.SantaRulesService.
+type SantaRulesService struct {
+	Recorder
+	ListFunc                  func(context.Context, *goztl.ListOptions) ([]goztl.SantaRule, *goztl.Response, error)
+	GetByIDFunc               func(context.Context, int) (*goztl.SantaRule, *goztl.Response, error)
+	GetByConfigurationIDFunc  func(context.Context, int) ([]goztl.SantaRule, *goztl.Response, error)
+	GetByTargetIdentifierFunc func(context.Context, string) ([]goztl.SantaRule, *goztl.Response, error)
+	GetByTargetTypeFunc       func(context.Context, string) ([]goztl.SantaRule, *goztl.Response, error)
+	CreateFunc                func(context.Context, *goztl.SantaRuleRequest) (*goztl.SantaRule, *goztl.Response, error)
+	UpdateFunc                func(context.Context, int, *goztl.SantaRuleRequest) (*goztl.SantaRule, *goztl.Response, error)
+	DeleteFunc                func(context.Context, int) (*goztl.Response, error)
+}
+
+var _ goztl.SantaRulesService = &SantaRulesService{}
+
+// List records the call and returns the results of ListFunc.
+func (f *SantaRulesService) List(a0 context.Context, a1 *goztl.ListOptions) (r0 []goztl.SantaRule, r1 *goztl.Response, r2 error) {
+	f.record("List", a1)
+	if f.ListFunc == nil {
+		r2 = errNotStubbed("SantaRulesService", "List")
+		return
+	}
+	return f.ListFunc(a0, a1)
+}
+
+// GetByID records the call and returns the results of GetByIDFunc.
+func (f *SantaRulesService) GetByID(a0 context.Context, a1 int) (r0 *goztl.SantaRule, r1 *goztl.Response, r2 error) {
+	f.record("GetByID", a1)
+	if f.GetByIDFunc == nil {
+		r2 = errNotStubbed("SantaRulesService", "GetByID")
+		return
+	}
+	return f.GetByIDFunc(a0, a1)
+}
+
+// GetByConfigurationID records the call and returns the results of GetByConfigurationIDFunc.
+func (f *SantaRulesService) GetByConfigurationID(a0 context.Context, a1 int) (r0 []goztl.SantaRule, r1 *goztl.Response, r2 error) {
+	f.record("GetByConfigurationID", a1)
+	if f.GetByConfigurationIDFunc == nil {
+		r2 = errNotStubbed("SantaRulesService", "GetByConfigurationID")
+		return
+	}
+	return f.GetByConfigurationIDFunc(a0, a1)
+}
+
+// GetByTargetIdentifier records the call and returns the results of GetByTargetIdentifierFunc.
+func (f *SantaRulesService) GetByTargetIdentifier(a0 context.Context, a1 string) (r0 []goztl.SantaRule, r1 *goztl.Response, r2 error) {
+	f.record("GetByTargetIdentifier", a1)
+	if f.GetByTargetIdentifierFunc == nil {
+		r2 = errNotStubbed("SantaRulesService", "GetByTargetIdentifier")
+		return
+	}
+	return f.GetByTargetIdentifierFunc(a0, a1)
+}
+
+// GetByTargetType records the call and returns the results of GetByTargetTypeFunc.
+func (f *SantaRulesService) GetByTargetType(a0 context.Context, a1 string) (r0 []goztl.SantaRule, r1 *goztl.Response, r2 error) {
+	f.record("GetByTargetType", a1)
+	if f.GetByTargetTypeFunc == nil {
+		r2 = errNotStubbed("SantaRulesService", "GetByTargetType")
+		return
+	}
+	return f.GetByTargetTypeFunc(a0, a1)
+}
+
+// Create records the call and returns the results of CreateFunc.
+func (f *SantaRulesService) Create(a0 context.Context, a1 *goztl.SantaRuleRequest) (r0 *goztl.SantaRule, r1 *goztl.Response, r2 error) {
+	f.record("Create", a1)
+	if f.CreateFunc == nil {
+		r2 = errNotStubbed("SantaRulesService", "Create")
+		return
+	}
+	return f.CreateFunc(a0, a1)
+}
+
+// Update records the call and returns the results of UpdateFunc.
+func (f *SantaRulesService) Update(a0 context.Context, a1 int, a2 *goztl.SantaRuleRequest) (r0 *goztl.SantaRule, r1 *goztl.Response, r2 error) {
+	f.record("Update", a1, a2)
+	if f.UpdateFunc == nil {
+		r2 = errNotStubbed("SantaRulesService", "Update")
+		return
+	}
+	return f.UpdateFunc(a0, a1, a2)
+}
+
+// Delete records the call and returns the results of DeleteFunc.
+func (f *SantaRulesService) Delete(a0 context.Context, a1 int) (r0 *goztl.Response, r1 error) {
+	f.record("Delete", a1)
+	if f.DeleteFunc == nil {
+		r1 = errNotStubbed("SantaRulesService", "Delete")
+		return
+	}
+	return f.DeleteFunc(a0, a1)
+}
+
+// StoresService is a fake goztl.StoresService.
+type StoresService struct {
+	Recorder
+	ListFunc      func(context.Context, *goztl.ListOptions) ([]goztl.Store, *goztl.Response, error)
+	GetByIDFunc   func(context.Context, string) (*goztl.Store, *goztl.Response, error)
+	GetByNameFunc func(context.Context, string) (*goztl.Store, *goztl.Response, error)
+	CreateFunc    func(context.Context, *goztl.StoreRequest) (*goztl.Store, *goztl.Response, error)
+	UpdateFunc    func(context.Context, string, *goztl.StoreRequest) (*goztl.Store, *goztl.Response, error)
+	DeleteFunc    func(context.Context, string) (*goztl.Response, error)
+}
+
+var _ goztl.StoresService = &StoresService{}
+
+// List records the call and returns the results of ListFunc.
+func (f *StoresService) List(a0 context.Context, a1 *goztl.ListOptions) (r0 []goztl.Store, r1 *goztl.Response, r2 error) {
+	f.record("List", a1)
+	if f.ListFunc == nil {
+		r2 = errNotStubbed("StoresService", "List")
+		return
+	}
+	return f.ListFunc(a0, a1)
+}
+
+// GetByID records the call and returns the results of GetByIDFunc.
+func (f *StoresService) GetByID(a0 context.Context, a1 string) (r0 *goztl.Store, r1 *goztl.Response, r2 error) {
+	f.record("GetByID", a1)
+	if f.GetByIDFunc == nil {
+		r2 = errNotStubbed("StoresService", "GetByID")
+		return
+	}
+	return f.GetByIDFunc(a0, a1)
+}
+
+// GetByName records the call and returns the results of GetByNameFunc.
+func (f *StoresService) GetByName(a0 context.Context, a1 string) (r0 *goztl.Store, r1 *goztl.Response, r2 error) {
+	f.record("GetByName", a1)
+	if f.GetByNameFunc == nil {
+		r2 = errNotStubbed("StoresService", "GetByName")
+		return
+	}
+	return f.GetByNameFunc(a0, a1)
+}
+
+// Create records the call and returns the results of CreateFunc.
+func (f *StoresService) Create(a0 context.Context, a1 *goztl.StoreRequest) (r0 *goztl.Store, r1 *goztl.Response, r2 error) {
+	f.record("Create", a1)
+	if f.CreateFunc == nil {
+		r2 = errNotStubbed("StoresService", "Create")
+		return
+	}
+	return f.CreateFunc(a0, a1)
+}
+
+// Update records the call and returns the results of UpdateFunc.
+func (f *StoresService) Update(a0 context.Context, a1 string, a2 *goztl.StoreRequest) (r0 *goztl.Store, r1 *goztl.Response, r2 error) {
+	f.record("Update", a1, a2)
+	if f.UpdateFunc == nil {
+		r2 = errNotStubbed("StoresService", "Update")
+		return
+	}
+	return f.UpdateFunc(a0, a1, a2)
+}
+
+// Delete records the call and returns the results of DeleteFunc.
+func (f *StoresService) Delete(a0 context.Context, a1 string) (r0 *goztl.Response, r1 error) {
+	f.record("Delete", a1)
+	if f.DeleteFunc == nil {
+		r1 = errNotStubbed("StoresService", "Delete")
+		return
+	}
+	return f.DeleteFunc(a0, a1)
+}
+
+// TagsService is a fake goztl.TagsService.
+type TagsService struct {
+	Recorder
+	ListFunc      func(context.Context, *goztl.ListOptions) ([]goztl.Tag, *goztl.Response, error)
+	GetByIDFunc   func(context.Context, int) (*goztl.Tag, *goztl.Response, error)
+	GetByNameFunc func(context.Context, string) (*goztl.Tag, *goztl.Response, error)
+	CreateFunc    func(context.Context, *goztl.TagCreateRequest) (*goztl.Tag, *goztl.Response, error)
+	UpdateFunc    func(context.Context, int, *goztl.TagUpdateRequest) (*goztl.Tag, *goztl.Response, error)
+	DeleteFunc    func(context.Context, int) (*goztl.Response, error)
+}
+
+var _ goztl.TagsService = &TagsService{}
+
+// List records the call and returns the results of ListFunc.
+func (f *TagsService) List(a0 context.Context, a1 *goztl.ListOptions) (r0 []goztl.Tag, r1 *goztl.Response, r2 error) {
+	f.record("List", a1)
+	if f.ListFunc == nil {
+		r2 = errNotStubbed("TagsService", "List")
+		return
+	}
+	return f.ListFunc(a0, a1)
+}
+
+// GetByID records the call and returns the results of GetByIDFunc.
+func (f *TagsService) GetByID(a0 context.Context, a1 int) (r0 *goztl.Tag, r1 *goztl.Response, r2 error) {
+	f.record("GetByID", a1)
+	if f.GetByIDFunc == nil {
+		r2 = errNotStubbed("TagsService", "GetByID")
+		return
+	}
+	return f.GetByIDFunc(a0, a1)
+}
+
+// GetByName records the call and returns the results of GetByNameFunc.
+func (f *TagsService) GetByName(a0 context.Context, a1 string) (r0 *goztl.Tag, r1 *goztl.Response, r2 error) {
+	f.record("GetByName", a1)
+	if f.GetByNameFunc == nil {
+		r2 = errNotStubbed("TagsService", "GetByName")
+		return
+	}
+	return f.GetByNameFunc(a0, a1)
+}
+
+// Create records the call and returns the results of CreateFunc.
+func (f *TagsService) Create(a0 context.Context, a1 *goztl.TagCreateRequest) (r0 *goztl.Tag, r1 *goztl.Response, r2 error) {
+	f.record("Create", a1)
+	if f.CreateFunc == nil {
+		r2 = errNotStubbed("TagsService", "Create")
+		return
+	}
+	return f.CreateFunc(a0, a1)
+}
+
+// Update records the call and returns the results of UpdateFunc.
+func (f *TagsService) Update(a0 context.Context, a1 int, a2 *goztl.TagUpdateRequest) (r0 *goztl.Tag, r1 *goztl.Response, r2 error) {
+	f.record("Update", a1, a2)
+	if f.UpdateFunc == nil {
+		r2 = errNotStubbed("TagsService", "Update")
+		return
+	}
+	return f.UpdateFunc(a0, a1, a2)
+}
+
+// Delete records the call and returns the results of DeleteFunc.
+func (f *TagsService) Delete(a0 context.Context, a1 int) (r0 *goztl.Response, r1 error) {
+	f.record("Delete", a1)
+	if f.DeleteFunc == nil {
+		r1 = errNotStubbed("TagsService", "Delete")
+		return
+	}
+	return f.DeleteFunc(a0, a1)
+}
+
+// TasksService is a fake goztl.TasksService.
+type TasksService struct {
+	Recorder
+	GetByIDFunc  func(context.Context, string) (*goztl.TaskResult, *goztl.Response, error)
+	WaitFunc     func(context.Context, string, *goztl.TaskPollOptions) (*goztl.TaskResult, *goztl.Response, error)
+	DownloadFunc func(context.Context, *goztl.TaskResult, io.Writer) (*goztl.Response, error)
+}
+
+var _ goztl.TasksService = &TasksService{}
+
+// GetByID records the call and returns the results of GetByIDFunc.
+func (f *TasksService) GetByID(a0 context.Context, a1 string) (r0 *goztl.TaskResult, r1 *goztl.Response, r2 error) {
+	f.record("GetByID", a1)
+	if f.GetByIDFunc == nil {
+		r2 = errNotStubbed("TasksService", "GetByID")
+		return
+	}
+	return f.GetByIDFunc(a0, a1)
+}
+
+// Wait records the call and returns the results of WaitFunc.
+func (f *TasksService) Wait(a0 context.Context, a1 string, a2 *goztl.TaskPollOptions) (r0 *goztl.TaskResult, r1 *goztl.Response, r2 error) {
+	f.record("Wait", a1, a2)
+	if f.WaitFunc == nil {
+		r2 = errNotStubbed("TasksService", "Wait")
+		return
+	}
+	return f.WaitFunc(a0, a1, a2)
+}
+
+// Download records the call and returns the results of DownloadFunc.
+func (f *TasksService) Download(a0 context.Context, a1 *goztl.TaskResult, a2 io.Writer) (r0 *goztl.Response, r1 error) {
+	f.record("Download", a1, a2)
+	if f.DownloadFunc == nil {
+		r1 = errNotStubbed("TasksService", "Download")
+		return
+	}
+	return f.DownloadFunc(a0, a1, a2)
+}
+
+// TaxonomiesService is a fake goztl.TaxonomiesService.
+type TaxonomiesService struct {
+	Recorder
+	ListFunc      func(context.Context, *goztl.ListOptions) ([]goztl.Taxonomy, *goztl.Response, error)
+	GetByIDFunc   func(context.Context, int) (*goztl.Taxonomy, *goztl.Response, error)
+	GetByNameFunc func(context.Context, string) (*goztl.Taxonomy, *goztl.Response, error)
+	CreateFunc    func(context.Context, *goztl.TaxonomyCreateRequest) (*goztl.Taxonomy, *goztl.Response, error)
+	UpdateFunc    func(context.Context, int, *goztl.TaxonomyUpdateRequest) (*goztl.Taxonomy, *goztl.Response, error)
+	DeleteFunc    func(context.Context, int) (*goztl.Response, error)
+}
+
+var _ goztl.TaxonomiesService = &TaxonomiesService{}
+
+// List records the call and returns the results of ListFunc.
+func (f *TaxonomiesService) List(a0 context.Context, a1 *goztl.ListOptions) (r0 []goztl.Taxonomy, r1 *goztl.Response, r2 error) {
+	f.record("List", a1)
+	if f.ListFunc == nil {
+		r2 = errNotStubbed("TaxonomiesService", "List")
+		return
+	}
+	return f.ListFunc(a0, a1)
+}
+
+// GetByID records the call and returns the results of GetByIDFunc.
+func (f *TaxonomiesService) GetByID(a0 context.Context, a1 int) (r0 *goztl.Taxonomy, r1 *goztl.Response, r2 error) {
+	f.record("GetByID", a1)
+	if f.GetByIDFunc == nil {
+		r2 = errNotStubbed("TaxonomiesService", "GetByID")
+		return
+	}
+	return f.GetByIDFunc(a0, a1)
+}
+
+// GetByName records the call and returns the results of GetByNameFunc.
+func (f *TaxonomiesService) GetByName(a0 context.Context, a1 string) (r0 *goztl.Taxonomy, r1 *goztl.Response, r2 error) {
+	f.record("GetByName", a1)
+	if f.GetByNameFunc == nil {
+		r2 = errNotStubbed("TaxonomiesService", "GetByName")
+		return
+	}
+	return f.GetByNameFunc(a0, a1)
+}
+
+// Create records the call and returns the results of CreateFunc.
+func (f *TaxonomiesService) Create(a0 context.Context, a1 *goztl.TaxonomyCreateRequest) (r0 *goztl.Taxonomy, r1 *goztl.Response, r2 error) {
+	f.record("Create", a1)
+	if f.CreateFunc == nil {
+		r2 = errNotStubbed("TaxonomiesService", "Create")
+		return
+	}
+	return f.CreateFunc(a0, a1)
+}
+
+// Update records the call and returns the results of UpdateFunc.
+func (f *TaxonomiesService) Update(a0 context.Context, a1 int, a2 *goztl.TaxonomyUpdateRequest) (r0 *goztl.Taxonomy, r1 *goztl.Response, r2 error) {
+	f.record("Update", a1, a2)
+	if f.UpdateFunc == nil {
+		r2 = errNotStubbed("TaxonomiesService", "Update")
+		return
+	}
+	return f.UpdateFunc(a0, a1, a2)
+}
+
+// Delete records the call and returns the results of DeleteFunc.
+func (f *TaxonomiesService) Delete(a0 context.Context, a1 int) (r0 *goztl.Response, r1 error) {
+	f.record("Delete", a1)
+	if f.DeleteFunc == nil {
+		r1 = errNotStubbed("TaxonomiesService", "Delete")
+		return
+	}
+	return f.DeleteFunc(a0, a1)
+}
+
+// TurboConfigurationsService is a fake goztl.TurboConfigurationsService.
+type TurboConfigurationsService struct {
+	Recorder
+	ListFunc      func(context.Context, *goztl.ListOptions) ([]goztl.TurboConfiguration, *goztl.Response, error)
+	GetByIDFunc   func(context.Context, string) (*goztl.TurboConfiguration, *goztl.Response, error)
+	GetByNameFunc func(context.Context, string) (*goztl.TurboConfiguration, *goztl.Response, error)
+	CreateFunc    func(context.Context, *goztl.TurboConfigurationRequest) (*goztl.TurboConfiguration, *goztl.Response, error)
+	UpdateFunc    func(context.Context, string, *goztl.TurboConfigurationRequest) (*goztl.TurboConfiguration, *goztl.Response, error)
+	DeleteFunc    func(context.Context, string) (*goztl.Response, error)
+}
+
+var _ goztl.TurboConfigurationsService = &TurboConfigurationsService{}
+
+// List records the call and returns the results of ListFunc.
+func (f *TurboConfigurationsService) List(a0 context.Context, a1 *goztl.ListOptions) (r0 []goztl.TurboConfiguration, r1 *goztl.Response, r2 error) {
+	f.record("List", a1)
+	if f.ListFunc == nil {
+		r2 = errNotStubbed("TurboConfigurationsService", "List")
+		return
+	}
+	return f.ListFunc(a0, a1)
+}
+
+// GetByID records the call and returns the results of GetByIDFunc.
+func (f *TurboConfigurationsService) GetByID(a0 context.Context, a1 string) (r0 *goztl.TurboConfiguration, r1 *goztl.Response, r2 error) {
+	f.record("GetByID", a1)
+	if f.GetByIDFunc == nil {
+		r2 = errNotStubbed("TurboConfigurationsService", "GetByID")
+		return
+	}
+	return f.GetByIDFunc(a0, a1)
+}
+
+// GetByName records the call and returns the results of GetByNameFunc.
+func (f *TurboConfigurationsService) GetByName(a0 context.Context, a1 string) (r0 *goztl.TurboConfiguration, r1 *goztl.Response, r2 error) {
+	f.record("GetByName", a1)
+	if f.GetByNameFunc == nil {
+		r2 = errNotStubbed("TurboConfigurationsService", "GetByName")
+		return
+	}
+	return f.GetByNameFunc(a0, a1)
+}
+
+// Create records the call and returns the results of CreateFunc.
+func (f *TurboConfigurationsService) Create(a0 context.Context, a1 *goztl.TurboConfigurationRequest) (r0 *goztl.TurboConfiguration, r1 *goztl.Response, r2 error) {
+	f.record("Create", a1)
+	if f.CreateFunc == nil {
+		r2 = errNotStubbed("TurboConfigurationsService", "Create")
+		return
+	}
+	return f.CreateFunc(a0, a1)
+}
+
+// Update records the call and returns the results of UpdateFunc.
+func (f *TurboConfigurationsService) Update(a0 context.Context, a1 string, a2 *goztl.TurboConfigurationRequest) (r0 *goztl.TurboConfiguration, r1 *goztl.Response, r2 error) {
+	f.record("Update", a1, a2)
+	if f.UpdateFunc == nil {
+		r2 = errNotStubbed("TurboConfigurationsService", "Update")
+		return
+	}
+	return f.UpdateFunc(a0, a1, a2)
+}
+
+// Delete records the call and returns the results of DeleteFunc.
+func (f *TurboConfigurationsService) Delete(a0 context.Context, a1 string) (r0 *goztl.Response, r1 error) {
+	f.record("Delete", a1)
+	if f.DeleteFunc == nil {
+		r1 = errNotStubbed("TurboConfigurationsService", "Delete")
+		return
+	}
+	return f.DeleteFunc(a0, a1)
+}
+
+// TurboEnrollmentsService is a fake goztl.TurboEnrollmentsService.
+type TurboEnrollmentsService struct {
+	Recorder
+	ListFunc                 func(context.Context, *goztl.ListOptions) ([]goztl.TurboEnrollment, *goztl.Response, error)
+	GetByIDFunc              func(context.Context, int) (*goztl.TurboEnrollment, *goztl.Response, error)
+	GetByConfigurationIDFunc func(context.Context, string) ([]goztl.TurboEnrollment, *goztl.Response, error)
+	CreateFunc               func(context.Context, *goztl.TurboEnrollmentRequest) (*goztl.TurboEnrollment, *goztl.Response, error)
+	UpdateFunc               func(context.Context, int, *goztl.TurboEnrollmentRequest) (*goztl.TurboEnrollment, *goztl.Response, error)
+	DeleteFunc               func(context.Context, int) (*goztl.Response, error)
+}
+
+var _ goztl.TurboEnrollmentsService = &TurboEnrollmentsService{}
+
+// List records the call and returns the results of ListFunc.
+func (f *TurboEnrollmentsService) List(a0 context.Context, a1 *goztl.ListOptions) (r0 []goztl.TurboEnrollment, r1 *goztl.Response, r2 error) {
+	f.record("List", a1)
+	if f.ListFunc == nil {
+		r2 = errNotStubbed("TurboEnrollmentsService", "List")
+		return
+	}
+	return f.ListFunc(a0, a1)
+}
+
+// GetByID records the call and returns the results of GetByIDFunc.
+func (f *TurboEnrollmentsService) GetByID(a0 context.Context, a1 int) (r0 *goztl.TurboEnrollment, r1 *goztl.Response, r2 error) {
+	f.record("GetByID", a1)
+	if f.GetByIDFunc == nil {
+		r2 = errNotStubbed("TurboEnrollmentsService", "GetByID")
+		return
+	}
+	return f.GetByIDFunc(a0, a1)
+}
+
+// GetByConfigurationID records the call and returns the results of GetByConfigurationIDFunc.
+func (f *TurboEnrollmentsService) GetByConfigurationID(a0 context.Context, a1 string) (r0 []goztl.TurboEnrollment, r1 *goztl.Response, r2 error) {
+	f.record("GetByConfigurationID", a1)
+	if f.GetByConfigurationIDFunc == nil {
+		r2 = errNotStubbed("TurboEnrollmentsService", "GetByConfigurationID")
+		return
+	}
+	return f.GetByConfigurationIDFunc(a0, a1)
+}
+
+// Create records the call and returns the results of CreateFunc.
+func (f *TurboEnrollmentsService) Create(a0 context.Context, a1 *goztl.TurboEnrollmentRequest) (r0 *goztl.TurboEnrollment, r1 *goztl.Response, r2 error) {
+	f.record("Create", a1)
+	if f.CreateFunc == nil {
+		r2 = errNotStubbed("TurboEnrollmentsService", "Create")
+		return
+	}
+	return f.CreateFunc(a0, a1)
+}
+
+// Update records the call and returns the results of UpdateFunc.
+func (f *TurboEnrollmentsService) Update(a0 context.Context, a1 int, a2 *goztl.TurboEnrollmentRequest) (r0 *goztl.TurboEnrollment, r1 *goztl.Response, r2 error) {
+	f.record("Update", a1, a2)
+	if f.UpdateFunc == nil {
+		r2 = errNotStubbed("TurboEnrollmentsService", "Update")
+		return
+	}
+	return f.UpdateFunc(a0, a1, a2)
+}
+
+// Delete records the call and returns the results of DeleteFunc.
+func (f *TurboEnrollmentsService) Delete(a0 context.Context, a1 int) (r0 *goztl.Response, r1 error) {
+	f.record("Delete", a1)
+	if f.DeleteFunc == nil {
+		r1 = errNotStubbed("TurboEnrollmentsService", "Delete")
+		return
+	}
+	return f.DeleteFunc(a0, a1)
+}
+
+// TurboMSCPChecksService is a fake goztl.TurboMSCPChecksService.
+type TurboMSCPChecksService struct {
+	Recorder
+	ListFunc        func(context.Context, *goztl.ListOptions) ([]goztl.TurboMSCPCheck, *goztl.Response, error)
+	GetByIDFunc     func(context.Context, string) (*goztl.TurboMSCPCheck, *goztl.Response, error)
+	GetByRuleIDFunc func(context.Context, string) ([]goztl.TurboMSCPCheck, *goztl.Response, error)
+	CreateFunc      func(context.Context, *goztl.TurboMSCPCheckRequest) (*goztl.TurboMSCPCheck, *goztl.Response, error)
+	UpdateFunc      func(context.Context, string, *goztl.TurboMSCPCheckRequest) (*goztl.TurboMSCPCheck, *goztl.Response, error)
+	DeleteFunc      func(context.Context, string) (*goztl.Response, error)
+}
+
+var _ goztl.TurboMSCPChecksService = &TurboMSCPChecksService{}
+
+// List records the call and returns the results of ListFunc.
+func (f *TurboMSCPChecksService) List(a0 context.Context, a1 *goztl.ListOptions) (r0 []goztl.TurboMSCPCheck, r1 *goztl.Response, r2 error) {
+	f.record("List", a1)
+	if f.ListFunc == nil {
+		r2 = errNotStubbed("TurboMSCPChecksService", "List")
+		return
+	}
+	return f.ListFunc(a0, a1)
+}
+
+// GetByID records the call and returns the results of GetByIDFunc.
+func (f *TurboMSCPChecksService) GetByID(a0 context.Context, a1 string) (r0 *goztl.TurboMSCPCheck, r1 *goztl.Response, r2 error) {
+	f.record("GetByID", a1)
+	if f.GetByIDFunc == nil {
+		r2 = errNotStubbed("TurboMSCPChecksService", "GetByID")
+		return
+	}
+	return f.GetByIDFunc(a0, a1)
+}
+
+// GetByRuleID records the call and returns the results of GetByRuleIDFunc.
+func (f *TurboMSCPChecksService) GetByRuleID(a0 context.Context, a1 string) (r0 []goztl.TurboMSCPCheck, r1 *goztl.Response, r2 error) {
+	f.record("GetByRuleID", a1)
+	if f.GetByRuleIDFunc == nil {
+		r2 = errNotStubbed("TurboMSCPChecksService", "GetByRuleID")
+		return
+	}
+	return f.GetByRuleIDFunc(a0, a1)
+}
+
+// Create records the call and returns the results of CreateFunc.
+func (f *TurboMSCPChecksService) Create(a0 context.Context, a1 *goztl.TurboMSCPCheckRequest) (r0 *goztl.TurboMSCPCheck, r1 *goztl.Response, r2 error) {
+	f.record("Create", a1)
+	if f.CreateFunc == nil {
+		r2 = errNotStubbed("TurboMSCPChecksService", "Create")
+		return
+	}
+	return f.CreateFunc(a0, a1)
+}
+
+// Update records the call and returns the results of UpdateFunc.
+func (f *TurboMSCPChecksService) Update(a0 context.Context, a1 string, a2 *goztl.TurboMSCPCheckRequest) (r0 *goztl.TurboMSCPCheck, r1 *goztl.Response, r2 error) {
+	f.record("Update", a1, a2)
+	if f.UpdateFunc == nil {
+		r2 = errNotStubbed("TurboMSCPChecksService", "Update")
+		return
+	}
+	return f.UpdateFunc(a0, a1, a2)
+}
+
+// Delete records the call and returns the results of DeleteFunc.
+func (f *TurboMSCPChecksService) Delete(a0 context.Context, a1 string) (r0 *goztl.Response, r1 error) {
+	f.record("Delete", a1)
+	if f.DeleteFunc == nil {
+		r1 = errNotStubbed("TurboMSCPChecksService", "Delete")
+		return
+	}
+	return f.DeleteFunc(a0, a1)
+}
+
+// TurboOneTimeJobsService is a fake goztl.TurboOneTimeJobsService.
+type TurboOneTimeJobsService struct {
+	Recorder
+	ListFunc    func(context.Context, *goztl.ListOptions) ([]goztl.TurboOneTimeJob, *goztl.Response, error)
+	GetByIDFunc func(context.Context, string) (*goztl.TurboOneTimeJob, *goztl.Response, error)
+	CreateFunc  func(context.Context, *goztl.TurboOneTimeJobRequest) (*goztl.TurboOneTimeJob, *goztl.Response, error)
+	UpdateFunc  func(context.Context, string, *goztl.TurboOneTimeJobRequest) (*goztl.TurboOneTimeJob, *goztl.Response, error)
+	DeleteFunc  func(context.Context, string) (*goztl.Response, error)
+}
+
+var _ goztl.TurboOneTimeJobsService = &TurboOneTimeJobsService{}
+
+// List records the call and returns the results of ListFunc.
+func (f *TurboOneTimeJobsService) List(a0 context.Context, a1 *goztl.ListOptions) (r0 []goztl.TurboOneTimeJob, r1 *goztl.Response, r2 error) {
+	f.record("List", a1)
+	if f.ListFunc == nil {
+		r2 = errNotStubbed("TurboOneTimeJobsService", "List")
+		return
+	}
+	return f.ListFunc(a0, a1)
+}
+
+// GetByID records the call and returns the results of GetByIDFunc.
+func (f *TurboOneTimeJobsService) GetByID(a0 context.Context, a1 string) (r0 *goztl.TurboOneTimeJob, r1 *goztl.Response, r2 error) {
+	f.record("GetByID", a1)
+	if f.GetByIDFunc == nil {
+		r2 = errNotStubbed("TurboOneTimeJobsService", "GetByID")
+		return
+	}
+	return f.GetByIDFunc(a0, a1)
+}
+
+// Create records the call and returns the results of CreateFunc.
+func (f *TurboOneTimeJobsService) Create(a0 context.Context, a1 *goztl.TurboOneTimeJobRequest) (r0 *goztl.TurboOneTimeJob, r1 *goztl.Response, r2 error) {
+	f.record("Create", a1)
+	if f.CreateFunc == nil {
+		r2 = errNotStubbed("TurboOneTimeJobsService", "Create")
+		return
+	}
+	return f.CreateFunc(a0, a1)
+}
+
+// Update records the call and returns the results of UpdateFunc.
+func (f *TurboOneTimeJobsService) Update(a0 context.Context, a1 string, a2 *goztl.TurboOneTimeJobRequest) (r0 *goztl.TurboOneTimeJob, r1 *goztl.Response, r2 error) {
+	f.record("Update", a1, a2)
+	if f.UpdateFunc == nil {
+		r2 = errNotStubbed("TurboOneTimeJobsService", "Update")
+		return
+	}
+	return f.UpdateFunc(a0, a1, a2)
+}
+
+// Delete records the call and returns the results of DeleteFunc.
+func (f *TurboOneTimeJobsService) Delete(a0 context.Context, a1 string) (r0 *goztl.Response, r1 error) {
+	f.record("Delete", a1)
+	if f.DeleteFunc == nil {
+		r1 = errNotStubbed("TurboOneTimeJobsService", "Delete")
+		return
+	}
+	return f.DeleteFunc(a0, a1)
+}
+
+// TurboRecurringJobsService is a fake goztl.TurboRecurringJobsService.
+type TurboRecurringJobsService struct {
+	Recorder
+	ListFunc    func(context.Context, *goztl.ListOptions) ([]goztl.TurboRecurringJob, *goztl.Response, error)
+	GetByIDFunc func(context.Context, string) (*goztl.TurboRecurringJob, *goztl.Response, error)
+	CreateFunc  func(context.Context, *goztl.TurboRecurringJobRequest) (*goztl.TurboRecurringJob, *goztl.Response, error)
+	UpdateFunc  func(context.Context, string, *goztl.TurboRecurringJobRequest) (*goztl.TurboRecurringJob, *goztl.Response, error)
+	DeleteFunc  func(context.Context, string) (*goztl.Response, error)
+}
+
+var _ goztl.TurboRecurringJobsService = &TurboRecurringJobsService{}
+
+// List records the call and returns the results of ListFunc.
+func (f *TurboRecurringJobsService) List(a0 context.Context, a1 *goztl.ListOptions) (r0 []goztl.TurboRecurringJob, r1 *goztl.Response, r2 error) {
+	f.record("List", a1)
+	if f.ListFunc == nil {
+		r2 = errNotStubbed("TurboRecurringJobsService", "List")
+		return
+	}
+	return f.ListFunc(a0, a1)
+}
+
+// GetByID records the call and returns the results of GetByIDFunc.
+func (f *TurboRecurringJobsService) GetByID(a0 context.Context, a1 string) (r0 *goztl.TurboRecurringJob, r1 *goztl.Response, r2 error) {
+	f.record("GetByID", a1)
+	if f.GetByIDFunc == nil {
+		r2 = errNotStubbed("TurboRecurringJobsService", "GetByID")
+		return
+	}
+	return f.GetByIDFunc(a0, a1)
+}
+
+// Create records the call and returns the results of CreateFunc.
+func (f *TurboRecurringJobsService) Create(a0 context.Context, a1 *goztl.TurboRecurringJobRequest) (r0 *goztl.TurboRecurringJob, r1 *goztl.Response, r2 error) {
+	f.record("Create", a1)
+	if f.CreateFunc == nil {
+		r2 = errNotStubbed("TurboRecurringJobsService", "Create")
+		return
+	}
+	return f.CreateFunc(a0, a1)
+}
+
+// Update records the call and returns the results of UpdateFunc.
+func (f *TurboRecurringJobsService) Update(a0 context.Context, a1 string, a2 *goztl.TurboRecurringJobRequest) (r0 *goztl.TurboRecurringJob, r1 *goztl.Response, r2 error) {
+	f.record("Update", a1, a2)
+	if f.UpdateFunc == nil {
+		r2 = errNotStubbed("TurboRecurringJobsService", "Update")
+		return
+	}
+	return f.UpdateFunc(a0, a1, a2)
+}
+
+// Delete records the call and returns the results of DeleteFunc.
+func (f *TurboRecurringJobsService) Delete(a0 context.Context, a1 string) (r0 *goztl.Response, r1 error) {
+	f.record("Delete", a1)
+	if f.DeleteFunc == nil {
+		r1 = errNotStubbed("TurboRecurringJobsService", "Delete")
+		return
+	}
+	return f.DeleteFunc(a0, a1)
+}
+
+// TurboScriptsService is a fake goztl.TurboScriptsService.
+type TurboScriptsService struct {
+	Recorder
+	ListFunc      func(context.Context, *goztl.ListOptions) ([]goztl.TurboScript, *goztl.Response, error)
+	GetByIDFunc   func(context.Context, string) (*goztl.TurboScript, *goztl.Response, error)
+	GetByNameFunc func(context.Context, string) (*goztl.TurboScript, *goztl.Response, error)
+	CreateFunc    func(context.Context, *goztl.TurboScriptRequest) (*goztl.TurboScript, *goztl.Response, error)
+	UpdateFunc    func(context.Context, string, *goztl.TurboScriptRequest) (*goztl.TurboScript, *goztl.Response, error)
+	DeleteFunc    func(context.Context, string) (*goztl.Response, error)
+}
+
+var _ goztl.TurboScriptsService = &TurboScriptsService{}
+
+// List records the call and returns the results of ListFunc.
+func (f *TurboScriptsService) List(a0 context.Context, a1 *goztl.ListOptions) (r0 []goztl.TurboScript, r1 *goztl.Response, r2 error) {
+	f.record("List", a1)
+	if f.ListFunc == nil {
+		r2 = errNotStubbed("TurboScriptsService", "List")
+		return
+	}
+	return f.ListFunc(a0, a1)
+}
+
+// GetByID records the call and returns the results of GetByIDFunc.
+func (f *TurboScriptsService) GetByID(a0 context.Context, a1 string) (r0 *goztl.TurboScript, r1 *goztl.Response, r2 error) {
+	f.record("GetByID", a1)
+	if f.GetByIDFunc == nil {
+		r2 = errNotStubbed("TurboScriptsService", "GetByID")
+		return
+	}
+	return f.GetByIDFunc(a0, a1)
+}
+
+// GetByName records the call and returns the results of GetByNameFunc.
+func (f *TurboScriptsService) GetByName(a0 context.Context, a1 string) (r0 *goztl.TurboScript, r1 *goztl.Response, r2 error) {
+	f.record("GetByName", a1)
+	if f.GetByNameFunc == nil {
+		r2 = errNotStubbed("TurboScriptsService", "GetByName")
+		return
+	}
+	return f.GetByNameFunc(a0, a1)
+}
+
+// Create records the call and returns the results of CreateFunc.
+func (f *TurboScriptsService) Create(a0 context.Context, a1 *goztl.TurboScriptRequest) (r0 *goztl.TurboScript, r1 *goztl.Response, r2 error) {
+	f.record("Create", a1)
+	if f.CreateFunc == nil {
+		r2 = errNotStubbed("TurboScriptsService", "Create")
+		return
+	}
+	return f.CreateFunc(a0, a1)
+}
+
+// Update records the call and returns the results of UpdateFunc.
+func (f *TurboScriptsService) Update(a0 context.Context, a1 string, a2 *goztl.TurboScriptRequest) (r0 *goztl.TurboScript, r1 *goztl.Response, r2 error) {
+	f.record("Update", a1, a2)
+	if f.UpdateFunc == nil {
+		r2 = errNotStubbed("TurboScriptsService", "Update")
+		return
+	}
+	return f.UpdateFunc(a0, a1, a2)
+}
+
+// Delete records the call and returns the results of DeleteFunc.
+func (f *TurboScriptsService) Delete(a0 context.Context, a1 string) (r0 *goztl.Response, r1 error) {
+	f.record("Delete", a1)
+	if f.DeleteFunc == nil {
+		r1 = errNotStubbed("TurboScriptsService", "Delete")
+		return
+	}
+	return f.DeleteFunc(a0, a1)
+}
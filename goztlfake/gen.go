@@ -0,0 +1,16 @@
+//go:build ignore
+
+// gen.go generates the fakes of the goztl services.
+package main
+
+import (
+	"log"
+
+	"github.com/zentralopensource/goztl/internal/fakegen"
+)
+
+func main() {
+	if err := fakegen.WriteFile("..", "fakes.go"); err != nil {
+		log.Fatal(err)
+	}
+}
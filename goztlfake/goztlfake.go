@@ -0,0 +1,82 @@
+// Package goztlfake provides fakes of the goztl services for unit tests.
+//
+// Every fake records its calls, and returns the results of the function field of the called
+// method. A method without function field returns an error.
+//
+//	client, fakes := goztlfake.NewClient()
+//	fakes.Tags.GetByNameFunc = func(ctx context.Context, name string) (*goztl.Tag, *goztl.Response, error) {
+//		return &goztl.Tag{ID: 1, Name: name}, nil, nil
+//	}
+//	// code under test using client
+//	calls := fakes.Tags.CallsTo("GetByName")
+package goztlfake
+
+//go:generate go run gen.go
+
+import (
+	"fmt"
+	"sync"
+)
+
+const fakeBaseURL = "https://zentral.invalid/api/"
+
+// Call is a recorded call of a fake method. The context is not recorded.
+type Call struct {
+	Method string
+	Args   []interface{}
+}
+
+// Recorder records the calls made to a fake. It is safe for concurrent use.
+type Recorder struct {
+	mu    sync.Mutex
+	calls []Call
+}
+
+func (r *Recorder) record(method string, args ...interface{}) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.calls = append(r.calls, Call{Method: method, Args: args})
+}
+
+// Calls returns all the recorded calls, in order.
+func (r *Recorder) Calls() []Call {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	calls := make([]Call, len(r.calls))
+	copy(calls, r.calls)
+	return calls
+}
+
+// CallsTo returns the recorded calls of a method, in order.
+func (r *Recorder) CallsTo(method string) []Call {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	var calls []Call
+	for _, c := range r.calls {
+		if c.Method == method {
+			calls = append(calls, c)
+		}
+	}
+	return calls
+}
+
+// Reset forgets the recorded calls.
+func (r *Recorder) Reset() {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.calls = nil
+}
+
+// NotStubbedError is returned by a fake method without function field.
+type NotStubbedError struct {
+	Service string
+	Method  string
+}
+
+func (e *NotStubbedError) Error() string {
+	return fmt.Sprintf("%s.%s is not stubbed", e.Service, e.Method)
+}
+
+func errNotStubbed(service, method string) error {
+	return &NotStubbedError{Service: service, Method: method}
+}
@@ -0,0 +1,60 @@
+package goztlfake
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/zentralopensource/goztl"
+)
+
+func TestNewClient(t *testing.T) {
+	client, fakes := NewClient()
+
+	fakes.Tags.GetByNameFunc = func(ctx context.Context, name string) (*goztl.Tag, *goztl.Response, error) {
+		return &goztl.Tag{ID: 1, Name: name}, nil, nil
+	}
+
+	ctx := context.Background()
+	got, _, err := client.Tags.GetByName(ctx, "yolo")
+	if err != nil {
+		t.Fatalf("Tags.GetByName returned error: %v", err)
+	}
+
+	want := &goztl.Tag{ID: 1, Name: "yolo"}
+	if !cmp.Equal(got, want) {
+		t.Errorf("Tags.GetByName returned %+v, want %+v", got, want)
+	}
+
+	calls := fakes.Tags.CallsTo("GetByName")
+	wantCalls := []Call{{Method: "GetByName", Args: []interface{}{"yolo"}}}
+	if !cmp.Equal(calls, wantCalls) {
+		t.Errorf("Tags.CallsTo returned %+v, want %+v", calls, wantCalls)
+	}
+}
+
+func TestNotStubbed(t *testing.T) {
+	client, fakes := NewClient()
+
+	ctx := context.Background()
+	_, err := client.SantaRules.Delete(ctx, 1)
+
+	var nse *NotStubbedError
+	if !errors.As(err, &nse) {
+		t.Fatalf("SantaRules.Delete returned error %v, want NotStubbedError", err)
+	}
+	if got := nse.Error(); got != "SantaRulesService.Delete is not stubbed" {
+		t.Errorf("NotStubbedError.Error returned %q", got)
+	}
+
+	want := []Call{{Method: "Delete", Args: []interface{}{1}}}
+	if got := fakes.SantaRules.Calls(); !cmp.Equal(got, want) {
+		t.Errorf("SantaRules.Calls returned %+v, want %+v", got, want)
+	}
+
+	fakes.SantaRules.Reset()
+	if got := fakes.SantaRules.Calls(); len(got) != 0 {
+		t.Errorf("SantaRules.Calls returned %+v after Reset", got)
+	}
+}
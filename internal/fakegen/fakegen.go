@@ -0,0 +1,328 @@
+// Package fakegen generates the fakes of the goztlfake package from the service interfaces of the
+// goztl package.
+package fakegen
+
+import (
+	"bytes"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"text/template"
+)
+
+type param struct {
+	Name string
+	Type string
+}
+
+type method struct {
+	Name    string
+	Params  []param
+	Results []param
+}
+
+// Signature returns the function type of the method.
+func (m method) Signature() string {
+	var ps, rs []string
+	for _, p := range m.Params {
+		ps = append(ps, p.Type)
+	}
+	for _, r := range m.Results {
+		rs = append(rs, r.Type)
+	}
+	return fmt.Sprintf("func(%s) (%s)", strings.Join(ps, ", "), strings.Join(rs, ", "))
+}
+
+// RecordedArgs returns the arguments recorded for a call, the context excluded.
+func (m method) RecordedArgs() string {
+	var args []string
+	for _, p := range m.Params {
+		if p.Type != "context.Context" {
+			args = append(args, p.Name)
+		}
+	}
+	return strings.Join(args, ", ")
+}
+
+// ErrResult returns the name of the error result of the method, if any.
+func (m method) ErrResult() string {
+	for _, r := range m.Results {
+		if r.Type == "error" {
+			return r.Name
+		}
+	}
+	return ""
+}
+
+type service struct {
+	Name    string
+	Methods []method
+}
+
+type clientField struct {
+	Name    string
+	Service string
+}
+
+// Generate parses the non test files of the goztl package in dir and returns the source of the
+// goztlfake fakes.
+func Generate(dir string) ([]byte, error) {
+	fset := token.NewFileSet()
+	paths, err := filepath.Glob(filepath.Join(dir, "*.go"))
+	if err != nil {
+		return nil, err
+	}
+	var files []*ast.File
+	for _, path := range paths {
+		if strings.HasSuffix(path, "_test.go") {
+			continue
+		}
+		f, err := parser.ParseFile(fset, path, nil, 0)
+		if err != nil {
+			return nil, err
+		}
+		if f.Name.Name != "goztl" {
+			continue
+		}
+		files = append(files, f)
+	}
+
+	var services []service
+	var fields []clientField
+	importPaths := make(map[string]string)
+	for _, f := range files {
+		for _, imp := range f.Imports {
+			path, err := strconv.Unquote(imp.Path.Value)
+			if err != nil {
+				return nil, err
+			}
+			name := path[strings.LastIndex(path, "/")+1:]
+			if imp.Name != nil {
+				name = imp.Name.Name
+			}
+			importPaths[name] = path
+		}
+		for _, decl := range f.Decls {
+			gd, ok := decl.(*ast.GenDecl)
+			if !ok || gd.Tok != token.TYPE {
+				continue
+			}
+			for _, spec := range gd.Specs {
+				ts := spec.(*ast.TypeSpec)
+				switch t := ts.Type.(type) {
+				case *ast.InterfaceType:
+					if strings.HasSuffix(ts.Name.Name, "Service") {
+						services = append(services, parseService(ts.Name.Name, t))
+					}
+				case *ast.StructType:
+					if ts.Name.Name == "Client" {
+						fields = parseClientFields(t)
+					}
+				}
+			}
+		}
+	}
+	if len(services) == 0 {
+		return nil, fmt.Errorf("no service interfaces found in %s", dir)
+	}
+	sort.Slice(services, func(i, j int) bool { return services[i].Name < services[j].Name })
+	imports, err := serviceImports(services, importPaths)
+	if err != nil {
+		return nil, err
+	}
+
+	var buf bytes.Buffer
+	err = tmpl.Execute(&buf, struct {
+		Imports  []string
+		Services []service
+		Fields   []clientField
+	}{imports, services, fields})
+	if err != nil {
+		return nil, err
+	}
+	return format.Source(buf.Bytes())
+}
+
+// qualifierRe matches the package qualifiers of the rendered types.
+var qualifierRe = regexp.MustCompile(`\b([a-z]\w*)\.`)
+
+// serviceImports returns the paths of the packages used by the methods of the services, other
+// than context and goztl.
+func serviceImports(services []service, importPaths map[string]string) ([]string, error) {
+	seen := make(map[string]bool)
+	var imports []string
+	for _, s := range services {
+		for _, m := range s.Methods {
+			for _, p := range append(append([]param{}, m.Params...), m.Results...) {
+				for _, match := range qualifierRe.FindAllStringSubmatch(p.Type, -1) {
+					name := match[1]
+					if name == "context" || name == "goztl" || seen[name] {
+						continue
+					}
+					path, ok := importPaths[name]
+					if !ok {
+						return nil, fmt.Errorf("unknown package %q in %s.%s", name, s.Name, m.Name)
+					}
+					seen[name] = true
+					imports = append(imports, path)
+				}
+			}
+		}
+	}
+	sort.Strings(imports)
+	return imports, nil
+}
+
+func parseService(name string, it *ast.InterfaceType) service {
+	s := service{Name: name}
+	for _, m := range it.Methods.List {
+		ft, ok := m.Type.(*ast.FuncType)
+		if !ok || len(m.Names) == 0 {
+			continue
+		}
+		meth := method{Name: m.Names[0].Name}
+		for _, p := range flatten(ft.Params) {
+			meth.Params = append(meth.Params, param{Name: fmt.Sprintf("a%d", len(meth.Params)), Type: p})
+		}
+		if ft.Results != nil {
+			for _, r := range flatten(ft.Results) {
+				meth.Results = append(meth.Results, param{Name: fmt.Sprintf("r%d", len(meth.Results)), Type: r})
+			}
+		}
+		s.Methods = append(s.Methods, meth)
+	}
+	return s
+}
+
+func parseClientFields(st *ast.StructType) []clientField {
+	var fields []clientField
+	for _, f := range st.Fields.List {
+		id, ok := f.Type.(*ast.Ident)
+		if !ok || !strings.HasSuffix(id.Name, "Service") {
+			continue
+		}
+		for _, n := range f.Names {
+			fields = append(fields, clientField{Name: n.Name, Service: id.Name})
+		}
+	}
+	return fields
+}
+
+// flatten returns one qualified type per parameter.
+func flatten(fl *ast.FieldList) []string {
+	var types []string
+	for _, f := range fl.List {
+		t := typeString(f.Type)
+		n := len(f.Names)
+		if n == 0 {
+			n = 1
+		}
+		for i := 0; i < n; i++ {
+			types = append(types, t)
+		}
+	}
+	return types
+}
+
+// typeString renders a type expression, with the goztl types qualified.
+func typeString(expr ast.Expr) string {
+	switch t := expr.(type) {
+	case *ast.Ident:
+		if ast.IsExported(t.Name) {
+			return "goztl." + t.Name
+		}
+		return t.Name
+	case *ast.SelectorExpr:
+		return typeString(t.X) + "." + t.Sel.Name
+	case *ast.StarExpr:
+		return "*" + typeString(t.X)
+	case *ast.ArrayType:
+		return "[]" + typeString(t.Elt)
+	case *ast.MapType:
+		return "map[" + typeString(t.Key) + "]" + typeString(t.Value)
+	case *ast.InterfaceType:
+		return "interface{}"
+	case *ast.Ellipsis:
+		return "..." + typeString(t.Elt)
+	}
+	panic(fmt.Sprintf("unsupported type expression %T", expr))
+}
+
+// WriteFile generates the fakes and writes them to path.
+func WriteFile(dir, path string) error {
+	src, err := Generate(dir)
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(path, src, 0644)
+}
+
+var tmpl = template.Must(template.New("fakes").Parse(`// Code generated by go generate; DO NOT EDIT.
+
+package goztlfake
+
+import (
+	"context"
+{{- range .Imports}}
+	"{{.}}"
+{{- end}}
+
+	"github.com/zentralopensource/goztl"
+)
+
+// Fakes holds a fake for every service of a goztl.Client.
+type Fakes struct {
+{{- range .Fields}}
+	{{.Name}} *{{.Service}}
+{{- end}}
+}
+
+// NewClient returns a goztl.Client with all its services replaced by fakes, and the fakes.
+func NewClient() (*goztl.Client, *Fakes) {
+	c, err := goztl.NewClient(nil, fakeBaseURL, "")
+	if err != nil {
+		panic(err)
+	}
+	f := &Fakes{
+{{- range .Fields}}
+		{{.Name}}: &{{.Service}}{},
+{{- end}}
+	}
+{{- range .Fields}}
+	c.{{.Name}} = f.{{.Name}}
+{{- end}}
+	return c, f
+}
+{{range $s := .Services}}
+// {{$s.Name}} is a fake goztl.{{$s.Name}}.
+type {{$s.Name}} struct {
+	Recorder
+{{- range $s.Methods}}
+	{{.Name}}Func {{.Signature}}
+{{- end}}
+}
+
+var _ goztl.{{$s.Name}} = &{{$s.Name}}{}
+{{range $m := $s.Methods}}
+// {{$m.Name}} records the call and returns the results of {{$m.Name}}Func.
+func (f *{{$s.Name}}) {{$m.Name}}({{range $i, $p := $m.Params}}{{if $i}}, {{end}}{{$p.Name}} {{$p.Type}}{{end}}) ({{range $i, $r := $m.Results}}{{if $i}}, {{end}}{{$r.Name}} {{$r.Type}}{{end}}) {
+	f.record("{{$m.Name}}"{{with $m.RecordedArgs}}, {{.}}{{end}})
+	if f.{{$m.Name}}Func == nil {
+		{{- with $m.ErrResult}}
+		{{.}} = errNotStubbed("{{$s.Name}}", "{{$m.Name}}")
+		{{- end}}
+		return
+	}
+	return f.{{$m.Name}}Func({{range $i, $p := $m.Params}}{{if $i}}, {{end}}{{$p.Name}}{{end}})
+}
+{{end}}
+{{- end}}
+`))
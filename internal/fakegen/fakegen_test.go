@@ -0,0 +1,23 @@
+package fakegen
+
+import (
+	"bytes"
+	"os"
+	"testing"
+)
+
+func TestGeneratedFakesUpToDate(t *testing.T) {
+	got, err := Generate("../..")
+	if err != nil {
+		t.Fatalf("Generate returned error: %v", err)
+	}
+
+	want, err := os.ReadFile("../../goztlfake/fakes.go")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !bytes.Equal(got, want) {
+		t.Error("goztlfake/fakes.go is out of date, run go generate ./goztlfake")
+	}
+}
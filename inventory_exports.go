@@ -0,0 +1,147 @@
+package goztl
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+)
+
+// InventoryExportKind is the kind of the rows of an inventory export
+type InventoryExportKind string
+
+// Inventory export kinds
+const (
+	InventoryExportMachines        InventoryExportKind = "snapshots"
+	InventoryExportMacOSApps       InventoryExportKind = "macos_app_instances"
+	InventoryExportAndroidApps     InventoryExportKind = "android_apps"
+	InventoryExportIOSApps         InventoryExportKind = "ios_apps"
+	InventoryExportWindowsPrograms InventoryExportKind = "program_instances"
+	InventoryExportDebPackages     InventoryExportKind = "deb_packages"
+	InventoryExportRPMPackages     InventoryExportKind = "rpm_packages"
+)
+
+var inventoryExportKinds = []InventoryExportKind{
+	InventoryExportMachines,
+	InventoryExportMacOSApps,
+	InventoryExportAndroidApps,
+	InventoryExportIOSApps,
+	InventoryExportWindowsPrograms,
+	InventoryExportDebPackages,
+	InventoryExportRPMPackages,
+}
+
+// Inventory export formats
+const (
+	InventoryExportCSV  = "csv"
+	InventoryExportXLSX = "xlsx"
+	InventoryExportZIP  = "zip"
+)
+
+// InventoryExportOptions specifies the format and the filters of an inventory export
+type InventoryExportOptions struct {
+	// Format of the file, the default format of the endpoint if empty
+	Format string `url:"export_format,omitempty"`
+
+	// Name of the inventory source
+	Source string `url:"source_name,omitempty"`
+
+	MetaBusinessUnitID int `url:"meta_business_unit_id,omitempty"`
+
+	// Polling of the export task, for DownloadExport
+	Poll *TaskPollOptions `url:"-"`
+}
+
+// InventoryExportFile describes the file of an inventory export
+type InventoryExportFile struct {
+	Filename    string
+	ContentType string
+	Size        int64
+}
+
+// Export starts an inventory export. The export runs in a background task, that can be waited for
+// with the Tasks service.
+func (s *InventoryMachinesServiceOp) Export(ctx context.Context, kind InventoryExportKind, opt *InventoryExportOptions) (*Task, *Response, error) {
+	known := false
+	for _, k := range inventoryExportKinds {
+		known = known || k == kind
+	}
+	if !known {
+		return nil, nil, NewArgError("kind", fmt.Sprintf("unknown export kind %q", kind))
+	}
+	if opt != nil {
+		switch opt.Format {
+		case "", InventoryExportCSV, InventoryExportXLSX, InventoryExportZIP:
+		default:
+			return nil, nil, NewArgError("Format", fmt.Sprintf("unknown export format %q", opt.Format))
+		}
+	}
+
+	path, err := addOptions(fmt.Sprintf("%sexport_%s/", imBasePath, kind), opt)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest(ctx, http.MethodPost, path, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	task := new(Task)
+	resp, err := s.client.Do(ctx, req, task)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return task, resp, err
+}
+
+// DownloadExport runs an inventory export, waits for its task with the Tasks service, and writes
+// the exported file to w.
+func (s *InventoryMachinesServiceOp) DownloadExport(ctx context.Context, kind InventoryExportKind, opt *InventoryExportOptions, w io.Writer) (*InventoryExportFile, *Response, error) {
+	if w == nil {
+		return nil, nil, NewArgError("w", "cannot be nil")
+	}
+
+	task, resp, err := s.Export(ctx, kind, opt)
+	if err != nil {
+		return nil, resp, err
+	}
+	var poll *TaskPollOptions
+	if opt != nil {
+		poll = opt.Poll
+	}
+	tr, resp, err := s.client.Tasks.Wait(ctx, task.ID, poll)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	cw := &countingWriter{w: w}
+	resp, err = s.client.Tasks.Download(ctx, tr, cw)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	file := &InventoryExportFile{Size: cw.n}
+	if resp != nil {
+		file.ContentType = resp.Header.Get("Content-Type")
+		if _, params, err := mime.ParseMediaType(resp.Header.Get("Content-Disposition")); err == nil {
+			file.Filename = params["filename"]
+		}
+	}
+
+	return file, resp, nil
+}
+
+// countingWriter counts the bytes written to a writer.
+type countingWriter struct {
+	w io.Writer
+	n int64
+}
+
+func (cw *countingWriter) Write(p []byte) (int, error) {
+	n, err := cw.w.Write(p)
+	cw.n += int64(n)
+	return n, err
+}
@@ -0,0 +1,62 @@
+package goztl
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestInventoryMachinesService_DownloadExport(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/inventory/machines/export_macos_app_instances/", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testQueryArg(t, r, "export_format", "csv")
+		testQueryArg(t, r, "source_name", "osquery")
+		testQueryArg(t, r, "meta_business_unit_id", "2")
+		fmt.Fprint(w, `{"task_id": "a7f0d2d0", "task_result_url": "/api/task_result/a7f0d2d0/"}`)
+	})
+	mux.HandleFunc("/task_result/a7f0d2d0/", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"name": "export", "id": "a7f0d2d0", "status": "SUCCESS", "unready": false,
+		                "download_url": "/task_result/a7f0d2d0/download/"}`)
+	})
+	mux.HandleFunc("/task_result/a7f0d2d0/download/", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/csv")
+		w.Header().Set("Content-Disposition", `attachment; filename="macos_apps.csv"`)
+		fmt.Fprint(w, "name,version\nYolo.app,1.0\n")
+	})
+
+	ctx := context.Background()
+	var buf bytes.Buffer
+	opt := &InventoryExportOptions{
+		Format:             InventoryExportCSV,
+		Source:             "osquery",
+		MetaBusinessUnitID: 2,
+		Poll:               &TaskPollOptions{MinInterval: time.Millisecond},
+	}
+	got, _, err := client.InventoryMachines.DownloadExport(ctx, InventoryExportMacOSApps, opt, &buf)
+	if err != nil {
+		t.Fatalf("InventoryMachines.DownloadExport returned error: %v", err)
+	}
+
+	want := &InventoryExportFile{Filename: "macos_apps.csv", ContentType: "text/csv", Size: 26}
+	if !cmp.Equal(got, want) {
+		t.Errorf("InventoryMachines.DownloadExport returned %+v, want %+v", got, want)
+	}
+	if buf.String() != "name,version\nYolo.app,1.0\n" {
+		t.Errorf("InventoryMachines.DownloadExport wrote %q", buf.String())
+	}
+
+	if _, _, err := client.InventoryMachines.Export(ctx, "yolo", nil); err == nil {
+		t.Error("InventoryMachines.Export returned no error for an unknown kind")
+	}
+	if _, _, err := client.InventoryMachines.Export(ctx, InventoryExportMachines, &InventoryExportOptions{Format: "pdf"}); err == nil {
+		t.Error("InventoryMachines.Export returned no error for an unknown format")
+	}
+}
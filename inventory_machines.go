@@ -0,0 +1,316 @@
+package goztl
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/url"
+	"time"
+)
+
+const (
+	imBasePath           = "inventory/machines/"
+	imArchiveBasePath    = imBasePath + "archive/"
+	imUnarchiveBasePath  = imBasePath + "unarchive/"
+	inventoryCleanupPath = "inventory/cleanup/"
+)
+
+// InventoryMachinesService is an interface for interfacing with the inventory machines
+// endpoints of the Zentral API
+type InventoryMachinesService interface {
+	List(context.Context, *ListOptions) ([]InventoryMachine, *Response, error)
+	Search(context.Context, *InventoryMachineFilter, *ListOptions) ([]InventoryMachine, *Response, error)
+	GetBySerialNumber(context.Context, string) (*InventoryMachine, *Response, error)
+	UpdateTags(context.Context, *InventoryMachineTagsRequest) (*InventoryMachineTagsResult, *Response, error)
+	Archive(context.Context, []string) (*InventoryMachinesArchiveResult, *Response, error)
+	Unarchive(context.Context, []string) (*InventoryMachinesArchiveResult, *Response, error)
+	ArchiveDryRun(context.Context, time.Time) (int, *Response, error)
+	Cleanup(context.Context, int) (*Task, *Response, error)
+	Export(context.Context, InventoryExportKind, *InventoryExportOptions) (*Task, *Response, error)
+	DownloadExport(context.Context, InventoryExportKind, *InventoryExportOptions, io.Writer) (*InventoryExportFile, *Response, error)
+}
+
+// InventoryMachinesServiceOp handles communication with the inventory machines related
+// methods of the Zentral API.
+type InventoryMachinesServiceOp struct {
+	client *Client
+}
+
+var _ InventoryMachinesService = &InventoryMachinesServiceOp{}
+
+// InventoryMachine represents a machine of the Zentral inventory
+type InventoryMachine struct {
+	SerialNumber      string                             `json:"serial_number"`
+	Platform          string                             `json:"platform"`
+	Type              string                             `json:"type"`
+	LastSeen          *Timestamp                         `json:"last_seen"`
+	MetaBusinessUnits []InventoryMachineMetaBusinessUnit `json:"meta_business_units"`
+	Tags              []InventoryMachineTag              `json:"tags"`
+
+	// Current snapshot of each source, only returned by GetBySerialNumber
+	Snapshots []InventoryMachineSnapshot `json:"snapshots,omitempty"`
+}
+
+func (im InventoryMachine) String() string {
+	return Stringify(im)
+}
+
+// InventoryMachineMetaBusinessUnit represents a meta business unit of a machine
+type InventoryMachineMetaBusinessUnit struct {
+	ID   int    `json:"id"`
+	Name string `json:"name"`
+}
+
+// InventoryMachineTag represents a tag of a machine
+type InventoryMachineTag struct {
+	ID                 int    `json:"id"`
+	TaxonomyID         *int   `json:"taxonomy"`
+	MetaBusinessUnitID *int   `json:"meta_business_unit"`
+	Name               string `json:"name"`
+}
+
+// InventoryMachineSnapshot represents the current inventory of a machine for a source
+type InventoryMachineSnapshot struct {
+	Source            InventoryMachineSource             `json:"source"`
+	Reference         string                             `json:"reference"`
+	Platform          string                             `json:"platform"`
+	Type              string                             `json:"type"`
+	OSVersion         *InventoryMachineOSVersion         `json:"os_version"`
+	SystemInfo        *InventoryMachineSystemInfo        `json:"system_info"`
+	NetworkInterfaces []InventoryMachineNetworkInterface `json:"network_interfaces"`
+	PrincipalUser     *InventoryMachinePrincipalUser     `json:"principal_user"`
+	BusinessUnit      *InventoryMachineBusinessUnit      `json:"business_unit"`
+	PublicIPAddress   *string                            `json:"public_ip_address"`
+	LastSeen          *Timestamp                         `json:"last_seen"`
+}
+
+// InventoryMachineSource represents the source of a machine snapshot
+type InventoryMachineSource struct {
+	ID     int    `json:"id"`
+	Module string `json:"module"`
+	Name   string `json:"name"`
+}
+
+// InventoryMachineOSVersion represents the operating system of a machine snapshot
+type InventoryMachineOSVersion struct {
+	Name    string `json:"name"`
+	Major   *int   `json:"major"`
+	Minor   *int   `json:"minor"`
+	Patch   *int   `json:"patch"`
+	Build   string `json:"build"`
+	Version string `json:"version"`
+}
+
+// InventoryMachineSystemInfo represents the hardware and host information of a machine snapshot
+type InventoryMachineSystemInfo struct {
+	ComputerName     string `json:"computer_name"`
+	Hostname         string `json:"hostname"`
+	HardwareModel    string `json:"hardware_model"`
+	HardwareSerial   string `json:"hardware_serial"`
+	CPUType          string `json:"cpu_type"`
+	CPUBrand         string `json:"cpu_brand"`
+	CPUPhysicalCores *int   `json:"cpu_physical_cores"`
+	PhysicalMemory   *int64 `json:"physical_memory"`
+}
+
+// InventoryMachineNetworkInterface represents a network interface of a machine snapshot
+type InventoryMachineNetworkInterface struct {
+	Interface string `json:"interface"`
+	MAC       string `json:"mac"`
+	Address   string `json:"address"`
+	Mask      string `json:"mask"`
+	Broadcast string `json:"broadcast"`
+}
+
+// InventoryMachinePrincipalUser represents the principal user of a machine snapshot
+type InventoryMachinePrincipalUser struct {
+	Source        InventoryMachinePrincipalUserSource `json:"source"`
+	UniqueID      string                              `json:"unique_id"`
+	PrincipalName string                              `json:"principal_name"`
+	DisplayName   string                              `json:"display_name"`
+}
+
+// InventoryMachinePrincipalUserSource represents how the principal user of a machine was found
+type InventoryMachinePrincipalUserSource struct {
+	Type       string                 `json:"type"`
+	Properties map[string]interface{} `json:"properties"`
+}
+
+// InventoryMachineBusinessUnit represents the business unit of a machine snapshot
+type InventoryMachineBusinessUnit struct {
+	ReferenceID        string `json:"reference_id"`
+	Key                string `json:"key"`
+	Name               string `json:"name"`
+	MetaBusinessUnitID *int   `json:"meta_business_unit"`
+}
+
+// InventoryMachineFilter filters the machines of a search. The zero values are ignored.
+type InventoryMachineFilter struct {
+	// Free text search on the serial numbers, the computer names and the principal users
+	Query string `url:"q,omitempty"`
+
+	SerialNumber       string `url:"serial_number,omitempty"`
+	MetaBusinessUnitID int    `url:"meta_business_unit_id,omitempty"`
+	TagID              int    `url:"tag_id,omitempty"`
+	Platform           string `url:"platform,omitempty"`
+	Type               string `url:"type,omitempty"`
+	SourceID           int    `url:"source_id,omitempty"`
+
+	// Only the machines seen since this time
+	LastSeenSince time.Time `url:"last_seen_since,omitempty"`
+
+	// Only the machines not seen since this time
+	LastSeenBefore time.Time `url:"last_seen_before,omitempty"`
+}
+
+// InventoryMachinesArchiveResult represents the result of the archiving or unarchiving of machines
+type InventoryMachinesArchiveResult struct {
+	// Number of archived or unarchived machines
+	Machines int `json:"machines"`
+}
+
+type machinesArchiveRequest struct {
+	SerialNumbers []string `json:"serial_numbers"`
+}
+
+type inventoryCleanupRequest struct {
+	Days int `json:"days"`
+}
+
+// List lists all the machines.
+func (s *InventoryMachinesServiceOp) List(ctx context.Context, opt *ListOptions) ([]InventoryMachine, *Response, error) {
+	return s.list(ctx, opt, nil)
+}
+
+// Search lists the machines matching a filter.
+func (s *InventoryMachinesServiceOp) Search(ctx context.Context, filter *InventoryMachineFilter, opt *ListOptions) ([]InventoryMachine, *Response, error) {
+	if filter == nil {
+		return nil, nil, NewArgError("filter", "cannot be nil")
+	}
+	return s.list(ctx, opt, filter)
+}
+
+// GetBySerialNumber retrieves a machine, with its current snapshots, by serial number.
+func (s *InventoryMachinesServiceOp) GetBySerialNumber(ctx context.Context, serialNumber string) (*InventoryMachine, *Response, error) {
+	if len(serialNumber) < 1 {
+		return nil, nil, NewArgError("serialNumber", "cannot be blank")
+	}
+
+	path := imBasePath + url.PathEscape(serialNumber) + "/"
+
+	req, err := s.client.NewRequest(ctx, http.MethodGet, path, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	im := new(InventoryMachine)
+
+	resp, err := s.client.Do(ctx, req, im)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return im, resp, err
+}
+
+// Archive archives machines by serial number. The archived machines are hidden from the inventory
+// until they are seen again or unarchived.
+func (s *InventoryMachinesServiceOp) Archive(ctx context.Context, serialNumbers []string) (*InventoryMachinesArchiveResult, *Response, error) {
+	return s.archive(ctx, imArchiveBasePath, serialNumbers)
+}
+
+// Unarchive unarchives machines by serial number.
+func (s *InventoryMachinesServiceOp) Unarchive(ctx context.Context, serialNumbers []string) (*InventoryMachinesArchiveResult, *Response, error) {
+	return s.archive(ctx, imUnarchiveBasePath, serialNumbers)
+}
+
+// ArchiveDryRun returns the number of machines not seen since lastSeenBefore, that an archiving of
+// the stale machines would archive. Nothing is archived.
+func (s *InventoryMachinesServiceOp) ArchiveDryRun(ctx context.Context, lastSeenBefore time.Time) (int, *Response, error) {
+	if lastSeenBefore.IsZero() {
+		return 0, nil, NewArgError("lastSeenBefore", "cannot be zero")
+	}
+
+	// only the count of the first page is needed
+	path, err := addOptions(imBasePath, &ListOptions{Limit: 1})
+	if err != nil {
+		return 0, nil, err
+	}
+	path, err = addOptions(path, &InventoryMachineFilter{LastSeenBefore: lastSeenBefore})
+	if err != nil {
+		return 0, nil, err
+	}
+
+	req, err := s.client.NewRequest(ctx, http.MethodGet, path, nil)
+	if err != nil {
+		return 0, nil, err
+	}
+
+	page := new(maybePaginatedResults[InventoryMachine])
+	resp, err := s.client.Do(ctx, req, page)
+	if err != nil {
+		return 0, resp, err
+	}
+
+	return page.Count, resp, err
+}
+
+// Cleanup starts the cleanup of the inventory history older than the retention window, in days.
+// The cleanup runs in a background task, that can be waited for with the Tasks service.
+func (s *InventoryMachinesServiceOp) Cleanup(ctx context.Context, days int) (*Task, *Response, error) {
+	if days < 1 {
+		return nil, nil, NewArgError("days", "cannot be less than 1")
+	}
+
+	req, err := s.client.NewRequest(ctx, http.MethodPost, inventoryCleanupPath, &inventoryCleanupRequest{Days: days})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	task := new(Task)
+	resp, err := s.client.Do(ctx, req, task)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return task, resp, err
+}
+
+// Helper method for archiving and unarchiving machines
+func (s *InventoryMachinesServiceOp) archive(ctx context.Context, path string, serialNumbers []string) (*InventoryMachinesArchiveResult, *Response, error) {
+	if len(serialNumbers) == 0 {
+		return nil, nil, NewArgError("serialNumbers", "cannot be empty")
+	}
+	for _, sn := range serialNumbers {
+		if len(sn) < 1 {
+			return nil, nil, NewArgError("serialNumbers", "cannot contain blank serial numbers")
+		}
+	}
+
+	req, err := s.client.NewRequest(ctx, http.MethodPost, path, &machinesArchiveRequest{SerialNumbers: serialNumbers})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	result := new(InventoryMachinesArchiveResult)
+	resp, err := s.client.Do(ctx, req, result)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return result, resp, err
+}
+
+// Helper method for listing machines
+func (s *InventoryMachinesServiceOp) list(ctx context.Context, opt *ListOptions, filter *InventoryMachineFilter) ([]InventoryMachine, *Response, error) {
+	path := imBasePath
+	path, err := addOptions(path, opt)
+	if err != nil {
+		return nil, nil, err
+	}
+	path, err = addOptions(path, filter)
+	if err != nil {
+		return nil, nil, err
+	}
+	return resolveAllPages[InventoryMachine](ctx, s.client, path)
+}
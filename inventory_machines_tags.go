@@ -0,0 +1,294 @@
+package goztl
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+)
+
+const imTagsBasePath = imBasePath + "tags/"
+
+// Kinds of the machine tag operations
+const (
+	// Add the tags to the machines
+	TagOperationAdd = "ADD"
+
+	// Remove the tags from the machines
+	TagOperationRemove = "REMOVE"
+
+	// Replace the tags of the machines having the same taxonomies as the tags
+	TagOperationSet = "SET"
+)
+
+// InventoryMachineTagRef references a tag by ID, or by taxonomy and name.
+type InventoryMachineTagRef struct {
+	ID int
+
+	// Name of the taxonomy. The tag names are unique, so an empty taxonomy matches the tag of any
+	// taxonomy, and the tags created without taxonomy.
+	Taxonomy string
+
+	Name string
+}
+
+// InventoryMachineTagOperation represents an operation on the tags of machines
+type InventoryMachineTagOperation struct {
+	Kind string
+	Tags []InventoryMachineTagRef
+}
+
+// InventoryMachineTagsRequest represents a request to update the tags of machines, selected by
+// serial number or by principal user.
+type InventoryMachineTagsRequest struct {
+	SerialNumbers               []string
+	PrincipalUserUniqueIDs      []string
+	PrincipalUserPrincipalNames []string
+
+	Operations []InventoryMachineTagOperation
+
+	// Create the missing tags and taxonomies of the ADD and SET operations with the Tags and
+	// Taxonomies services, instead of returning an error.
+	AutoCreate bool
+}
+
+// InventoryMachineTagsResult represents the result of an update of the tags of machines
+type InventoryMachineTagsResult struct {
+	Machines struct {
+		Found int `json:"found"`
+	} `json:"machines"`
+	Tags struct {
+		Added   int `json:"added"`
+		Removed int `json:"removed"`
+	} `json:"tags"`
+}
+
+type machineTagsRequest struct {
+	PrincipalUsers *machineTagsPrincipalUsers `json:"principal_users,omitempty"`
+	SerialNumbers  []string                   `json:"serial_numbers,omitempty"`
+	Operations     []machineTagsOperation     `json:"operations"`
+}
+
+type machineTagsPrincipalUsers struct {
+	UniqueIDs      []string `json:"unique_ids,omitempty"`
+	PrincipalNames []string `json:"principal_names,omitempty"`
+}
+
+type machineTagsOperation struct {
+	Kind     string   `json:"kind"`
+	Taxonomy *string  `json:"taxonomy"`
+	Names    []string `json:"names"`
+}
+
+// UpdateTags adds, removes or replaces the tags of machines. The tag references are resolved to
+// the taxonomy and name of the tags with the Tags and Taxonomies services.
+func (s *InventoryMachinesServiceOp) UpdateTags(ctx context.Context, tagsRequest *InventoryMachineTagsRequest) (*InventoryMachineTagsResult, *Response, error) {
+	if tagsRequest == nil {
+		return nil, nil, NewArgError("tagsRequest", "cannot be nil")
+	}
+	if len(tagsRequest.SerialNumbers)+len(tagsRequest.PrincipalUserUniqueIDs)+len(tagsRequest.PrincipalUserPrincipalNames) == 0 {
+		return nil, nil, NewArgError("tagsRequest", "no machines selected")
+	}
+	if len(tagsRequest.Operations) == 0 {
+		return nil, nil, NewArgError("tagsRequest", "no operations")
+	}
+
+	r := &tagResolver{client: s.client, autoCreate: tagsRequest.AutoCreate}
+	ops, err := r.operations(ctx, tagsRequest.Operations)
+	if err != nil {
+		return nil, nil, err
+	}
+	body := &machineTagsRequest{SerialNumbers: tagsRequest.SerialNumbers, Operations: ops}
+	if len(tagsRequest.PrincipalUserUniqueIDs)+len(tagsRequest.PrincipalUserPrincipalNames) > 0 {
+		body.PrincipalUsers = &machineTagsPrincipalUsers{
+			UniqueIDs:      tagsRequest.PrincipalUserUniqueIDs,
+			PrincipalNames: tagsRequest.PrincipalUserPrincipalNames,
+		}
+	}
+
+	req, err := s.client.NewRequest(ctx, http.MethodPost, imTagsBasePath, body)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	result := new(InventoryMachineTagsResult)
+	resp, err := s.client.Do(ctx, req, result)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return result, resp, err
+}
+
+// tagResolver resolves the tag references of the machine tag operations.
+type tagResolver struct {
+	client     *Client
+	autoCreate bool
+
+	// tags and taxonomies, listed on first use
+	tags       []Tag
+	taxonomies []Taxonomy
+	loaded     bool
+}
+
+// operations returns the API operations of the tag operations, grouped by kind and taxonomy.
+func (r *tagResolver) operations(ctx context.Context, tagOps []InventoryMachineTagOperation) ([]machineTagsOperation, error) {
+	var ops []machineTagsOperation
+	index := make(map[string]int)
+	for _, op := range tagOps {
+		switch op.Kind {
+		case TagOperationAdd, TagOperationRemove, TagOperationSet:
+		default:
+			return nil, NewArgError("Kind", fmt.Sprintf("unknown operation %q", op.Kind))
+		}
+		if len(op.Tags) == 0 {
+			return nil, NewArgError("Tags", "cannot be empty")
+		}
+		for _, ref := range op.Tags {
+			taxonomy, name, err := r.resolve(ctx, ref, op.Kind != TagOperationRemove)
+			if err != nil {
+				return nil, err
+			}
+			key := op.Kind + "\x00"
+			if taxonomy != nil {
+				key += *taxonomy
+			}
+			i, ok := index[key]
+			if !ok {
+				i = len(ops)
+				index[key] = i
+				ops = append(ops, machineTagsOperation{Kind: op.Kind, Taxonomy: taxonomy, Names: []string{}})
+			}
+			if !containsString(ops[i].Names, name) {
+				ops[i].Names = append(ops[i].Names, name)
+			}
+		}
+	}
+	return ops, nil
+}
+
+// resolve returns the taxonomy name, nil for no taxonomy, and the name of a referenced tag.
+// The missing tags referenced by name are created if needed and allowed, or passed as is if not
+// needed.
+func (r *tagResolver) resolve(ctx context.Context, ref InventoryMachineTagRef, needed bool) (*string, string, error) {
+	if (ref.ID > 0) == (ref.Name != "") {
+		return nil, "", NewArgError("Tags", "a tag reference needs an ID or a name")
+	}
+	if err := r.load(ctx); err != nil {
+		return nil, "", err
+	}
+
+	if ref.ID > 0 {
+		for _, t := range r.tags {
+			if t.ID == ref.ID {
+				return r.tagTaxonomy(t), t.Name, nil
+			}
+		}
+		return nil, "", fmt.Errorf("tag %d not found", ref.ID)
+	}
+
+	var taxonomy *Taxonomy
+	if ref.Taxonomy != "" {
+		for i, tx := range r.taxonomies {
+			if tx.Name == ref.Taxonomy {
+				taxonomy = &r.taxonomies[i]
+				break
+			}
+		}
+	}
+	// the tag names are unique, a reference without taxonomy matches the tags of any taxonomy
+	for _, t := range r.tags {
+		if t.Name != ref.Name {
+			continue
+		}
+		if ref.Taxonomy == "" {
+			return r.tagTaxonomy(t), t.Name, nil
+		}
+		if sameTaxonomy(t.TaxonomyID, taxonomy) {
+			return taxonomyName(ref.Taxonomy), t.Name, nil
+		}
+		if !needed {
+			break
+		}
+		return nil, "", fmt.Errorf("tag %q is not in taxonomy %q", ref.Name, ref.Taxonomy)
+	}
+	if !needed {
+		// missing tags are ignored by the REMOVE operations
+		return taxonomyName(ref.Taxonomy), ref.Name, nil
+	}
+	if !r.autoCreate {
+		if ref.Taxonomy != "" {
+			return nil, "", fmt.Errorf("tag %q of taxonomy %q not found", ref.Name, ref.Taxonomy)
+		}
+		return nil, "", fmt.Errorf("tag %q not found", ref.Name)
+	}
+
+	createRequest := &TagCreateRequest{Name: ref.Name}
+	if ref.Taxonomy != "" {
+		if taxonomy == nil {
+			tx, _, err := r.client.Taxonomies.Create(ctx, &TaxonomyCreateRequest{Name: ref.Taxonomy})
+			if err != nil {
+				return nil, "", err
+			}
+			r.taxonomies = append(r.taxonomies, *tx)
+			taxonomy = tx
+		}
+		createRequest.TaxonomyID = Int(taxonomy.ID)
+	}
+	t, _, err := r.client.Tags.Create(ctx, createRequest)
+	if err != nil {
+		return nil, "", err
+	}
+	r.tags = append(r.tags, *t)
+	return taxonomyName(ref.Taxonomy), t.Name, nil
+}
+
+func (r *tagResolver) load(ctx context.Context) error {
+	if r.loaded {
+		return nil
+	}
+	tags, _, err := r.client.Tags.List(ctx, nil)
+	if err != nil {
+		return err
+	}
+	taxonomies, _, err := r.client.Taxonomies.List(ctx, nil)
+	if err != nil {
+		return err
+	}
+	r.tags, r.taxonomies, r.loaded = tags, taxonomies, true
+	return nil
+}
+
+func (r *tagResolver) tagTaxonomy(t Tag) *string {
+	if t.TaxonomyID == nil {
+		return nil
+	}
+	for _, tx := range r.taxonomies {
+		if tx.ID == *t.TaxonomyID {
+			return String(tx.Name)
+		}
+	}
+	return nil
+}
+
+func sameTaxonomy(taxonomyID *int, taxonomy *Taxonomy) bool {
+	if taxonomyID == nil || taxonomy == nil {
+		return taxonomyID == nil && taxonomy == nil
+	}
+	return *taxonomyID == taxonomy.ID
+}
+
+func taxonomyName(name string) *string {
+	if name == "" {
+		return nil
+	}
+	return String(name)
+}
+
+func containsString(l []string, s string) bool {
+	for _, e := range l {
+		if e == s {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,181 @@
+package goztl
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestInventoryMachinesService_UpdateTags(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/inventory/tags/", func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodGet:
+			fmt.Fprint(w, `[{"id": 1, "taxonomy": 10, "meta_business_unit": null, "name": "Yolo", "slug": "yolo", "color": "ff0000"},
+			                {"id": 2, "taxonomy": null, "meta_business_unit": null, "name": "Bolo", "slug": "bolo", "color": "ff0000"}]`)
+		case http.MethodPost:
+			testBody(t, r, `{"name":"Fomo","taxonomy":11,"meta_business_unit":null}`+"\n")
+			fmt.Fprint(w, `{"id": 3, "taxonomy": 11, "meta_business_unit": null, "name": "Fomo", "slug": "fomo", "color": "0079bf"}`)
+		}
+	})
+	mux.HandleFunc("/inventory/taxonomies/", func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodGet:
+			fmt.Fprint(w, `[{"id": 10, "meta_business_unit": null, "name": "Env"}]`)
+		case http.MethodPost:
+			testBody(t, r, `{"name":"Team","meta_business_unit":null}`+"\n")
+			fmt.Fprint(w, `{"id": 11, "meta_business_unit": null, "name": "Team"}`)
+		}
+	})
+	mux.HandleFunc("/inventory/machines/tags/", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testHeader(t, r, "Content-Type", "application/json")
+		testBody(t, r, `{"principal_users":{"principal_names":["yolo@example.com"]},"serial_numbers":["0123456789"],"operations":[`+
+			`{"kind":"SET","taxonomy":"Env","names":["Yolo"]},`+
+			`{"kind":"ADD","taxonomy":"Env","names":["Yolo"]},`+
+			`{"kind":"ADD","taxonomy":"Team","names":["Fomo"]},`+
+			`{"kind":"ADD","taxonomy":null,"names":["Bolo"]},`+
+			`{"kind":"REMOVE","taxonomy":"Team","names":["Old"]}]}`+"\n")
+		fmt.Fprint(w, `{"machines": {"found": 2}, "tags": {"added": 3, "removed": 1}}`)
+	})
+
+	ctx := context.Background()
+	tagsRequest := &InventoryMachineTagsRequest{
+		SerialNumbers:               []string{"0123456789"},
+		PrincipalUserPrincipalNames: []string{"yolo@example.com"},
+		Operations: []InventoryMachineTagOperation{
+			{Kind: TagOperationSet, Tags: []InventoryMachineTagRef{{ID: 1}}},
+			{Kind: TagOperationAdd, Tags: []InventoryMachineTagRef{{Name: "Yolo"}, {Taxonomy: "Team", Name: "Fomo"}, {ID: 2}}},
+			{Kind: TagOperationRemove, Tags: []InventoryMachineTagRef{{Taxonomy: "Team", Name: "Old"}}},
+		},
+	}
+
+	// the missing tags are not created by default
+	_, _, err := client.InventoryMachines.UpdateTags(ctx, tagsRequest)
+	if err == nil || err.Error() != `tag "Fomo" of taxonomy "Team" not found` {
+		t.Errorf("InventoryMachines.UpdateTags returned error %v", err)
+	}
+
+	tagsRequest.AutoCreate = true
+	got, _, err := client.InventoryMachines.UpdateTags(ctx, tagsRequest)
+	if err != nil {
+		t.Fatalf("InventoryMachines.UpdateTags returned error: %v", err)
+	}
+	want := &InventoryMachineTagsResult{}
+	want.Machines.Found = 2
+	want.Tags.Added = 3
+	want.Tags.Removed = 1
+	if !cmp.Equal(got, want) {
+		t.Errorf("InventoryMachines.UpdateTags returned %+v, want %+v", got, want)
+	}
+}
+
+func TestInventoryMachinesService_UpdateTagsNameWithoutTaxonomy(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/inventory/tags/", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			t.Errorf("Request method: %v, want GET", r.Method)
+		}
+		fmt.Fprint(w, `[{"id": 1, "taxonomy": 10, "meta_business_unit": null, "name": "Yolo", "slug": "yolo", "color": "ff0000"}]`)
+	})
+	mux.HandleFunc("/inventory/taxonomies/", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `[{"id": 10, "meta_business_unit": null, "name": "Env"}, {"id": 11, "meta_business_unit": null, "name": "Team"}]`)
+	})
+	mux.HandleFunc("/inventory/machines/tags/", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testBody(t, r, `{"serial_numbers":["0123456789"],"operations":[{"kind":"ADD","taxonomy":"Env","names":["Yolo"]}]}`+"\n")
+		fmt.Fprint(w, `{"machines": {"found": 1}, "tags": {"added": 1, "removed": 0}}`)
+	})
+
+	ctx := context.Background()
+	tagsRequest := &InventoryMachineTagsRequest{
+		SerialNumbers: []string{"0123456789"},
+		Operations: []InventoryMachineTagOperation{
+			{Kind: TagOperationAdd, Tags: []InventoryMachineTagRef{{Name: "Yolo"}}},
+		},
+		AutoCreate: true,
+	}
+
+	// the existing tag of the Env taxonomy is used, and no tag is created
+	got, _, err := client.InventoryMachines.UpdateTags(ctx, tagsRequest)
+	if err != nil {
+		t.Fatalf("InventoryMachines.UpdateTags returned error: %v", err)
+	}
+	if got.Tags.Added != 1 {
+		t.Errorf("InventoryMachines.UpdateTags returned %+v", got)
+	}
+
+	// the tag is not in the Team taxonomy, and cannot be created there
+	tagsRequest.Operations[0].Tags[0].Taxonomy = "Team"
+	_, _, err = client.InventoryMachines.UpdateTags(ctx, tagsRequest)
+	if err == nil || err.Error() != `tag "Yolo" is not in taxonomy "Team"` {
+		t.Errorf("InventoryMachines.UpdateTags returned error %v", err)
+	}
+}
+
+func TestInventoryMachinesService_UpdateTagsRemoveByName(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/inventory/tags/", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `[{"id": 1, "taxonomy": 10, "meta_business_unit": null, "name": "Yolo", "slug": "yolo", "color": "ff0000"}]`)
+	})
+	mux.HandleFunc("/inventory/taxonomies/", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `[{"id": 10, "meta_business_unit": null, "name": "Env"}]`)
+	})
+	mux.HandleFunc("/inventory/machines/tags/", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		// the tag is removed from its taxonomy, the unknown tag is passed as is
+		testBody(t, r, `{"serial_numbers":["0123456789"],"operations":[`+
+			`{"kind":"REMOVE","taxonomy":"Env","names":["Yolo"]},`+
+			`{"kind":"REMOVE","taxonomy":null,"names":["Old"]}]}`+"\n")
+		fmt.Fprint(w, `{"machines": {"found": 1}, "tags": {"added": 0, "removed": 1}}`)
+	})
+
+	ctx := context.Background()
+	tagsRequest := &InventoryMachineTagsRequest{
+		SerialNumbers: []string{"0123456789"},
+		Operations: []InventoryMachineTagOperation{
+			{Kind: TagOperationRemove, Tags: []InventoryMachineTagRef{{Name: "Yolo"}, {Name: "Old"}}},
+		},
+	}
+
+	got, _, err := client.InventoryMachines.UpdateTags(ctx, tagsRequest)
+	if err != nil {
+		t.Fatalf("InventoryMachines.UpdateTags returned error: %v", err)
+	}
+	if got.Tags.Removed != 1 {
+		t.Errorf("InventoryMachines.UpdateTags returned %+v", got)
+	}
+}
+
+func TestInventoryMachinesService_UpdateTagsArgErrors(t *testing.T) {
+	client, _, teardown := setup()
+	defer teardown()
+
+	ctx := context.Background()
+	machines := []string{"0123456789"}
+	for _, tagsRequest := range []*InventoryMachineTagsRequest{
+		nil,
+		{Operations: []InventoryMachineTagOperation{{Kind: TagOperationAdd, Tags: []InventoryMachineTagRef{{ID: 1}}}}},
+		{SerialNumbers: machines},
+		{SerialNumbers: machines, Operations: []InventoryMachineTagOperation{{Kind: "YOLO", Tags: []InventoryMachineTagRef{{ID: 1}}}}},
+		{SerialNumbers: machines, Operations: []InventoryMachineTagOperation{{Kind: TagOperationAdd}}},
+		{SerialNumbers: machines, Operations: []InventoryMachineTagOperation{{Kind: TagOperationAdd, Tags: []InventoryMachineTagRef{{ID: 1, Name: "Yolo"}}}}},
+	} {
+		_, _, err := client.InventoryMachines.UpdateTags(ctx, tagsRequest)
+		if _, ok := err.(*ArgError); !ok {
+			t.Errorf("InventoryMachines.UpdateTags(%+v) returned error %v, want an ArgError", tagsRequest, err)
+		}
+	}
+}
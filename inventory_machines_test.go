@@ -0,0 +1,292 @@
+package goztl
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+var imListJSONResponse = `
+{
+  "count": 1,
+  "next": null,
+  "previous": null,
+  "results": [
+    {
+      "serial_number": "0123456789",
+      "platform": "MACOS",
+      "type": "LAPTOP",
+      "last_seen": "2022-07-22T01:02:03.444444",
+      "meta_business_units": [{"id": 2, "name": "Default"}],
+      "tags": [{"id": 3, "taxonomy": null, "meta_business_unit": null, "name": "Yolo"}]
+    }
+  ]
+}
+`
+
+var imGetJSONResponse = `
+{
+  "serial_number": "0123/456789",
+  "platform": "MACOS",
+  "type": "LAPTOP",
+  "last_seen": "2022-07-22T01:02:03.444444",
+  "meta_business_units": [{"id": 2, "name": "Default"}],
+  "tags": [],
+  "snapshots": [
+    {
+      "source": {"id": 4, "module": "zentral.contrib.osquery", "name": "osquery"},
+      "reference": "0123/456789",
+      "platform": "MACOS",
+      "type": "LAPTOP",
+      "os_version": {"name": "macOS", "major": 14, "minor": 5, "patch": null, "build": "23F79", "version": ""},
+      "system_info": {
+        "computer_name": "Yolo's MacBook",
+        "hostname": "yolo.local",
+        "hardware_model": "Mac14,2",
+        "hardware_serial": "0123/456789",
+        "cpu_type": "arm64e",
+        "cpu_brand": "Apple M2",
+        "cpu_physical_cores": 8,
+        "physical_memory": 17179869184
+      },
+      "network_interfaces": [
+        {"interface": "en0", "mac": "00:11:22:33:44:55", "address": "192.168.1.2", "mask": "255.255.255.0", "broadcast": "192.168.1.255"}
+      ],
+      "principal_user": {
+        "source": {"type": "LOGGED_IN_USER", "properties": {"method": "last"}},
+        "unique_id": "yolo",
+        "principal_name": "yolo@example.com",
+        "display_name": "Yolo Fomo"
+      },
+      "business_unit": {"reference_id": "5", "key": "abc", "name": "Default", "meta_business_unit": 2},
+      "public_ip_address": null,
+      "last_seen": "2022-07-22T01:02:03.444444"
+    }
+  ]
+}
+`
+
+func TestInventoryMachinesService_List(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/inventory/machines/", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testHeader(t, r, "Accept", "application/json")
+		testQueryArg(t, r, "limit", "10")
+		fmt.Fprint(w, imListJSONResponse)
+	})
+
+	ctx := context.Background()
+	got, _, err := client.InventoryMachines.List(ctx, &ListOptions{Limit: 10})
+	if err != nil {
+		t.Errorf("InventoryMachines.List returned error: %v", err)
+	}
+
+	want := []InventoryMachine{
+		{
+			SerialNumber:      "0123456789",
+			Platform:          "MACOS",
+			Type:              "LAPTOP",
+			LastSeen:          &Timestamp{referenceTime},
+			MetaBusinessUnits: []InventoryMachineMetaBusinessUnit{{ID: 2, Name: "Default"}},
+			Tags:              []InventoryMachineTag{{ID: 3, Name: "Yolo"}},
+		},
+	}
+	if !cmp.Equal(got, want) {
+		t.Errorf("InventoryMachines.List returned %+v, want %+v", got, want)
+	}
+}
+
+func TestInventoryMachinesService_Search(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/inventory/machines/", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testHeader(t, r, "Accept", "application/json")
+		want := "last_seen_since=2022-07-22T01%3A02%3A03Z&meta_business_unit_id=2&platform=MACOS&tag_id=3"
+		if r.URL.RawQuery != want {
+			t.Errorf("Request query %q, want %q", r.URL.RawQuery, want)
+		}
+		fmt.Fprint(w, imListJSONResponse)
+	})
+
+	ctx := context.Background()
+	filter := &InventoryMachineFilter{MetaBusinessUnitID: 2, TagID: 3, Platform: "MACOS", LastSeenSince: referenceTime}
+	got, _, err := client.InventoryMachines.Search(ctx, filter, nil)
+	if err != nil {
+		t.Errorf("InventoryMachines.Search returned error: %v", err)
+	}
+	if len(got) != 1 || got[0].SerialNumber != "0123456789" {
+		t.Errorf("InventoryMachines.Search returned %+v", got)
+	}
+
+	if _, _, err := client.InventoryMachines.Search(ctx, nil, nil); err == nil {
+		t.Error("InventoryMachines.Search returned no error for a nil filter")
+	}
+}
+
+func TestInventoryMachinesService_GetBySerialNumber(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/inventory/machines/", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testHeader(t, r, "Accept", "application/json")
+		if r.URL.EscapedPath() != "/inventory/machines/0123%2F456789/" {
+			t.Errorf("Request path %q", r.URL.EscapedPath())
+		}
+		fmt.Fprint(w, imGetJSONResponse)
+	})
+
+	ctx := context.Background()
+	got, _, err := client.InventoryMachines.GetBySerialNumber(ctx, "0123/456789")
+	if err != nil {
+		t.Fatalf("InventoryMachines.GetBySerialNumber returned error: %v", err)
+	}
+
+	want := &InventoryMachine{
+		SerialNumber:      "0123/456789",
+		Platform:          "MACOS",
+		Type:              "LAPTOP",
+		LastSeen:          &Timestamp{referenceTime},
+		MetaBusinessUnits: []InventoryMachineMetaBusinessUnit{{ID: 2, Name: "Default"}},
+		Tags:              []InventoryMachineTag{},
+		Snapshots: []InventoryMachineSnapshot{
+			{
+				Source:    InventoryMachineSource{ID: 4, Module: "zentral.contrib.osquery", Name: "osquery"},
+				Reference: "0123/456789",
+				Platform:  "MACOS",
+				Type:      "LAPTOP",
+				OSVersion: &InventoryMachineOSVersion{Name: "macOS", Major: Int(14), Minor: Int(5), Build: "23F79"},
+				SystemInfo: &InventoryMachineSystemInfo{
+					ComputerName:     "Yolo's MacBook",
+					Hostname:         "yolo.local",
+					HardwareModel:    "Mac14,2",
+					HardwareSerial:   "0123/456789",
+					CPUType:          "arm64e",
+					CPUBrand:         "Apple M2",
+					CPUPhysicalCores: Int(8),
+					PhysicalMemory:   Int64(17179869184),
+				},
+				NetworkInterfaces: []InventoryMachineNetworkInterface{
+					{Interface: "en0", MAC: "00:11:22:33:44:55", Address: "192.168.1.2", Mask: "255.255.255.0", Broadcast: "192.168.1.255"},
+				},
+				PrincipalUser: &InventoryMachinePrincipalUser{
+					Source:        InventoryMachinePrincipalUserSource{Type: "LOGGED_IN_USER", Properties: map[string]interface{}{"method": "last"}},
+					UniqueID:      "yolo",
+					PrincipalName: "yolo@example.com",
+					DisplayName:   "Yolo Fomo",
+				},
+				BusinessUnit: &InventoryMachineBusinessUnit{ReferenceID: "5", Key: "abc", Name: "Default", MetaBusinessUnitID: Int(2)},
+				LastSeen:     &Timestamp{referenceTime},
+			},
+		},
+	}
+	if !cmp.Equal(got, want) {
+		t.Errorf("InventoryMachines.GetBySerialNumber returned %s", cmp.Diff(want, got))
+	}
+
+	if _, _, err := client.InventoryMachines.GetBySerialNumber(ctx, ""); err == nil {
+		t.Error("InventoryMachines.GetBySerialNumber returned no error for a blank serial number")
+	}
+}
+
+func TestInventoryMachinesService_Archive(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	for _, action := range []string{"archive", "unarchive"} {
+		mux.HandleFunc("/inventory/machines/"+action+"/", func(w http.ResponseWriter, r *http.Request) {
+			testMethod(t, r, "POST")
+			testHeader(t, r, "Content-Type", "application/json")
+			testBody(t, r, `{"serial_numbers":["0123456789","9876543210"]}`+"\n")
+			fmt.Fprint(w, `{"machines": 2}`)
+		})
+	}
+
+	ctx := context.Background()
+	serialNumbers := []string{"0123456789", "9876543210"}
+	want := &InventoryMachinesArchiveResult{Machines: 2}
+	got, _, err := client.InventoryMachines.Archive(ctx, serialNumbers)
+	if err != nil {
+		t.Errorf("InventoryMachines.Archive returned error: %v", err)
+	}
+	if !cmp.Equal(got, want) {
+		t.Errorf("InventoryMachines.Archive returned %+v, want %+v", got, want)
+	}
+	got, _, err = client.InventoryMachines.Unarchive(ctx, serialNumbers)
+	if err != nil {
+		t.Errorf("InventoryMachines.Unarchive returned error: %v", err)
+	}
+	if !cmp.Equal(got, want) {
+		t.Errorf("InventoryMachines.Unarchive returned %+v, want %+v", got, want)
+	}
+
+	for _, serialNumbers := range [][]string{nil, {"0123456789", ""}} {
+		if _, _, err := client.InventoryMachines.Archive(ctx, serialNumbers); err == nil {
+			t.Errorf("InventoryMachines.Archive(%q) returned no error", serialNumbers)
+		}
+	}
+}
+
+func TestInventoryMachinesService_ArchiveDryRun(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/inventory/machines/", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testQueryArg(t, r, "limit", "1")
+		testQueryArg(t, r, "last_seen_before", "2022-07-22T01:02:03Z")
+		fmt.Fprint(w, `{"count": 123, "next": "https://zentral.example.com/api/inventory/machines/?limit=1&offset=1",
+		                "previous": null, "results": [{"serial_number": "0123456789"}]}`)
+	})
+
+	ctx := context.Background()
+	got, _, err := client.InventoryMachines.ArchiveDryRun(ctx, referenceTime)
+	if err != nil {
+		t.Errorf("InventoryMachines.ArchiveDryRun returned error: %v", err)
+	}
+	if got != 123 {
+		t.Errorf("InventoryMachines.ArchiveDryRun returned %d, want 123", got)
+	}
+
+	if _, _, err := client.InventoryMachines.ArchiveDryRun(ctx, time.Time{}); err == nil {
+		t.Error("InventoryMachines.ArchiveDryRun returned no error for a zero time")
+	}
+}
+
+func TestInventoryMachinesService_Cleanup(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/inventory/cleanup/", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testHeader(t, r, "Content-Type", "application/json")
+		testBody(t, r, `{"days":30}`+"\n")
+		fmt.Fprint(w, `{"task_id": "a7f0d2d0-6a5b-4b5a-9b1e-7f0e7b0e2d4c",
+		                "task_result_url": "/api/task_result/a7f0d2d0-6a5b-4b5a-9b1e-7f0e7b0e2d4c/"}`)
+	})
+
+	ctx := context.Background()
+	got, _, err := client.InventoryMachines.Cleanup(ctx, 30)
+	if err != nil {
+		t.Errorf("InventoryMachines.Cleanup returned error: %v", err)
+	}
+	want := &Task{
+		ID:        "a7f0d2d0-6a5b-4b5a-9b1e-7f0e7b0e2d4c",
+		ResultURL: "/api/task_result/a7f0d2d0-6a5b-4b5a-9b1e-7f0e7b0e2d4c/",
+	}
+	if !cmp.Equal(got, want) {
+		t.Errorf("InventoryMachines.Cleanup returned %+v, want %+v", got, want)
+	}
+
+	if _, _, err := client.InventoryMachines.Cleanup(ctx, 0); err == nil {
+		t.Error("InventoryMachines.Cleanup returned no error for a 0 day retention")
+	}
+}
@@ -0,0 +1,368 @@
+package goztl
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"regexp"
+	"runtime"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+// JournalRecord is the audit journal record of a POST, PUT, PATCH or DELETE request.
+type JournalRecord struct {
+	Time  time.Time `json:"time"`
+	Actor string    `json:"actor,omitempty"`
+
+	// Service and method of the client, e.g. SantaRules and Create. Empty for the requests
+	// made directly with Client.Do.
+	Service string `json:"service,omitempty"`
+	Method  string `json:"method,omitempty"`
+
+	HTTPMethod string `json:"http_method"`
+	Path       string `json:"path"`
+
+	// Request body, with the secret fields redacted.
+	Body json.RawMessage `json:"body,omitempty"`
+
+	// Response status code, 0 if no response was received.
+	Status int `json:"status"`
+
+	// ID of the created, updated or deleted object, if known.
+	ObjectID string `json:"object_id,omitempty"`
+
+	Error string `json:"error,omitempty"`
+}
+
+// Journal is an audit journal of the mutating requests.
+type Journal interface {
+	Append(*JournalRecord) error
+}
+
+// SetJournal is a client option for appending a record to an audit journal for each POST, PUT,
+// PATCH and DELETE request made through Client.Do. The actor of the records can be overridden per
+// request with ContextWithActor. If a record cannot be appended, Client.Do returns an error
+// wrapping the journal error, even if the request succeeded.
+func SetJournal(j Journal, actor string) ClientOpt {
+	return func(c *Client) error {
+		if j == nil {
+			return NewArgError("j", "cannot be nil")
+		}
+		c.journal = j
+		c.journalActor = actor
+		return nil
+	}
+}
+
+type journalActorKey struct{}
+
+// ContextWithActor returns a context with the actor of the audit journal records of its requests.
+func ContextWithActor(ctx context.Context, actor string) context.Context {
+	return context.WithValue(ctx, journalActorKey{}, actor)
+}
+
+type journalBodyKey struct{}
+
+func isMutating(method string) bool {
+	switch method {
+	case http.MethodPost, http.MethodPut, http.MethodPatch, http.MethodDelete:
+		return true
+	}
+	return false
+}
+
+// withJournalBody attaches the redacted JSON form of a request body to a request.
+func (c *Client) withJournalBody(req *http.Request, body interface{}) *http.Request {
+	if c.journal == nil || body == nil || !isMutating(req.Method) {
+		return req
+	}
+	b, err := marshalJournalJSON(Redact(body))
+	if err != nil {
+		return req
+	}
+	return req.WithContext(context.WithValue(req.Context(), journalBodyKey{}, json.RawMessage(b)))
+}
+
+// marshalJournalJSON returns the JSON encoding of v, without HTML escaping, and without newline.
+func marshalJournalJSON(v interface{}) ([]byte, error) {
+	var buf bytes.Buffer
+	e := json.NewEncoder(&buf)
+	e.SetEscapeHTML(false)
+	if err := e.Encode(v); err != nil {
+		return nil, err
+	}
+	return bytes.TrimSuffix(buf.Bytes(), []byte("\n")), nil
+}
+
+// newJournalRecord returns the journal record of a request, without its outcome.
+func (c *Client) newJournalRecord(ctx context.Context, req *http.Request) *JournalRecord {
+	r := &JournalRecord{
+		Time:       time.Now().UTC(),
+		Actor:      c.journalActor,
+		HTTPMethod: req.Method,
+		Path:       strings.TrimPrefix(strings.TrimPrefix(req.URL.Path, c.BaseURL.Path), "/"),
+	}
+	if actor, ok := ctx.Value(journalActorKey{}).(string); ok {
+		r.Actor = actor
+	}
+	if b, ok := req.Context().Value(journalBodyKey{}).(json.RawMessage); ok {
+		r.Body = b
+	}
+	r.Service, r.Method = callerServiceMethod()
+	return r
+}
+
+// serviceMethodRe matches the names of the exported methods of the services.
+var serviceMethodRe = regexp.MustCompile(`^github\.com/zentralopensource/goztl\.\(\*(\w+)ServiceOp\)\.([A-Z]\w*)$`)
+
+// callerServiceMethod returns the service and the method calling Client.Do, if any.
+func callerServiceMethod() (string, string) {
+	pcs := make([]uintptr, 16)
+	n := runtime.Callers(3, pcs)
+	frames := runtime.CallersFrames(pcs[:n])
+	for {
+		f, more := frames.Next()
+		if m := serviceMethodRe.FindStringSubmatch(f.Function); m != nil {
+			return m[1], m[2]
+		}
+		if !more {
+			return "", ""
+		}
+	}
+}
+
+// maxJournalErrorSize is the maximum size of the error response bodies kept in the records.
+const maxJournalErrorSize = 1 << 10
+
+// setOutcome sets the outcome of the request on a journal record. The object ID is read from the
+// id, uuid or pk attribute of the response body, or from the last element of the path.
+func (r *JournalRecord) setOutcome(resp *http.Response, body []byte, err error) {
+	if resp != nil {
+		r.Status = resp.StatusCode
+	}
+	if err != nil {
+		r.Error = err.Error()
+		return
+	}
+	if r.Status < 200 || r.Status > 299 {
+		r.Error = string(bytes.TrimSpace(body[:min(len(body), maxJournalErrorSize)]))
+		if r.Error == "" {
+			r.Error = http.StatusText(r.Status)
+		}
+	}
+	var obj map[string]interface{}
+	d := json.NewDecoder(bytes.NewReader(body))
+	d.UseNumber()
+	if d.Decode(&obj) == nil {
+		for _, attr := range []string{"id", "uuid", "pk"} {
+			if v, ok := obj[attr]; ok && v != nil {
+				r.ObjectID = fmt.Sprint(v)
+				return
+			}
+		}
+	}
+	if r.HTTPMethod != http.MethodPost {
+		elems := strings.Split(strings.Trim(r.Path, "/"), "/")
+		r.ObjectID = elems[len(elems)-1]
+	}
+}
+
+// FileJournal is a Journal writing JSON Lines records to a file. When a record would grow the
+// file over MaxSize, the file is rotated: path is renamed path.1, path.1 is renamed path.2, etc.,
+// and only MaxBackups rotated files are kept.
+type FileJournal struct {
+	Path string
+
+	// Maximum size of the file in bytes. The file is never rotated if 0.
+	MaxSize int64
+
+	// Maximum number of rotated files to keep. All the rotated files are kept if 0.
+	MaxBackups int
+
+	mu sync.Mutex
+}
+
+// Append implements the Journal interface.
+func (j *FileJournal) Append(r *JournalRecord) error {
+	b, err := marshalJournalJSON(r)
+	if err != nil {
+		return err
+	}
+	b = append(b, '\n')
+	j.mu.Lock()
+	defer j.mu.Unlock()
+	if j.MaxSize > 0 {
+		fi, err := os.Stat(j.Path)
+		if err == nil && fi.Size() > 0 && fi.Size()+int64(len(b)) > j.MaxSize {
+			if err := j.rotate(); err != nil {
+				return err
+			}
+		} else if err != nil && !errors.Is(err, os.ErrNotExist) {
+			return err
+		}
+	}
+	f, err := os.OpenFile(j.Path, os.O_WRONLY|os.O_APPEND|os.O_CREATE, 0600)
+	if err != nil {
+		return err
+	}
+	if _, err := f.Write(b); err != nil {
+		f.Close()
+		return err
+	}
+	return f.Close()
+}
+
+func (j *FileJournal) rotate() error {
+	backups := journalBackups(j.Path)
+	for i := len(backups); i > 0; i-- {
+		if j.MaxBackups > 0 && i >= j.MaxBackups {
+			if err := os.Remove(backups[i-1]); err != nil {
+				return err
+			}
+			continue
+		}
+		if err := os.Rename(backups[i-1], fmt.Sprintf("%s.%d", j.Path, i+1)); err != nil {
+			return err
+		}
+	}
+	return os.Rename(j.Path, j.Path+".1")
+}
+
+// journalBackups returns the existing rotated files of a journal, newest first.
+func journalBackups(path string) []string {
+	var backups []string
+	for i := 1; ; i++ {
+		p := fmt.Sprintf("%s.%d", path, i)
+		if _, err := os.Stat(p); err != nil {
+			return backups
+		}
+		backups = append(backups, p)
+	}
+}
+
+// JournalReader reads the records of a JSON Lines audit journal.
+type JournalReader struct {
+	s    *bufio.Scanner
+	line int
+}
+
+// NewJournalReader returns a reader of the records of a journal.
+func NewJournalReader(r io.Reader) *JournalReader {
+	s := bufio.NewScanner(r)
+	s.Buffer(nil, 16<<20)
+	return &JournalReader{s: s}
+}
+
+// Next returns the next record, or io.EOF at the end of the journal.
+func (jr *JournalReader) Next() (*JournalRecord, error) {
+	for jr.s.Scan() {
+		jr.line++
+		b := bytes.TrimSpace(jr.s.Bytes())
+		if len(b) == 0 {
+			continue
+		}
+		r := new(JournalRecord)
+		if err := json.Unmarshal(b, r); err != nil {
+			return nil, fmt.Errorf("line %d: %w", jr.line, err)
+		}
+		return r, nil
+	}
+	if err := jr.s.Err(); err != nil {
+		return nil, err
+	}
+	return nil, io.EOF
+}
+
+// ReadJournalFile returns the records of a FileJournal, the rotated files included, in
+// chronological order.
+func ReadJournalFile(path string) ([]*JournalRecord, error) {
+	backups := journalBackups(path)
+	paths := []string{path}
+	for _, p := range backups {
+		paths = append([]string{p}, paths...)
+	}
+	var records []*JournalRecord
+	for _, p := range paths {
+		f, err := os.Open(p)
+		if errors.Is(err, os.ErrNotExist) {
+			continue
+		} else if err != nil {
+			return nil, err
+		}
+		jr := NewJournalReader(f)
+		for {
+			r, err := jr.Next()
+			if err == io.EOF {
+				break
+			} else if err != nil {
+				f.Close()
+				return nil, fmt.Errorf("%s: %w", p, err)
+			}
+			records = append(records, r)
+		}
+		f.Close()
+	}
+	return records, nil
+}
+
+// JournalSummary counts the records of an audit journal.
+type JournalSummary struct {
+	Total  int
+	Failed int
+
+	// Number of records by actor, and by "Service.Method", or "HTTPMethod path" for the
+	// requests made directly with Client.Do.
+	ByActor     map[string]int
+	ByOperation map[string]int
+
+	First, Last time.Time
+}
+
+// SummarizeJournal returns the summary of journal records. A record is failed if it has an error
+// or a status code outside of the 200 range.
+func SummarizeJournal(records []*JournalRecord) *JournalSummary {
+	s := &JournalSummary{ByActor: make(map[string]int), ByOperation: make(map[string]int)}
+	for _, r := range records {
+		s.Total++
+		if r.Error != "" || r.Status < 200 || r.Status > 299 {
+			s.Failed++
+		}
+		s.ByActor[r.Actor]++
+		op := r.Service + "." + r.Method
+		if r.Service == "" {
+			op = r.HTTPMethod + " " + r.Path
+		}
+		s.ByOperation[op]++
+		if s.First.IsZero() || r.Time.Before(s.First) {
+			s.First = r.Time
+		}
+		if r.Time.After(s.Last) {
+			s.Last = r.Time
+		}
+	}
+	return s
+}
+
+// Operations returns the operations of the summary, sorted by decreasing count, then by name.
+func (s *JournalSummary) Operations() []string {
+	ops := make([]string, 0, len(s.ByOperation))
+	for op := range s.ByOperation {
+		ops = append(ops, op)
+	}
+	sort.Slice(ops, func(i, j int) bool {
+		if s.ByOperation[ops[i]] != s.ByOperation[ops[j]] {
+			return s.ByOperation[ops[i]] > s.ByOperation[ops[j]]
+		}
+		return ops[i] < ops[j]
+	})
+	return ops
+}
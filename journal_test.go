@@ -0,0 +1,137 @@
+package goztl
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+)
+
+func TestJournal(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	path := filepath.Join(t.TempDir(), "journal.jsonl")
+	if err := SetJournal(&FileJournal{Path: path}, "pipeline")(client); err != nil {
+		t.Fatalf("SetJournal returned error: %v", err)
+	}
+
+	mux.HandleFunc("/mdm/recovery_password_configs/", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"id": 4, "name": "Static"}`)
+	})
+	mux.HandleFunc("/mdm/recovery_password_configs/4/", func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodGet:
+			fmt.Fprint(w, `{"id": 4, "name": "Static"}`)
+		case http.MethodDelete:
+			w.WriteHeader(http.StatusNoContent)
+		}
+	})
+	mux.HandleFunc("/mdm/recovery_password_configs/5/", func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, `{"detail": "Not found."}`, http.StatusNotFound)
+	})
+
+	ctx := context.Background()
+	createRequest := &MDMRecoveryPasswordConfigRequest{Name: "Static", StaticPassword: String("12345678")}
+	if _, _, err := client.MDMRecoveryPasswordConfigs.Create(ctx, createRequest); err != nil {
+		t.Fatalf("MDMRecoveryPasswordConfigs.Create returned error: %v", err)
+	}
+	if _, _, err := client.MDMRecoveryPasswordConfigs.GetByID(ctx, 4); err != nil {
+		t.Fatalf("MDMRecoveryPasswordConfigs.GetByID returned error: %v", err)
+	}
+	if _, err := client.MDMRecoveryPasswordConfigs.Delete(ContextWithActor(ctx, "yolo"), 4); err != nil {
+		t.Fatalf("MDMRecoveryPasswordConfigs.Delete returned error: %v", err)
+	}
+	if _, err := client.MDMRecoveryPasswordConfigs.Delete(ctx, 5); err == nil {
+		t.Fatal("MDMRecoveryPasswordConfigs.Delete returned no error")
+	}
+
+	records, err := ReadJournalFile(path)
+	if err != nil {
+		t.Fatalf("ReadJournalFile returned error: %v", err)
+	}
+	want := []*JournalRecord{
+		{
+			Actor:      "pipeline",
+			Service:    "MDMRecoveryPasswordConfigs",
+			Method:     "Create",
+			HTTPMethod: "POST",
+			Path:       "mdm/recovery_password_configs/",
+			Body: json.RawMessage(`{"name":"Static","dynamic_password":false,"static_password":"<redacted>",` +
+				`"rotation_interval_days":0,"reveal_rotation_delay":0,"rotate_firmware_password":false}`),
+			Status:   200,
+			ObjectID: "4",
+		},
+		{
+			Actor:      "yolo",
+			Service:    "MDMRecoveryPasswordConfigs",
+			Method:     "Delete",
+			HTTPMethod: "DELETE",
+			Path:       "mdm/recovery_password_configs/4/",
+			Status:     204,
+			ObjectID:   "4",
+		},
+		{
+			Actor:      "pipeline",
+			Service:    "MDMRecoveryPasswordConfigs",
+			Method:     "Delete",
+			HTTPMethod: "DELETE",
+			Path:       "mdm/recovery_password_configs/5/",
+			Status:     404,
+			ObjectID:   "5",
+			Error:      `{"detail": "Not found."}`,
+		},
+	}
+	if !cmp.Equal(records, want, cmpopts.IgnoreFields(JournalRecord{}, "Time")) {
+		t.Errorf("ReadJournalFile returned %s", cmp.Diff(want, records, cmpopts.IgnoreFields(JournalRecord{}, "Time")))
+	}
+
+	s := SummarizeJournal(records)
+	if s.Total != 3 || s.Failed != 1 || s.ByActor["pipeline"] != 2 {
+		t.Errorf("SummarizeJournal returned %+v", s)
+	}
+	if got, want := s.Operations(), []string{"MDMRecoveryPasswordConfigs.Delete", "MDMRecoveryPasswordConfigs.Create"}; !cmp.Equal(got, want) {
+		t.Errorf("JournalSummary.Operations returned %v, want %v", got, want)
+	}
+}
+
+func TestFileJournalRotation(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "journal.jsonl")
+	j := &FileJournal{Path: path, MaxSize: 200, MaxBackups: 2}
+	for i := 0; i < 10; i++ {
+		if err := j.Append(&JournalRecord{HTTPMethod: "POST", Path: fmt.Sprintf("inventory/tags/%d/", i), Status: 201}); err != nil {
+			t.Fatalf("FileJournal.Append returned error: %v", err)
+		}
+	}
+	for _, p := range []string{path, path + ".1", path + ".2"} {
+		fi, err := os.Stat(p)
+		if err != nil {
+			t.Fatalf("missing journal file: %v", err)
+		}
+		if fi.Size() > j.MaxSize {
+			t.Errorf("%s size %d > %d", p, fi.Size(), j.MaxSize)
+		}
+	}
+	if _, err := os.Stat(path + ".3"); err == nil {
+		t.Errorf("too many rotated journal files")
+	}
+
+	records, err := ReadJournalFile(path)
+	if err != nil {
+		t.Fatalf("ReadJournalFile returned error: %v", err)
+	}
+	var paths []string
+	for _, r := range records {
+		paths = append(paths, r.Path)
+	}
+	if got := strings.Join(paths, " "); !strings.HasSuffix(got, "inventory/tags/8/ inventory/tags/9/") || len(records) >= 10 {
+		t.Errorf("ReadJournalFile returned %s", got)
+	}
+}
@@ -2,7 +2,7 @@ package goztl
 
 type Digicert struct {
 	APIBaseURL       string `json:"api_base_url"`
-	APIToken         string `json:"api_token"`
+	APIToken         string `json:"api_token" ztl:"secret"`
 	ProfileGUID      string `json:"profile_guid"`
 	BusinessUnitGUID string `json:"business_unit_guid"`
 	SeatType         string `json:"seat_type"`
@@ -12,7 +12,7 @@ type Digicert struct {
 
 type IDent struct {
 	URL            string `json:"url"`
-	BearerToken    string `json:"bearer_token"`
+	BearerToken    string `json:"bearer_token" ztl:"secret"`
 	RequestTimeout int    `json:"request_timeout"`
 	MaxRetries     int    `json:"max_retries"`
 }
@@ -20,9 +20,9 @@ type IDent struct {
 type MicrosoftCA struct {
 	URL      string `json:"url"`
 	Username string `json:"username"`
-	Password string `json:"password"`
+	Password string `json:"password" ztl:"secret"`
 }
 
 type StaticChallenge struct {
-	Challenge string `json:"challenge"`
+	Challenge string `json:"challenge" ztl:"secret"`
 }
@@ -0,0 +1,215 @@
+package goztl
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+)
+
+// MaxInlineFileSize is the maximum size of the content of a file sent inline, base 64 encoded, in
+// a request.
+const MaxInlineFileSize int64 = 10 << 20
+
+// ErrFileTooLarge is returned when a local file is larger than the allowed size.
+var ErrFileTooLarge = errors.New("file too large")
+
+// ErrFileMismatch is returned when the file known to Zentral is not the local file.
+var ErrFileMismatch = errors.New("file mismatch")
+
+// LocalFile is the digest of a local file, and its base 64 encoded content if it was read for an
+// inline upload.
+type LocalFile struct {
+	SHA256 string
+	Size   int64
+	Source string
+}
+
+// ReadLocalFile reads r to compute its SHA-256 and size. If inline is true, the content is
+// also base 64 encoded. The content is streamed, and only kept in memory when inline is true.
+//
+// maxSize is the maximum number of bytes that can be read, with 0 meaning no limit. When inline is
+// true, the limit is at most MaxInlineFileSize.
+func ReadLocalFile(r io.Reader, maxSize int64, inline bool) (*LocalFile, error) {
+	if r == nil {
+		return nil, NewArgError("r", "cannot be nil")
+	}
+	if maxSize < 0 {
+		return nil, NewArgError("maxSize", "cannot be less than 0")
+	}
+	if inline && (maxSize == 0 || maxSize > MaxInlineFileSize) {
+		maxSize = MaxInlineFileSize
+	}
+	if maxSize > 0 {
+		// one extra byte to detect the files that are too large
+		r = io.LimitReader(r, maxSize+1)
+	}
+
+	h := sha256.New()
+	w := io.Writer(h)
+	var buf bytes.Buffer
+	var enc io.WriteCloser
+	if inline {
+		enc = base64.NewEncoder(base64.StdEncoding, &buf)
+		w = io.MultiWriter(h, enc)
+	}
+
+	size, err := io.Copy(w, r)
+	if err != nil {
+		return nil, err
+	}
+	if maxSize > 0 && size > maxSize {
+		return nil, fmt.Errorf("%w: more than %d bytes", ErrFileTooLarge, maxSize)
+	}
+
+	lf := &LocalFile{
+		SHA256: hex.EncodeToString(h.Sum(nil)),
+		Size:   size,
+	}
+	if enc != nil {
+		if err := enc.Close(); err != nil {
+			return nil, err
+		}
+		lf.Source = buf.String()
+	}
+	return lf, nil
+}
+
+// verify checks that the SHA-256 and size known to Zentral are the ones of the local file. A size
+// of 0 is not checked, because it is not always returned.
+func (lf *LocalFile) verify(sha256 string, size int64) error {
+	if sha256 != lf.SHA256 {
+		return fmt.Errorf("%w: SHA-256 %s, want %s", ErrFileMismatch, sha256, lf.SHA256)
+	}
+	if size != 0 && size != lf.Size {
+		return fmt.Errorf("%w: size %d, want %d", ErrFileMismatch, size, lf.Size)
+	}
+	return nil
+}
+
+// NewMDMDataAssetRequestFromReader returns a request to create or update a MDM data asset with the
+// content read from r.
+//
+// If fileURI is blank, the content is sent inline, base 64 encoded, in the Source field. Use
+// MDMDataAssets.Options to know if the server accepts inline content. Otherwise, the content must
+// have been uploaded to fileURI, and only its SHA-256 is computed.
+//
+// maxSize is the maximum size of the content, as in ReadLocalFile.
+func NewMDMDataAssetRequestFromReader(r io.Reader, assetType string, fileURI string, maxSize int64) (*MDMDataAssetRequest, *LocalFile, error) {
+	lf, err := ReadLocalFile(r, maxSize, fileURI == "")
+	if err != nil {
+		return nil, nil, err
+	}
+	mdar := &MDMDataAssetRequest{Type: assetType}
+	if fileURI == "" {
+		mdar.Source = lf.Source
+	} else {
+		mdar.FileURI = fileURI
+		mdar.FileSHA256 = lf.SHA256
+	}
+	return mdar, lf, nil
+}
+
+// NewMDMDataAssetRequestFromFile is NewMDMDataAssetRequestFromReader for the file at path.
+func NewMDMDataAssetRequestFromFile(path string, assetType string, fileURI string, maxSize int64) (*MDMDataAssetRequest, *LocalFile, error) {
+	if len(path) < 1 {
+		return nil, nil, NewArgError("path", "cannot be blank")
+	}
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer f.Close()
+	return NewMDMDataAssetRequestFromReader(f, assetType, fileURI, maxSize)
+}
+
+// NewMDMPackageCreateRequestFromReader returns a request to create a MDM package, with the SHA-256
+// of the content read from r. The content must have been uploaded to sourceURI. maxSize is the
+// maximum size of the content, as in ReadLocalFile.
+func NewMDMPackageCreateRequestFromReader(r io.Reader, name string, description string, sourceURI string, maxSize int64) (*MDMPackageCreateRequest, *LocalFile, error) {
+	if len(sourceURI) < 1 {
+		return nil, nil, NewArgError("sourceURI", "cannot be blank")
+	}
+	lf, err := ReadLocalFile(r, maxSize, false)
+	if err != nil {
+		return nil, nil, err
+	}
+	return &MDMPackageCreateRequest{
+		Name:        name,
+		Description: description,
+		SourceURI:   sourceURI,
+		SHA256:      lf.SHA256,
+	}, lf, nil
+}
+
+// NewMDMPackageCreateRequestFromFile is NewMDMPackageCreateRequestFromReader for the file at path.
+func NewMDMPackageCreateRequestFromFile(path string, name string, description string, sourceURI string, maxSize int64) (*MDMPackageCreateRequest, *LocalFile, error) {
+	if len(path) < 1 {
+		return nil, nil, NewArgError("path", "cannot be blank")
+	}
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer f.Close()
+	return NewMDMPackageCreateRequestFromReader(f, name, description, sourceURI, maxSize)
+}
+
+// NewMDMEnterpriseAppRequestFromReader returns a request to create or update a MDM enterprise app,
+// with the SHA-256 of the content read from r. The content must have been uploaded to packageURI.
+// The other fields of the request are left for the caller to set. maxSize is the maximum size of
+// the content, as in ReadLocalFile.
+func NewMDMEnterpriseAppRequestFromReader(r io.Reader, packageURI string, maxSize int64) (*MDMEnterpriseAppRequest, *LocalFile, error) {
+	if len(packageURI) < 1 {
+		return nil, nil, NewArgError("packageURI", "cannot be blank")
+	}
+	lf, err := ReadLocalFile(r, maxSize, false)
+	if err != nil {
+		return nil, nil, err
+	}
+	return &MDMEnterpriseAppRequest{
+		PackageURI:    packageURI,
+		PackageSHA256: lf.SHA256,
+	}, lf, nil
+}
+
+// NewMDMEnterpriseAppRequestFromFile is NewMDMEnterpriseAppRequestFromReader for the file at path.
+func NewMDMEnterpriseAppRequestFromFile(path string, packageURI string, maxSize int64) (*MDMEnterpriseAppRequest, *LocalFile, error) {
+	if len(path) < 1 {
+		return nil, nil, NewArgError("path", "cannot be blank")
+	}
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer f.Close()
+	return NewMDMEnterpriseAppRequestFromReader(f, packageURI, maxSize)
+}
+
+// VerifyMDMDataAsset checks that the file of a MDM data asset is the local file.
+func (lf *LocalFile) VerifyMDMDataAsset(mda *MDMDataAsset) error {
+	if mda == nil {
+		return NewArgError("mda", "cannot be nil")
+	}
+	return lf.verify(mda.FileSHA256, mda.FileSize)
+}
+
+// VerifyMDMPackage checks that the file of a MDM package is the local file.
+func (lf *LocalFile) VerifyMDMPackage(mp *MDMPackage) error {
+	if mp == nil {
+		return NewArgError("mp", "cannot be nil")
+	}
+	return lf.verify(mp.SHA256, mp.Size)
+}
+
+// VerifyMDMEnterpriseApp checks that the package of a MDM enterprise app is the local file.
+func (lf *LocalFile) VerifyMDMEnterpriseApp(mea *MDMEnterpriseApp) error {
+	if mea == nil {
+		return NewArgError("mea", "cannot be nil")
+	}
+	return lf.verify(mea.PackageSHA256, mea.PackageSize)
+}
@@ -0,0 +1,138 @@
+package goztl
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+// SHA-256 of "yolo"
+const localFileSHA256 = "311fe3feed16b9cd8df0f8b1517be5cb86048707df4889ba8dc37d4d68866d02"
+
+func TestReadLocalFile(t *testing.T) {
+	got, err := ReadLocalFile(strings.NewReader("yolo"), 0, false)
+	if err != nil {
+		t.Fatalf("ReadLocalFile returned error: %v", err)
+	}
+
+	want := &LocalFile{SHA256: localFileSHA256, Size: 4}
+	if !cmp.Equal(got, want) {
+		t.Errorf("ReadLocalFile returned %+v, want %+v", got, want)
+	}
+}
+
+func TestReadLocalFileInline(t *testing.T) {
+	got, err := ReadLocalFile(strings.NewReader("yolo"), 0, true)
+	if err != nil {
+		t.Fatalf("ReadLocalFile returned error: %v", err)
+	}
+
+	if got.Source != "eW9sbw==" {
+		t.Errorf("ReadLocalFile returned source %q, want %q", got.Source, "eW9sbw==")
+	}
+	if got.Size != 4 {
+		t.Errorf("ReadLocalFile returned size %d, want 4", got.Size)
+	}
+}
+
+func TestReadLocalFileTooLarge(t *testing.T) {
+	_, err := ReadLocalFile(strings.NewReader("yolo"), 3, false)
+	if !errors.Is(err, ErrFileTooLarge) {
+		t.Errorf("ReadLocalFile returned error %v, want %v", err, ErrFileTooLarge)
+	}
+
+	_, err = ReadLocalFile(strings.NewReader("yolo"), 4, false)
+	if err != nil {
+		t.Errorf("ReadLocalFile returned error: %v", err)
+	}
+}
+
+func TestNewMDMDataAssetRequestFromFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "yolo.zip")
+	if err := os.WriteFile(path, []byte("yolo"), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	got, _, err := NewMDMDataAssetRequestFromFile(path, "ZIP", "", 0)
+	if err != nil {
+		t.Fatalf("NewMDMDataAssetRequestFromFile returned error: %v", err)
+	}
+	want := &MDMDataAssetRequest{Type: "ZIP", Source: "eW9sbw=="}
+	if !cmp.Equal(got, want) {
+		t.Errorf("NewMDMDataAssetRequestFromFile returned %+v, want %+v", got, want)
+	}
+
+	got, _, err = NewMDMDataAssetRequestFromFile(path, "ZIP", "s3://bucket/yolo.zip", 0)
+	if err != nil {
+		t.Fatalf("NewMDMDataAssetRequestFromFile returned error: %v", err)
+	}
+	want = &MDMDataAssetRequest{Type: "ZIP", FileURI: "s3://bucket/yolo.zip", FileSHA256: localFileSHA256}
+	if !cmp.Equal(got, want) {
+		t.Errorf("NewMDMDataAssetRequestFromFile returned %+v, want %+v", got, want)
+	}
+
+	_, _, err = NewMDMDataAssetRequestFromFile(path, "ZIP", "s3://bucket/yolo.zip", 3)
+	if !errors.Is(err, ErrFileTooLarge) {
+		t.Errorf("NewMDMDataAssetRequestFromFile returned error %v, want %v", err, ErrFileTooLarge)
+	}
+}
+
+func TestNewMDMPackageCreateRequestFromReader(t *testing.T) {
+	got, _, err := NewMDMPackageCreateRequestFromReader(strings.NewReader("yolo"), "Yolo", "Fomo", "s3://bucket/yolo.pkg", 0)
+	if err != nil {
+		t.Fatalf("NewMDMPackageCreateRequestFromReader returned error: %v", err)
+	}
+
+	want := &MDMPackageCreateRequest{Name: "Yolo", Description: "Fomo", SourceURI: "s3://bucket/yolo.pkg", SHA256: localFileSHA256}
+	if !cmp.Equal(got, want) {
+		t.Errorf("NewMDMPackageCreateRequestFromReader returned %+v, want %+v", got, want)
+	}
+
+	_, _, err = NewMDMPackageCreateRequestFromReader(strings.NewReader("yolo"), "Yolo", "Fomo", "", 0)
+	if err == nil {
+		t.Error("NewMDMPackageCreateRequestFromReader did not return an error with a blank source URI")
+	}
+
+	_, _, err = NewMDMPackageCreateRequestFromReader(strings.NewReader("yolo"), "Yolo", "Fomo", "s3://bucket/yolo.pkg", 3)
+	if !errors.Is(err, ErrFileTooLarge) {
+		t.Errorf("NewMDMPackageCreateRequestFromReader returned error %v, want %v", err, ErrFileTooLarge)
+	}
+}
+
+func TestNewMDMEnterpriseAppRequestFromReader(t *testing.T) {
+	got, _, err := NewMDMEnterpriseAppRequestFromReader(strings.NewReader("yolo"), "s3://bucket/yolo.pkg", 4)
+	if err != nil {
+		t.Fatalf("NewMDMEnterpriseAppRequestFromReader returned error: %v", err)
+	}
+
+	want := &MDMEnterpriseAppRequest{PackageURI: "s3://bucket/yolo.pkg", PackageSHA256: localFileSHA256}
+	if !cmp.Equal(got, want) {
+		t.Errorf("NewMDMEnterpriseAppRequestFromReader returned %+v, want %+v", got, want)
+	}
+}
+
+func TestLocalFileVerify(t *testing.T) {
+	lf, err := ReadLocalFile(strings.NewReader("yolo"), 0, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := lf.VerifyMDMDataAsset(&MDMDataAsset{FileSHA256: localFileSHA256, FileSize: 4}); err != nil {
+		t.Errorf("VerifyMDMDataAsset returned error: %v", err)
+	}
+	if err := lf.VerifyMDMPackage(&MDMPackage{SHA256: localFileSHA256}); err != nil {
+		t.Errorf("VerifyMDMPackage returned error: %v", err)
+	}
+	err = lf.VerifyMDMEnterpriseApp(&MDMEnterpriseApp{PackageSHA256: lf.SHA256, PackageSize: 5})
+	if !errors.Is(err, ErrFileMismatch) {
+		t.Errorf("VerifyMDMEnterpriseApp returned error %v, want %v", err, ErrFileMismatch)
+	}
+	err = lf.VerifyMDMPackage(&MDMPackage{SHA256: strings.Repeat("0", 64)})
+	if !errors.Is(err, ErrFileMismatch) {
+		t.Errorf("VerifyMDMPackage returned error %v, want %v", err, ErrFileMismatch)
+	}
+}
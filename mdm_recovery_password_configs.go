@@ -32,7 +32,7 @@ type MDMRecoveryPasswordConfig struct {
 	ID                     int       `json:"id"`
 	Name                   string    `json:"name"`
 	DynamicPassword        bool      `json:"dynamic_password"`
-	StaticPassword         *string   `json:"static_password"`
+	StaticPassword         *string   `json:"static_password" ztl:"secret"`
 	RotationIntervalDays   int       `json:"rotation_interval_days"`
 	RevealRotationDelay    int       `json:"reveal_rotation_delay"`
 	RotateFirmwarePassword bool      `json:"rotate_firmware_password"`
@@ -48,7 +48,7 @@ func (mrpc MDMRecoveryPasswordConfig) String() string {
 type MDMRecoveryPasswordConfigRequest struct {
 	Name                   string  `json:"name"`
 	DynamicPassword        bool    `json:"dynamic_password"`
-	StaticPassword         *string `json:"static_password"`
+	StaticPassword         *string `json:"static_password" ztl:"secret"`
 	RotationIntervalDays   int     `json:"rotation_interval_days"`
 	RevealRotationDelay    int     `json:"reveal_rotation_delay"`
 	RotateFirmwarePassword bool    `json:"rotate_firmware_password"`
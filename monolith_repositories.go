@@ -34,13 +34,13 @@ type MonolithS3Backend struct {
 	RegionName           string `json:"region_name"`
 	Prefix               string `json:"prefix"`
 	AccessKeyID          string `json:"access_key_id"`
-	SecretAccessKey      string `json:"secret_access_key"`
+	SecretAccessKey      string `json:"secret_access_key" ztl:"secret"`
 	AssumeRoleARN        string `json:"assume_role_arn"`
 	SignatureVersion     string `json:"signature_version"`
 	EndpointURL          string `json:"endpoint_url"`
 	CloudfrontDomain     string `json:"cloudfront_domain"`
 	CloudfrontKeyID      string `json:"cloudfront_key_id"`
-	CloudfrontPrivkeyPEM string `json:"cloudfront_privkey_pem"`
+	CloudfrontPrivkeyPEM string `json:"cloudfront_privkey_pem" ztl:"secret"`
 }
 
 type MonolithAzureBackend struct {
@@ -49,7 +49,7 @@ type MonolithAzureBackend struct {
 	Prefix         string `json:"prefix"`
 	ClientID       string `json:"client_id"`
 	TenantID       string `json:"tenant_id"`
-	ClientSecret   string `json:"client_secret"`
+	ClientSecret   string `json:"client_secret" ztl:"secret"`
 }
 
 type MonolithRepository struct {
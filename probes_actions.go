@@ -32,13 +32,13 @@ var _ ProbesActionsService = &ProbesActionsServiceOp{}
 type ProbeActionHTTPPost struct {
 	URL               string       `json:"url"`
 	Username          *string      `json:"username"`
-	Password          *string      `json:"password"`
+	Password          *string      `json:"password" ztl:"secret"`
 	Headers           []HTTPHeader `json:"headers"`
 	CELTransformation *string      `json:"cel_transformation"`
 }
 
 type ProbeActionSlackIncomingWebhook struct {
-	URL string `json:"url"`
+	URL string `json:"url" ztl:"secret"`
 }
 
 type ProbeAction struct {
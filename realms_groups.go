@@ -0,0 +1,205 @@
+package goztl
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+)
+
+const rgBasePath = "realms/groups/"
+
+// RealmsGroupsService is an interface for interfacing with the realm groups
+// endpoints of the Zentral API
+type RealmsGroupsService interface {
+	List(context.Context, *ListOptions) ([]RealmsGroup, *Response, error)
+	GetByUUID(context.Context, string) (*RealmsGroup, *Response, error)
+	GetByRealmUUID(context.Context, string) ([]RealmsGroup, *Response, error)
+	GetByDisplayName(context.Context, string) ([]RealmsGroup, *Response, error)
+	Create(context.Context, *RealmsGroupRequest) (*RealmsGroup, *Response, error)
+	Update(context.Context, string, *RealmsGroupRequest) (*RealmsGroup, *Response, error)
+	Delete(context.Context, string) (*Response, error)
+}
+
+// RealmsGroupsServiceOp handles communication with the realm groups related
+// methods of the Zentral API.
+type RealmsGroupsServiceOp struct {
+	client *Client
+}
+
+var _ RealmsGroupsService = &RealmsGroupsServiceOp{}
+
+// RealmsGroup represents a Zentral realm group
+type RealmsGroup struct {
+	UUID        string  `json:"uuid"`
+	RealmUUID   string  `json:"realm"`
+	ParentUUID  *string `json:"parent"`
+	DisplayName string  `json:"display_name"`
+
+	// Groups provisioned by SCIM cannot be updated
+	SCIMExternalID *string `json:"scim_external_id"`
+	SCIMManaged    bool    `json:"scim_managed"`
+
+	Created Timestamp `json:"created_at"`
+	Updated Timestamp `json:"updated_at"`
+}
+
+func (rg RealmsGroup) String() string {
+	return Stringify(rg)
+}
+
+// RealmsGroupRequest represents a request to create or update a realm group
+type RealmsGroupRequest struct {
+	RealmUUID   string  `json:"realm"`
+	ParentUUID  *string `json:"parent"`
+	DisplayName string  `json:"display_name"`
+}
+
+type listRGOptions struct {
+	RealmUUID   string `url:"realm_uuid,omitempty"`
+	DisplayName string `url:"display_name,omitempty"`
+}
+
+// List lists all the realm groups.
+func (s *RealmsGroupsServiceOp) List(ctx context.Context, opt *ListOptions) ([]RealmsGroup, *Response, error) {
+	return s.list(ctx, opt, nil)
+}
+
+// GetByUUID retrieves a realm group by UUID.
+func (s *RealmsGroupsServiceOp) GetByUUID(ctx context.Context, rgUUID string) (*RealmsGroup, *Response, error) {
+	if len(rgUUID) < 1 {
+		return nil, nil, NewArgError("rgUUID", "cannot be empty")
+	}
+
+	path := fmt.Sprintf("%s%s/", rgBasePath, rgUUID)
+
+	req, err := s.client.NewRequest(ctx, http.MethodGet, path, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	rg := new(RealmsGroup)
+
+	resp, err := s.client.Do(ctx, req, rg)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return rg, resp, err
+}
+
+// GetByRealmUUID retrieves the groups of a realm.
+func (s *RealmsGroupsServiceOp) GetByRealmUUID(ctx context.Context, rUUID string) ([]RealmsGroup, *Response, error) {
+	if len(rUUID) < 1 {
+		return nil, nil, NewArgError("rUUID", "cannot be empty")
+	}
+
+	listRGOpt := &listRGOptions{RealmUUID: rUUID}
+
+	rgs, resp, err := s.list(ctx, nil, listRGOpt)
+	if err != nil {
+		return nil, resp, err
+	}
+	if len(rgs) < 1 {
+		return nil, resp, nil
+	}
+
+	return rgs, resp, err
+}
+
+// GetByDisplayName retrieves realm groups by display name. The display names are only unique
+// within a realm.
+func (s *RealmsGroupsServiceOp) GetByDisplayName(ctx context.Context, displayName string) ([]RealmsGroup, *Response, error) {
+	if len(displayName) < 1 {
+		return nil, nil, NewArgError("displayName", "cannot be blank")
+	}
+
+	listRGOpt := &listRGOptions{DisplayName: displayName}
+
+	rgs, resp, err := s.list(ctx, nil, listRGOpt)
+	if err != nil {
+		return nil, resp, err
+	}
+	if len(rgs) < 1 {
+		return nil, resp, nil
+	}
+
+	return rgs, resp, err
+}
+
+// Create a new realm group.
+func (s *RealmsGroupsServiceOp) Create(ctx context.Context, createRequest *RealmsGroupRequest) (*RealmsGroup, *Response, error) {
+	if createRequest == nil {
+		return nil, nil, NewArgError("createRequest", "cannot be nil")
+	}
+
+	req, err := s.client.NewRequest(ctx, http.MethodPost, rgBasePath, createRequest)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	rg := new(RealmsGroup)
+	resp, err := s.client.Do(ctx, req, rg)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return rg, resp, err
+}
+
+// Update a realm group.
+func (s *RealmsGroupsServiceOp) Update(ctx context.Context, rgUUID string, updateRequest *RealmsGroupRequest) (*RealmsGroup, *Response, error) {
+	if len(rgUUID) < 1 {
+		return nil, nil, NewArgError("rgUUID", "cannot be empty")
+	}
+
+	if updateRequest == nil {
+		return nil, nil, NewArgError("updateRequest", "cannot be nil")
+	}
+
+	path := fmt.Sprintf("%s%s/", rgBasePath, rgUUID)
+
+	req, err := s.client.NewRequest(ctx, http.MethodPut, path, updateRequest)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	rg := new(RealmsGroup)
+	resp, err := s.client.Do(ctx, req, rg)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return rg, resp, err
+}
+
+// Delete a realm group.
+func (s *RealmsGroupsServiceOp) Delete(ctx context.Context, rgUUID string) (*Response, error) {
+	if len(rgUUID) < 1 {
+		return nil, NewArgError("rgUUID", "cannot be empty")
+	}
+
+	path := fmt.Sprintf("%s%s/", rgBasePath, rgUUID)
+
+	req, err := s.client.NewRequest(ctx, http.MethodDelete, path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.client.Do(ctx, req, nil)
+
+	return resp, err
+}
+
+// Helper method for listing realm groups
+func (s *RealmsGroupsServiceOp) list(ctx context.Context, opt *ListOptions, rgOpt *listRGOptions) ([]RealmsGroup, *Response, error) {
+	path := rgBasePath
+	path, err := addOptions(path, opt)
+	if err != nil {
+		return nil, nil, err
+	}
+	path, err = addOptions(path, rgOpt)
+	if err != nil {
+		return nil, nil, err
+	}
+	return resolveAllPages[RealmsGroup](ctx, s.client, path)
+}
@@ -0,0 +1,215 @@
+package goztl
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+var rgJSONResponse = `
+{
+    "uuid": "f6b0b1b8-2d57-4a4c-9c0f-6a8a8e0c1f3e",
+    "realm": "af751e50-9eae-4fdb-b197-dd5041072a69",
+    "parent": null,
+    "display_name": "Admins",
+    "scim_external_id": null,
+    "scim_managed": false,
+    "created_at": "2022-07-22T01:02:03.444444",
+    "updated_at": "2022-07-22T01:02:03.444444"
+}
+`
+
+var rgSCIMJSONResponse = `
+{
+    "uuid": "0c2bd7c4-4cbb-4b6f-9a1c-3f0e2d8f7a11",
+    "realm": "af751e50-9eae-4fdb-b197-dd5041072a69",
+    "parent": "f6b0b1b8-2d57-4a4c-9c0f-6a8a8e0c1f3e",
+    "display_name": "Santa admins",
+    "scim_external_id": "yolo",
+    "scim_managed": true,
+    "created_at": "2022-07-22T01:02:03.444444",
+    "updated_at": "2022-07-22T01:02:03.444444"
+}
+`
+
+func TestRealmsGroupsService_List(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/realms/groups/", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testHeader(t, r, "Accept", "application/json")
+		fmt.Fprint(w, "["+rgJSONResponse+","+rgSCIMJSONResponse+"]")
+	})
+
+	ctx := context.Background()
+	got, _, err := client.RealmsGroups.List(ctx, nil)
+	if err != nil {
+		t.Errorf("RealmsGroups.List returned error: %v", err)
+	}
+
+	want := []RealmsGroup{
+		{
+			UUID:        "f6b0b1b8-2d57-4a4c-9c0f-6a8a8e0c1f3e",
+			RealmUUID:   "af751e50-9eae-4fdb-b197-dd5041072a69",
+			DisplayName: "Admins",
+			Created:     Timestamp{referenceTime},
+			Updated:     Timestamp{referenceTime},
+		},
+		{
+			UUID:           "0c2bd7c4-4cbb-4b6f-9a1c-3f0e2d8f7a11",
+			RealmUUID:      "af751e50-9eae-4fdb-b197-dd5041072a69",
+			ParentUUID:     String("f6b0b1b8-2d57-4a4c-9c0f-6a8a8e0c1f3e"),
+			DisplayName:    "Santa admins",
+			SCIMExternalID: String("yolo"),
+			SCIMManaged:    true,
+			Created:        Timestamp{referenceTime},
+			Updated:        Timestamp{referenceTime},
+		},
+	}
+	if !cmp.Equal(got, want) {
+		t.Errorf("RealmsGroups.List returned %+v, want %+v", got, want)
+	}
+}
+
+func TestRealmsGroupsService_GetByUUID(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/realms/groups/f6b0b1b8-2d57-4a4c-9c0f-6a8a8e0c1f3e/", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testHeader(t, r, "Accept", "application/json")
+		fmt.Fprint(w, rgJSONResponse)
+	})
+
+	ctx := context.Background()
+	got, _, err := client.RealmsGroups.GetByUUID(ctx, "f6b0b1b8-2d57-4a4c-9c0f-6a8a8e0c1f3e")
+	if err != nil {
+		t.Errorf("RealmsGroups.GetByUUID returned error: %v", err)
+	}
+	if got == nil || got.DisplayName != "Admins" || got.ParentUUID != nil {
+		t.Errorf("RealmsGroups.GetByUUID returned %+v", got)
+	}
+}
+
+func TestRealmsGroupsService_GetByRealmUUID(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/realms/groups/", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testHeader(t, r, "Accept", "application/json")
+		testQueryArg(t, r, "realm_uuid", "af751e50-9eae-4fdb-b197-dd5041072a69")
+		fmt.Fprint(w, "["+rgJSONResponse+","+rgSCIMJSONResponse+"]")
+	})
+
+	ctx := context.Background()
+	got, _, err := client.RealmsGroups.GetByRealmUUID(ctx, "af751e50-9eae-4fdb-b197-dd5041072a69")
+	if err != nil {
+		t.Errorf("RealmsGroups.GetByRealmUUID returned error: %v", err)
+	}
+	if len(got) != 2 {
+		t.Errorf("RealmsGroups.GetByRealmUUID returned %+v", got)
+	}
+}
+
+func TestRealmsGroupsService_GetByDisplayName(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/realms/groups/", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testHeader(t, r, "Accept", "application/json")
+		if r.URL.Query().Get("display_name") == "Admins" {
+			fmt.Fprint(w, "["+rgJSONResponse+"]")
+		} else {
+			fmt.Fprint(w, "[]")
+		}
+	})
+
+	ctx := context.Background()
+	got, _, err := client.RealmsGroups.GetByDisplayName(ctx, "Admins")
+	if err != nil {
+		t.Errorf("RealmsGroups.GetByDisplayName returned error: %v", err)
+	}
+	if len(got) != 1 || got[0].UUID != "f6b0b1b8-2d57-4a4c-9c0f-6a8a8e0c1f3e" {
+		t.Errorf("RealmsGroups.GetByDisplayName returned %+v", got)
+	}
+
+	got, _, err = client.RealmsGroups.GetByDisplayName(ctx, "Yolo")
+	if err != nil || got != nil {
+		t.Errorf("RealmsGroups.GetByDisplayName returned %+v, %v, want nil, nil", got, err)
+	}
+}
+
+func TestRealmsGroupsService_Create(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/realms/groups/", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testHeader(t, r, "Accept", "application/json")
+		testHeader(t, r, "Content-Type", "application/json")
+		testBody(t, r, `{"realm":"af751e50-9eae-4fdb-b197-dd5041072a69","parent":null,"display_name":"Admins"}`+"\n")
+		fmt.Fprint(w, rgJSONResponse)
+	})
+
+	ctx := context.Background()
+	createRequest := &RealmsGroupRequest{
+		RealmUUID:   "af751e50-9eae-4fdb-b197-dd5041072a69",
+		DisplayName: "Admins",
+	}
+	got, _, err := client.RealmsGroups.Create(ctx, createRequest)
+	if err != nil {
+		t.Errorf("RealmsGroups.Create returned error: %v", err)
+	}
+	if got == nil || got.UUID != "f6b0b1b8-2d57-4a4c-9c0f-6a8a8e0c1f3e" {
+		t.Errorf("RealmsGroups.Create returned %+v", got)
+	}
+}
+
+func TestRealmsGroupsService_Update(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/realms/groups/0c2bd7c4-4cbb-4b6f-9a1c-3f0e2d8f7a11/", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PUT")
+		testHeader(t, r, "Accept", "application/json")
+		testHeader(t, r, "Content-Type", "application/json")
+		testBody(t, r, `{"realm":"af751e50-9eae-4fdb-b197-dd5041072a69",`+
+			`"parent":"f6b0b1b8-2d57-4a4c-9c0f-6a8a8e0c1f3e","display_name":"Santa admins"}`+"\n")
+		fmt.Fprint(w, rgSCIMJSONResponse)
+	})
+
+	ctx := context.Background()
+	updateRequest := &RealmsGroupRequest{
+		RealmUUID:   "af751e50-9eae-4fdb-b197-dd5041072a69",
+		ParentUUID:  String("f6b0b1b8-2d57-4a4c-9c0f-6a8a8e0c1f3e"),
+		DisplayName: "Santa admins",
+	}
+	got, _, err := client.RealmsGroups.Update(ctx, "0c2bd7c4-4cbb-4b6f-9a1c-3f0e2d8f7a11", updateRequest)
+	if err != nil {
+		t.Errorf("RealmsGroups.Update returned error: %v", err)
+	}
+	if got == nil || got.DisplayName != "Santa admins" {
+		t.Errorf("RealmsGroups.Update returned %+v", got)
+	}
+}
+
+func TestRealmsGroupsService_Delete(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/realms/groups/f6b0b1b8-2d57-4a4c-9c0f-6a8a8e0c1f3e/", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+	})
+
+	ctx := context.Background()
+	_, err := client.RealmsGroups.Delete(ctx, "f6b0b1b8-2d57-4a4c-9c0f-6a8a8e0c1f3e")
+	if err != nil {
+		t.Errorf("RealmsGroups.Delete returned error: %v", err)
+	}
+}
@@ -14,6 +14,9 @@ type RealmsRealmsService interface {
 	List(context.Context, *ListOptions) ([]RealmsRealm, *Response, error)
 	GetByUUID(context.Context, string) (*RealmsRealm, *Response, error)
 	GetByName(context.Context, string) (*RealmsRealm, *Response, error)
+	Create(context.Context, *RealmsRealmRequest) (*RealmsRealm, *Response, error)
+	Update(context.Context, string, *RealmsRealmRequest) (*RealmsRealm, *Response, error)
+	Delete(context.Context, string) (*Response, error)
 }
 
 // RealmsRealmsServiceOp handles communication with the realms related
@@ -28,7 +31,7 @@ var _ RealmsRealmsService = &RealmsRealmsServiceOp{}
 type LDAPConfig struct {
 	Host         string `json:"host"`
 	BindDN       string `json:"bind_dn"`
-	BindPassword string `json:"bind_password"`
+	BindPassword string `json:"bind_password" ztl:"secret"`
 	UsersBaseDN  string `json:"users_base_dn"`
 }
 
@@ -36,7 +39,7 @@ type LDAPConfig struct {
 type OpenIDCConfig struct {
 	DiscoveryURL string   `json:"discovery_url"`
 	ClientID     string   `json:"client_id"`
-	ClientSecret *string  `json:"client_secret"`
+	ClientSecret *string  `json:"client_secret" ztl:"secret"`
 	ExtraScopes  []string `json:"extra_scopes"`
 }
 
@@ -72,6 +75,26 @@ func (r RealmsRealm) String() string {
 	return Stringify(r)
 }
 
+// RealmsRealmRequest represents a request to create or update a realm.
+// Only the config of the backend must be set.
+type RealmsRealmRequest struct {
+	Name               string         `json:"name"`
+	Backend            string         `json:"backend"`
+	LDAPConfig         *LDAPConfig    `json:"ldap_config,omitempty"`
+	OpenIDCConfig      *OpenIDCConfig `json:"openidc_config,omitempty"`
+	SAMLConfig         *SAMLConfig    `json:"saml_config,omitempty"`
+	EnabledForLogin    bool           `json:"enabled_for_login"`
+	LoginSessionExpiry int            `json:"login_session_expiry"`
+	UsernameClaim      string         `json:"username_claim"`
+	EmailClaim         string         `json:"email_claim"`
+	FirstNameClaim     string         `json:"first_name_claim"`
+	LastNameClaim      string         `json:"last_name_claim"`
+	FullNameClaim      string         `json:"full_name_claim"`
+	CustomAttr1Claim   string         `json:"custom_attr_1_claim"`
+	CustomAttr2Claim   string         `json:"custom_attr_2_claim"`
+	SCIMEnabled        bool           `json:"scim_enabled"`
+}
+
 type listROptions struct {
 	Name string `url:"name,omitempty"`
 }
@@ -123,6 +146,70 @@ func (s *RealmsRealmsServiceOp) GetByName(ctx context.Context, name string) (*Re
 	return &rs[0], resp, err
 }
 
+// Create a new Realms realm.
+func (s *RealmsRealmsServiceOp) Create(ctx context.Context, createRequest *RealmsRealmRequest) (*RealmsRealm, *Response, error) {
+	if createRequest == nil {
+		return nil, nil, NewArgError("createRequest", "cannot be nil")
+	}
+
+	req, err := s.client.NewRequest(ctx, http.MethodPost, rBasePath, createRequest)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	r := new(RealmsRealm)
+	resp, err := s.client.Do(ctx, req, r)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return r, resp, err
+}
+
+// Update a Realms realm.
+func (s *RealmsRealmsServiceOp) Update(ctx context.Context, rUUID string, updateRequest *RealmsRealmRequest) (*RealmsRealm, *Response, error) {
+	if len(rUUID) < 1 {
+		return nil, nil, NewArgError("rUUID", "cannot be empty")
+	}
+
+	if updateRequest == nil {
+		return nil, nil, NewArgError("updateRequest", "cannot be nil")
+	}
+
+	path := fmt.Sprintf("%s%s/", rBasePath, rUUID)
+
+	req, err := s.client.NewRequest(ctx, http.MethodPut, path, updateRequest)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	r := new(RealmsRealm)
+	resp, err := s.client.Do(ctx, req, r)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return r, resp, err
+}
+
+// Delete a Realms realm.
+func (s *RealmsRealmsServiceOp) Delete(ctx context.Context, rUUID string) (*Response, error) {
+	if len(rUUID) < 1 {
+		return nil, NewArgError("rUUID", "cannot be empty")
+	}
+
+	path := fmt.Sprintf("%s%s/", rBasePath, rUUID)
+
+	req, err := s.client.NewRequest(ctx, http.MethodDelete, path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.client.Do(ctx, req, nil)
+
+	return resp, err
+}
+
 // Helper method for listing Realms realms
 func (s *RealmsRealmsServiceOp) list(ctx context.Context, opt *ListOptions, rOpt *listROptions) ([]RealmsRealm, *Response, error) {
 	path := rBasePath
@@ -227,3 +227,95 @@ func TestRealmsRealmsService_GetByName(t *testing.T) {
 		t.Errorf("RealmsRealms.GetByName returned %+v, want %+v", got, want)
 	}
 }
+
+func TestRealmsRealmsService_Create(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/realms/realms/", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testHeader(t, r, "Accept", "application/json")
+		testHeader(t, r, "Content-Type", "application/json")
+		testBody(t, r, `{"name":"Default","backend":"openidc",`+
+			`"openidc_config":{"discovery_url":"https://zentral.example.com/.well-known/openid-configuration",`+
+			`"client_id":"yolo","client_secret":"fomo","extra_scopes":["profile"]},`+
+			`"enabled_for_login":true,"login_session_expiry":120,"username_claim":"username","email_claim":"email",`+
+			`"first_name_claim":"first_name","last_name_claim":"last_name","full_name_claim":"full_name",`+
+			`"custom_attr_1_claim":"","custom_attr_2_claim":"","scim_enabled":true}`+"\n")
+		fmt.Fprint(w, rGetJSONResponse)
+	})
+
+	ctx := context.Background()
+	createRequest := &RealmsRealmRequest{
+		Name:    "Default",
+		Backend: "openidc",
+		OpenIDCConfig: &OpenIDCConfig{
+			DiscoveryURL: "https://zentral.example.com/.well-known/openid-configuration",
+			ClientID:     "yolo",
+			ClientSecret: String("fomo"),
+			ExtraScopes:  []string{"profile"},
+		},
+		EnabledForLogin:    true,
+		LoginSessionExpiry: 120,
+		UsernameClaim:      "username",
+		EmailClaim:         "email",
+		FirstNameClaim:     "first_name",
+		LastNameClaim:      "last_name",
+		FullNameClaim:      "full_name",
+		SCIMEnabled:        true,
+	}
+	got, _, err := client.RealmsRealms.Create(ctx, createRequest)
+	if err != nil {
+		t.Errorf("RealmsRealms.Create returned error: %v", err)
+	}
+	if got == nil || got.UUID != "af751e50-9eae-4fdb-b197-dd5041072a69" || got.OpenIDCConfig == nil {
+		t.Errorf("RealmsRealms.Create returned %+v", got)
+	}
+}
+
+func TestRealmsRealmsService_Update(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/realms/realms/af751e50-9eae-4fdb-b197-dd5041072a69/", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PUT")
+		testHeader(t, r, "Accept", "application/json")
+		testHeader(t, r, "Content-Type", "application/json")
+		testBody(t, r, `{"name":"Default","backend":"saml",`+
+			`"saml_config":{"default_relay_state":"","idp_metadata":"\u003cmd\u003e\u003c/md\u003e"},`+
+			`"enabled_for_login":false,"login_session_expiry":0,"username_claim":"username","email_claim":"",`+
+			`"first_name_claim":"","last_name_claim":"","full_name_claim":"",`+
+			`"custom_attr_1_claim":"","custom_attr_2_claim":"","scim_enabled":false}`+"\n")
+		fmt.Fprint(w, rGetJSONResponse)
+	})
+
+	ctx := context.Background()
+	updateRequest := &RealmsRealmRequest{
+		Name:          "Default",
+		Backend:       "saml",
+		SAMLConfig:    &SAMLConfig{IDPMetadata: "<md></md>"},
+		UsernameClaim: "username",
+	}
+	got, _, err := client.RealmsRealms.Update(ctx, "af751e50-9eae-4fdb-b197-dd5041072a69", updateRequest)
+	if err != nil {
+		t.Errorf("RealmsRealms.Update returned error: %v", err)
+	}
+	if got == nil || got.UUID != "af751e50-9eae-4fdb-b197-dd5041072a69" {
+		t.Errorf("RealmsRealms.Update returned %+v", got)
+	}
+}
+
+func TestRealmsRealmsService_Delete(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/realms/realms/af751e50-9eae-4fdb-b197-dd5041072a69/", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+	})
+
+	ctx := context.Background()
+	_, err := client.RealmsRealms.Delete(ctx, "af751e50-9eae-4fdb-b197-dd5041072a69")
+	if err != nil {
+		t.Errorf("RealmsRealms.Delete returned error: %v", err)
+	}
+}
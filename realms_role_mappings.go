@@ -0,0 +1,200 @@
+package goztl
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+)
+
+const rrmBasePath = "realms/role_mappings/"
+
+// RealmsRoleMappingsService is an interface for interfacing with the realm group
+// to role mapping endpoints of the Zentral API
+type RealmsRoleMappingsService interface {
+	List(context.Context, *ListOptions) ([]RealmsRoleMapping, *Response, error)
+	GetByID(context.Context, string) (*RealmsRoleMapping, *Response, error)
+	GetByRealmGroupUUID(context.Context, string) ([]RealmsRoleMapping, *Response, error)
+	GetByRoleID(context.Context, int) ([]RealmsRoleMapping, *Response, error)
+	Create(context.Context, *RealmsRoleMappingRequest) (*RealmsRoleMapping, *Response, error)
+	Update(context.Context, string, *RealmsRoleMappingRequest) (*RealmsRoleMapping, *Response, error)
+	Delete(context.Context, string) (*Response, error)
+}
+
+// RealmsRoleMappingsServiceOp handles communication with the realm group
+// to role mapping related methods of the Zentral API.
+type RealmsRoleMappingsServiceOp struct {
+	client *Client
+}
+
+var _ RealmsRoleMappingsService = &RealmsRoleMappingsServiceOp{}
+
+// RealmsRoleMapping represents a Zentral realm group to role mapping. The members of the
+// realm group are given the role.
+type RealmsRoleMapping struct {
+	ID             string `json:"id"`
+	RealmGroupUUID string `json:"realm_group"`
+	RoleID         int    `json:"role"`
+
+	Created Timestamp `json:"created_at"`
+	Updated Timestamp `json:"updated_at"`
+}
+
+func (m RealmsRoleMapping) String() string {
+	return Stringify(m)
+}
+
+// RealmsRoleMappingRequest represents a request to create or update a realm group
+// to role mapping.
+type RealmsRoleMappingRequest struct {
+	RealmGroupUUID string `json:"realm_group"`
+	RoleID         int    `json:"role"`
+}
+
+type listRRMOptions struct {
+	RealmGroupUUID string `url:"realm_group_uuid,omitempty"`
+	RoleID         int    `url:"role_id,omitempty"`
+}
+
+// List lists all the realm group to role mappings.
+func (s *RealmsRoleMappingsServiceOp) List(ctx context.Context, opt *ListOptions) ([]RealmsRoleMapping, *Response, error) {
+	return s.list(ctx, opt, nil)
+}
+
+// GetByID retrieves a realm group to role mapping by id.
+func (s *RealmsRoleMappingsServiceOp) GetByID(ctx context.Context, rrmID string) (*RealmsRoleMapping, *Response, error) {
+	if len(rrmID) < 1 {
+		return nil, nil, NewArgError("rrmID", "cannot be blank")
+	}
+
+	path := fmt.Sprintf("%s%s/", rrmBasePath, rrmID)
+
+	req, err := s.client.NewRequest(ctx, http.MethodGet, path, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	rrm := new(RealmsRoleMapping)
+
+	resp, err := s.client.Do(ctx, req, rrm)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return rrm, resp, err
+}
+
+// GetByRealmGroupUUID retrieves the role mappings of a realm group.
+func (s *RealmsRoleMappingsServiceOp) GetByRealmGroupUUID(ctx context.Context, rgUUID string) ([]RealmsRoleMapping, *Response, error) {
+	if len(rgUUID) < 1 {
+		return nil, nil, NewArgError("rgUUID", "cannot be empty")
+	}
+
+	listRRMOpt := &listRRMOptions{RealmGroupUUID: rgUUID}
+
+	rrms, resp, err := s.list(ctx, nil, listRRMOpt)
+	if err != nil {
+		return nil, resp, err
+	}
+	if len(rrms) < 1 {
+		return nil, resp, nil
+	}
+
+	return rrms, resp, err
+}
+
+// GetByRoleID retrieves the realm group mappings of a role.
+func (s *RealmsRoleMappingsServiceOp) GetByRoleID(ctx context.Context, roleID int) ([]RealmsRoleMapping, *Response, error) {
+	if roleID < 1 {
+		return nil, nil, NewArgError("roleID", "cannot be less than 1")
+	}
+
+	listRRMOpt := &listRRMOptions{RoleID: roleID}
+
+	rrms, resp, err := s.list(ctx, nil, listRRMOpt)
+	if err != nil {
+		return nil, resp, err
+	}
+	if len(rrms) < 1 {
+		return nil, resp, nil
+	}
+
+	return rrms, resp, err
+}
+
+// Create a new realm group to role mapping.
+func (s *RealmsRoleMappingsServiceOp) Create(ctx context.Context, createRequest *RealmsRoleMappingRequest) (*RealmsRoleMapping, *Response, error) {
+	if createRequest == nil {
+		return nil, nil, NewArgError("createRequest", "cannot be nil")
+	}
+
+	req, err := s.client.NewRequest(ctx, http.MethodPost, rrmBasePath, createRequest)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	rrm := new(RealmsRoleMapping)
+	resp, err := s.client.Do(ctx, req, rrm)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return rrm, resp, err
+}
+
+// Update a realm group to role mapping.
+func (s *RealmsRoleMappingsServiceOp) Update(ctx context.Context, rrmID string, updateRequest *RealmsRoleMappingRequest) (*RealmsRoleMapping, *Response, error) {
+	if len(rrmID) < 1 {
+		return nil, nil, NewArgError("rrmID", "cannot be blank")
+	}
+
+	if updateRequest == nil {
+		return nil, nil, NewArgError("updateRequest", "cannot be nil")
+	}
+
+	path := fmt.Sprintf("%s%s/", rrmBasePath, rrmID)
+
+	req, err := s.client.NewRequest(ctx, http.MethodPut, path, updateRequest)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	rrm := new(RealmsRoleMapping)
+	resp, err := s.client.Do(ctx, req, rrm)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return rrm, resp, err
+}
+
+// Delete a realm group to role mapping.
+func (s *RealmsRoleMappingsServiceOp) Delete(ctx context.Context, rrmID string) (*Response, error) {
+	if len(rrmID) < 1 {
+		return nil, NewArgError("rrmID", "cannot be blank")
+	}
+
+	path := fmt.Sprintf("%s%s/", rrmBasePath, rrmID)
+
+	req, err := s.client.NewRequest(ctx, http.MethodDelete, path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.client.Do(ctx, req, nil)
+
+	return resp, err
+}
+
+// Helper method for listing realm group to role mappings
+func (s *RealmsRoleMappingsServiceOp) list(ctx context.Context, opt *ListOptions, rrmOpt *listRRMOptions) ([]RealmsRoleMapping, *Response, error) {
+	path := rrmBasePath
+	path, err := addOptions(path, opt)
+	if err != nil {
+		return nil, nil, err
+	}
+	path, err = addOptions(path, rrmOpt)
+	if err != nil {
+		return nil, nil, err
+	}
+	return resolveAllPages[RealmsRoleMapping](ctx, s.client, path)
+}
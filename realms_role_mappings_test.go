@@ -0,0 +1,187 @@
+package goztl
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+var rrmJSONResponse = `
+{
+    "id": "5d8e1e39-0f1d-4d7b-8d5b-8c2c1d2f7e40",
+    "realm_group": "f6b0b1b8-2d57-4a4c-9c0f-6a8a8e0c1f3e",
+    "role": 3,
+    "created_at": "2022-07-22T01:02:03.444444",
+    "updated_at": "2022-07-22T01:02:03.444444"
+}
+`
+
+func TestRealmsRoleMappingsService_List(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/realms/role_mappings/", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testHeader(t, r, "Accept", "application/json")
+		fmt.Fprint(w, "["+rrmJSONResponse+"]")
+	})
+
+	ctx := context.Background()
+	got, _, err := client.RealmsRoleMappings.List(ctx, nil)
+	if err != nil {
+		t.Errorf("RealmsRoleMappings.List returned error: %v", err)
+	}
+
+	want := []RealmsRoleMapping{
+		{
+			ID:             "5d8e1e39-0f1d-4d7b-8d5b-8c2c1d2f7e40",
+			RealmGroupUUID: "f6b0b1b8-2d57-4a4c-9c0f-6a8a8e0c1f3e",
+			RoleID:         3,
+			Created:        Timestamp{referenceTime},
+			Updated:        Timestamp{referenceTime},
+		},
+	}
+	if !cmp.Equal(got, want) {
+		t.Errorf("RealmsRoleMappings.List returned %+v, want %+v", got, want)
+	}
+}
+
+func TestRealmsRoleMappingsService_GetByID(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/realms/role_mappings/5d8e1e39-0f1d-4d7b-8d5b-8c2c1d2f7e40/", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testHeader(t, r, "Accept", "application/json")
+		fmt.Fprint(w, rrmJSONResponse)
+	})
+
+	ctx := context.Background()
+	got, _, err := client.RealmsRoleMappings.GetByID(ctx, "5d8e1e39-0f1d-4d7b-8d5b-8c2c1d2f7e40")
+	if err != nil {
+		t.Errorf("RealmsRoleMappings.GetByID returned error: %v", err)
+	}
+	if got == nil || got.RoleID != 3 {
+		t.Errorf("RealmsRoleMappings.GetByID returned %+v", got)
+	}
+}
+
+func TestRealmsRoleMappingsService_GetByRealmGroupUUID(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/realms/role_mappings/", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testHeader(t, r, "Accept", "application/json")
+		testQueryArg(t, r, "realm_group_uuid", "f6b0b1b8-2d57-4a4c-9c0f-6a8a8e0c1f3e")
+		fmt.Fprint(w, "["+rrmJSONResponse+"]")
+	})
+
+	ctx := context.Background()
+	got, _, err := client.RealmsRoleMappings.GetByRealmGroupUUID(ctx, "f6b0b1b8-2d57-4a4c-9c0f-6a8a8e0c1f3e")
+	if err != nil {
+		t.Errorf("RealmsRoleMappings.GetByRealmGroupUUID returned error: %v", err)
+	}
+	if len(got) != 1 || got[0].RoleID != 3 {
+		t.Errorf("RealmsRoleMappings.GetByRealmGroupUUID returned %+v", got)
+	}
+}
+
+func TestRealmsRoleMappingsService_GetByRoleID(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/realms/role_mappings/", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testHeader(t, r, "Accept", "application/json")
+		if r.URL.Query().Get("role_id") == "3" {
+			fmt.Fprint(w, "["+rrmJSONResponse+"]")
+		} else {
+			fmt.Fprint(w, "[]")
+		}
+	})
+
+	ctx := context.Background()
+	got, _, err := client.RealmsRoleMappings.GetByRoleID(ctx, 3)
+	if err != nil {
+		t.Errorf("RealmsRoleMappings.GetByRoleID returned error: %v", err)
+	}
+	if len(got) != 1 || got[0].ID != "5d8e1e39-0f1d-4d7b-8d5b-8c2c1d2f7e40" {
+		t.Errorf("RealmsRoleMappings.GetByRoleID returned %+v", got)
+	}
+
+	got, _, err = client.RealmsRoleMappings.GetByRoleID(ctx, 4)
+	if err != nil || got != nil {
+		t.Errorf("RealmsRoleMappings.GetByRoleID returned %+v, %v, want nil, nil", got, err)
+	}
+}
+
+func TestRealmsRoleMappingsService_Create(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/realms/role_mappings/", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testHeader(t, r, "Accept", "application/json")
+		testHeader(t, r, "Content-Type", "application/json")
+		testBody(t, r, `{"realm_group":"f6b0b1b8-2d57-4a4c-9c0f-6a8a8e0c1f3e","role":3}`+"\n")
+		fmt.Fprint(w, rrmJSONResponse)
+	})
+
+	ctx := context.Background()
+	createRequest := &RealmsRoleMappingRequest{
+		RealmGroupUUID: "f6b0b1b8-2d57-4a4c-9c0f-6a8a8e0c1f3e",
+		RoleID:         3,
+	}
+	got, _, err := client.RealmsRoleMappings.Create(ctx, createRequest)
+	if err != nil {
+		t.Errorf("RealmsRoleMappings.Create returned error: %v", err)
+	}
+	if got == nil || got.ID != "5d8e1e39-0f1d-4d7b-8d5b-8c2c1d2f7e40" {
+		t.Errorf("RealmsRoleMappings.Create returned %+v", got)
+	}
+}
+
+func TestRealmsRoleMappingsService_Update(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/realms/role_mappings/5d8e1e39-0f1d-4d7b-8d5b-8c2c1d2f7e40/", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PUT")
+		testHeader(t, r, "Accept", "application/json")
+		testHeader(t, r, "Content-Type", "application/json")
+		testBody(t, r, `{"realm_group":"f6b0b1b8-2d57-4a4c-9c0f-6a8a8e0c1f3e","role":3}`+"\n")
+		fmt.Fprint(w, rrmJSONResponse)
+	})
+
+	ctx := context.Background()
+	updateRequest := &RealmsRoleMappingRequest{
+		RealmGroupUUID: "f6b0b1b8-2d57-4a4c-9c0f-6a8a8e0c1f3e",
+		RoleID:         3,
+	}
+	got, _, err := client.RealmsRoleMappings.Update(ctx, "5d8e1e39-0f1d-4d7b-8d5b-8c2c1d2f7e40", updateRequest)
+	if err != nil {
+		t.Errorf("RealmsRoleMappings.Update returned error: %v", err)
+	}
+	if got == nil || got.RoleID != 3 {
+		t.Errorf("RealmsRoleMappings.Update returned %+v", got)
+	}
+}
+
+func TestRealmsRoleMappingsService_Delete(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/realms/role_mappings/5d8e1e39-0f1d-4d7b-8d5b-8c2c1d2f7e40/", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+	})
+
+	ctx := context.Background()
+	_, err := client.RealmsRoleMappings.Delete(ctx, "5d8e1e39-0f1d-4d7b-8d5b-8c2c1d2f7e40")
+	if err != nil {
+		t.Errorf("RealmsRoleMappings.Delete returned error: %v", err)
+	}
+}
@@ -0,0 +1,200 @@
+package goztl
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+)
+
+const rtmBasePath = "realms/tag_mappings/"
+
+// RealmsTagMappingsService is an interface for interfacing with the realm group
+// to tag mapping endpoints of the Zentral API
+type RealmsTagMappingsService interface {
+	List(context.Context, *ListOptions) ([]RealmsTagMapping, *Response, error)
+	GetByID(context.Context, string) (*RealmsTagMapping, *Response, error)
+	GetByRealmGroupUUID(context.Context, string) ([]RealmsTagMapping, *Response, error)
+	GetByTagID(context.Context, int) ([]RealmsTagMapping, *Response, error)
+	Create(context.Context, *RealmsTagMappingRequest) (*RealmsTagMapping, *Response, error)
+	Update(context.Context, string, *RealmsTagMappingRequest) (*RealmsTagMapping, *Response, error)
+	Delete(context.Context, string) (*Response, error)
+}
+
+// RealmsTagMappingsServiceOp handles communication with the realm group
+// to tag mapping related methods of the Zentral API.
+type RealmsTagMappingsServiceOp struct {
+	client *Client
+}
+
+var _ RealmsTagMappingsService = &RealmsTagMappingsServiceOp{}
+
+// RealmsTagMapping represents a Zentral realm group to tag mapping. The machines of the
+// realm group members are given the tag.
+type RealmsTagMapping struct {
+	ID             string `json:"id"`
+	RealmGroupUUID string `json:"realm_group"`
+	TagID          int    `json:"tag"`
+
+	Created Timestamp `json:"created_at"`
+	Updated Timestamp `json:"updated_at"`
+}
+
+func (m RealmsTagMapping) String() string {
+	return Stringify(m)
+}
+
+// RealmsTagMappingRequest represents a request to create or update a realm group
+// to tag mapping.
+type RealmsTagMappingRequest struct {
+	RealmGroupUUID string `json:"realm_group"`
+	TagID          int    `json:"tag"`
+}
+
+type listRTMOptions struct {
+	RealmGroupUUID string `url:"realm_group_uuid,omitempty"`
+	TagID          int    `url:"tag_id,omitempty"`
+}
+
+// List lists all the realm group to tag mappings.
+func (s *RealmsTagMappingsServiceOp) List(ctx context.Context, opt *ListOptions) ([]RealmsTagMapping, *Response, error) {
+	return s.list(ctx, opt, nil)
+}
+
+// GetByID retrieves a realm group to tag mapping by id.
+func (s *RealmsTagMappingsServiceOp) GetByID(ctx context.Context, rtmID string) (*RealmsTagMapping, *Response, error) {
+	if len(rtmID) < 1 {
+		return nil, nil, NewArgError("rtmID", "cannot be blank")
+	}
+
+	path := fmt.Sprintf("%s%s/", rtmBasePath, rtmID)
+
+	req, err := s.client.NewRequest(ctx, http.MethodGet, path, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	rtm := new(RealmsTagMapping)
+
+	resp, err := s.client.Do(ctx, req, rtm)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return rtm, resp, err
+}
+
+// GetByRealmGroupUUID retrieves the tag mappings of a realm group.
+func (s *RealmsTagMappingsServiceOp) GetByRealmGroupUUID(ctx context.Context, rgUUID string) ([]RealmsTagMapping, *Response, error) {
+	if len(rgUUID) < 1 {
+		return nil, nil, NewArgError("rgUUID", "cannot be empty")
+	}
+
+	listRTMOpt := &listRTMOptions{RealmGroupUUID: rgUUID}
+
+	rtms, resp, err := s.list(ctx, nil, listRTMOpt)
+	if err != nil {
+		return nil, resp, err
+	}
+	if len(rtms) < 1 {
+		return nil, resp, nil
+	}
+
+	return rtms, resp, err
+}
+
+// GetByTagID retrieves the realm group mappings of a tag.
+func (s *RealmsTagMappingsServiceOp) GetByTagID(ctx context.Context, tagID int) ([]RealmsTagMapping, *Response, error) {
+	if tagID < 1 {
+		return nil, nil, NewArgError("tagID", "cannot be less than 1")
+	}
+
+	listRTMOpt := &listRTMOptions{TagID: tagID}
+
+	rtms, resp, err := s.list(ctx, nil, listRTMOpt)
+	if err != nil {
+		return nil, resp, err
+	}
+	if len(rtms) < 1 {
+		return nil, resp, nil
+	}
+
+	return rtms, resp, err
+}
+
+// Create a new realm group to tag mapping.
+func (s *RealmsTagMappingsServiceOp) Create(ctx context.Context, createRequest *RealmsTagMappingRequest) (*RealmsTagMapping, *Response, error) {
+	if createRequest == nil {
+		return nil, nil, NewArgError("createRequest", "cannot be nil")
+	}
+
+	req, err := s.client.NewRequest(ctx, http.MethodPost, rtmBasePath, createRequest)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	rtm := new(RealmsTagMapping)
+	resp, err := s.client.Do(ctx, req, rtm)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return rtm, resp, err
+}
+
+// Update a realm group to tag mapping.
+func (s *RealmsTagMappingsServiceOp) Update(ctx context.Context, rtmID string, updateRequest *RealmsTagMappingRequest) (*RealmsTagMapping, *Response, error) {
+	if len(rtmID) < 1 {
+		return nil, nil, NewArgError("rtmID", "cannot be blank")
+	}
+
+	if updateRequest == nil {
+		return nil, nil, NewArgError("updateRequest", "cannot be nil")
+	}
+
+	path := fmt.Sprintf("%s%s/", rtmBasePath, rtmID)
+
+	req, err := s.client.NewRequest(ctx, http.MethodPut, path, updateRequest)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	rtm := new(RealmsTagMapping)
+	resp, err := s.client.Do(ctx, req, rtm)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return rtm, resp, err
+}
+
+// Delete a realm group to tag mapping.
+func (s *RealmsTagMappingsServiceOp) Delete(ctx context.Context, rtmID string) (*Response, error) {
+	if len(rtmID) < 1 {
+		return nil, NewArgError("rtmID", "cannot be blank")
+	}
+
+	path := fmt.Sprintf("%s%s/", rtmBasePath, rtmID)
+
+	req, err := s.client.NewRequest(ctx, http.MethodDelete, path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.client.Do(ctx, req, nil)
+
+	return resp, err
+}
+
+// Helper method for listing realm group to tag mappings
+func (s *RealmsTagMappingsServiceOp) list(ctx context.Context, opt *ListOptions, rtmOpt *listRTMOptions) ([]RealmsTagMapping, *Response, error) {
+	path := rtmBasePath
+	path, err := addOptions(path, opt)
+	if err != nil {
+		return nil, nil, err
+	}
+	path, err = addOptions(path, rtmOpt)
+	if err != nil {
+		return nil, nil, err
+	}
+	return resolveAllPages[RealmsTagMapping](ctx, s.client, path)
+}
@@ -0,0 +1,187 @@
+package goztl
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+var rtmJSONResponse = `
+{
+    "id": "8a3f2c61-7b4e-4f0a-a1d9-2e6c5b7d9f12",
+    "realm_group": "f6b0b1b8-2d57-4a4c-9c0f-6a8a8e0c1f3e",
+    "tag": 3,
+    "created_at": "2022-07-22T01:02:03.444444",
+    "updated_at": "2022-07-22T01:02:03.444444"
+}
+`
+
+func TestRealmsTagMappingsService_List(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/realms/tag_mappings/", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testHeader(t, r, "Accept", "application/json")
+		fmt.Fprint(w, "["+rtmJSONResponse+"]")
+	})
+
+	ctx := context.Background()
+	got, _, err := client.RealmsTagMappings.List(ctx, nil)
+	if err != nil {
+		t.Errorf("RealmsTagMappings.List returned error: %v", err)
+	}
+
+	want := []RealmsTagMapping{
+		{
+			ID:             "8a3f2c61-7b4e-4f0a-a1d9-2e6c5b7d9f12",
+			RealmGroupUUID: "f6b0b1b8-2d57-4a4c-9c0f-6a8a8e0c1f3e",
+			TagID:          3,
+			Created:        Timestamp{referenceTime},
+			Updated:        Timestamp{referenceTime},
+		},
+	}
+	if !cmp.Equal(got, want) {
+		t.Errorf("RealmsTagMappings.List returned %+v, want %+v", got, want)
+	}
+}
+
+func TestRealmsTagMappingsService_GetByID(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/realms/tag_mappings/8a3f2c61-7b4e-4f0a-a1d9-2e6c5b7d9f12/", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testHeader(t, r, "Accept", "application/json")
+		fmt.Fprint(w, rtmJSONResponse)
+	})
+
+	ctx := context.Background()
+	got, _, err := client.RealmsTagMappings.GetByID(ctx, "8a3f2c61-7b4e-4f0a-a1d9-2e6c5b7d9f12")
+	if err != nil {
+		t.Errorf("RealmsTagMappings.GetByID returned error: %v", err)
+	}
+	if got == nil || got.TagID != 3 {
+		t.Errorf("RealmsTagMappings.GetByID returned %+v", got)
+	}
+}
+
+func TestRealmsTagMappingsService_GetByRealmGroupUUID(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/realms/tag_mappings/", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testHeader(t, r, "Accept", "application/json")
+		testQueryArg(t, r, "realm_group_uuid", "f6b0b1b8-2d57-4a4c-9c0f-6a8a8e0c1f3e")
+		fmt.Fprint(w, "["+rtmJSONResponse+"]")
+	})
+
+	ctx := context.Background()
+	got, _, err := client.RealmsTagMappings.GetByRealmGroupUUID(ctx, "f6b0b1b8-2d57-4a4c-9c0f-6a8a8e0c1f3e")
+	if err != nil {
+		t.Errorf("RealmsTagMappings.GetByRealmGroupUUID returned error: %v", err)
+	}
+	if len(got) != 1 || got[0].TagID != 3 {
+		t.Errorf("RealmsTagMappings.GetByRealmGroupUUID returned %+v", got)
+	}
+}
+
+func TestRealmsTagMappingsService_GetByTagID(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/realms/tag_mappings/", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testHeader(t, r, "Accept", "application/json")
+		if r.URL.Query().Get("tag_id") == "3" {
+			fmt.Fprint(w, "["+rtmJSONResponse+"]")
+		} else {
+			fmt.Fprint(w, "[]")
+		}
+	})
+
+	ctx := context.Background()
+	got, _, err := client.RealmsTagMappings.GetByTagID(ctx, 3)
+	if err != nil {
+		t.Errorf("RealmsTagMappings.GetByTagID returned error: %v", err)
+	}
+	if len(got) != 1 || got[0].ID != "8a3f2c61-7b4e-4f0a-a1d9-2e6c5b7d9f12" {
+		t.Errorf("RealmsTagMappings.GetByTagID returned %+v", got)
+	}
+
+	got, _, err = client.RealmsTagMappings.GetByTagID(ctx, 4)
+	if err != nil || got != nil {
+		t.Errorf("RealmsTagMappings.GetByTagID returned %+v, %v, want nil, nil", got, err)
+	}
+}
+
+func TestRealmsTagMappingsService_Create(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/realms/tag_mappings/", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testHeader(t, r, "Accept", "application/json")
+		testHeader(t, r, "Content-Type", "application/json")
+		testBody(t, r, `{"realm_group":"f6b0b1b8-2d57-4a4c-9c0f-6a8a8e0c1f3e","tag":3}`+"\n")
+		fmt.Fprint(w, rtmJSONResponse)
+	})
+
+	ctx := context.Background()
+	createRequest := &RealmsTagMappingRequest{
+		RealmGroupUUID: "f6b0b1b8-2d57-4a4c-9c0f-6a8a8e0c1f3e",
+		TagID:          3,
+	}
+	got, _, err := client.RealmsTagMappings.Create(ctx, createRequest)
+	if err != nil {
+		t.Errorf("RealmsTagMappings.Create returned error: %v", err)
+	}
+	if got == nil || got.ID != "8a3f2c61-7b4e-4f0a-a1d9-2e6c5b7d9f12" {
+		t.Errorf("RealmsTagMappings.Create returned %+v", got)
+	}
+}
+
+func TestRealmsTagMappingsService_Update(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/realms/tag_mappings/8a3f2c61-7b4e-4f0a-a1d9-2e6c5b7d9f12/", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PUT")
+		testHeader(t, r, "Accept", "application/json")
+		testHeader(t, r, "Content-Type", "application/json")
+		testBody(t, r, `{"realm_group":"f6b0b1b8-2d57-4a4c-9c0f-6a8a8e0c1f3e","tag":3}`+"\n")
+		fmt.Fprint(w, rtmJSONResponse)
+	})
+
+	ctx := context.Background()
+	updateRequest := &RealmsTagMappingRequest{
+		RealmGroupUUID: "f6b0b1b8-2d57-4a4c-9c0f-6a8a8e0c1f3e",
+		TagID:          3,
+	}
+	got, _, err := client.RealmsTagMappings.Update(ctx, "8a3f2c61-7b4e-4f0a-a1d9-2e6c5b7d9f12", updateRequest)
+	if err != nil {
+		t.Errorf("RealmsTagMappings.Update returned error: %v", err)
+	}
+	if got == nil || got.TagID != 3 {
+		t.Errorf("RealmsTagMappings.Update returned %+v", got)
+	}
+}
+
+func TestRealmsTagMappingsService_Delete(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/realms/tag_mappings/8a3f2c61-7b4e-4f0a-a1d9-2e6c5b7d9f12/", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+	})
+
+	ctx := context.Background()
+	_, err := client.RealmsTagMappings.Delete(ctx, "8a3f2c61-7b4e-4f0a-a1d9-2e6c5b7d9f12")
+	if err != nil {
+		t.Errorf("RealmsTagMappings.Delete returned error: %v", err)
+	}
+}
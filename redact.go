@@ -0,0 +1,121 @@
+package goztl
+
+import (
+	"encoding/json"
+	"reflect"
+	"strconv"
+)
+
+// RedactedValue replaces the values of the secret fields.
+const RedactedValue = "<redacted>"
+
+// The struct fields holding credentials are tagged with ztl:"secret".
+const (
+	secretTagKey   = "ztl"
+	secretTagValue = "secret"
+)
+
+func isSecretField(sf reflect.StructField) bool {
+	return sf.Tag.Get(secretTagKey) == secretTagValue
+}
+
+// Redact returns a deep copy of v, with the values of the secret fields replaced by RedactedValue.
+// The secret fields that are not strings or string pointers are set to their zero value. Unset
+// secret fields are left unset. The unknown fields kept in Extras cannot be told apart from the
+// secrets, so all their values are replaced by RedactedValue. v is not modified.
+func Redact[T any](v T) T {
+	src := reflect.ValueOf(&v).Elem()
+	dst := reflect.New(src.Type()).Elem()
+	redactValue(dst, src)
+	return dst.Interface().(T)
+}
+
+func redactValue(dst, src reflect.Value) {
+	switch src.Kind() {
+	case reflect.Ptr:
+		if src.IsNil() {
+			return
+		}
+		dst.Set(reflect.New(src.Type().Elem()))
+		redactValue(dst.Elem(), src.Elem())
+	case reflect.Interface:
+		if src.IsNil() {
+			return
+		}
+		elem := reflect.New(src.Elem().Type()).Elem()
+		redactValue(elem, src.Elem())
+		dst.Set(elem)
+	case reflect.Slice:
+		if src.IsNil() {
+			return
+		}
+		dst.Set(reflect.MakeSlice(src.Type(), src.Len(), src.Len()))
+		for i := 0; i < src.Len(); i++ {
+			redactValue(dst.Index(i), src.Index(i))
+		}
+	case reflect.Array:
+		for i := 0; i < src.Len(); i++ {
+			redactValue(dst.Index(i), src.Index(i))
+		}
+	case reflect.Map:
+		if src.IsNil() {
+			return
+		}
+		dst.Set(reflect.MakeMapWithSize(src.Type(), src.Len()))
+		iter := src.MapRange()
+		for iter.Next() {
+			val := reflect.New(src.Type().Elem()).Elem()
+			redactValue(val, iter.Value())
+			dst.SetMapIndex(iter.Key(), val)
+		}
+	case reflect.Struct:
+		// copy the unexported fields too
+		dst.Set(src)
+		t := src.Type()
+		for i := 0; i < src.NumField(); i++ {
+			sf := t.Field(i)
+			if !sf.IsExported() {
+				continue
+			}
+			if isSecretField(sf) {
+				redactSecret(dst.Field(i), src.Field(i))
+				continue
+			}
+			if sf.Type == extrasType {
+				redactExtras(dst.Field(i), src.Field(i))
+				continue
+			}
+			redactValue(dst.Field(i), src.Field(i))
+		}
+	default:
+		dst.Set(src)
+	}
+}
+
+func redactSecret(dst, src reflect.Value) {
+	dst.Set(reflect.Zero(dst.Type()))
+	if src.IsZero() {
+		return
+	}
+	switch {
+	case src.Kind() == reflect.String:
+		dst.SetString(RedactedValue)
+	case src.Kind() == reflect.Ptr && src.Type().Elem().Kind() == reflect.String:
+		dst.Set(reflect.New(src.Type().Elem()))
+		dst.Elem().SetString(RedactedValue)
+	}
+}
+
+// redactExtras replaces the values of the unknown fields by RedactedValue, keeping their keys.
+func redactExtras(dst, src reflect.Value) {
+	e := src.Interface().(Extras)
+	if e.Extra == nil {
+		return
+	}
+	redacted := json.RawMessage(strconv.Quote(RedactedValue))
+	extra := make(map[string]json.RawMessage, len(e.Extra))
+	for k := range e.Extra {
+		extra[k] = redacted
+	}
+	dst.Set(reflect.ValueOf(Extras{Extra: extra}))
+}
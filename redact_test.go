@@ -0,0 +1,106 @@
+package goztl
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestStringifySecrets(t *testing.T) {
+	r := RealmsRealm{
+		Name:          "Okta",
+		LDAPConfig:    &LDAPConfig{Host: "ldap.example.com", BindPassword: "yolo"},
+		OpenIDCConfig: &OpenIDCConfig{ClientID: "fomo"},
+	}
+
+	got := r.String()
+	if strings.Contains(got, "yolo") {
+		t.Errorf("RealmsRealm.String returned %s, with secret", got)
+	}
+	if !strings.Contains(got, "BindPassword:"+RedactedValue) {
+		t.Errorf("RealmsRealm.String returned %s, without redacted bind password", got)
+	}
+	if !strings.Contains(got, `ClientID:"fomo"`) {
+		t.Errorf("RealmsRealm.String returned %s, without client ID", got)
+	}
+}
+
+func TestRedact(t *testing.T) {
+	s := &Store{
+		Name: "Splunk",
+		Splunk: &StoreSplunk{
+			HECURL:          "https://splunk.example.com",
+			HECToken:        "yolo",
+			HECExtraHeaders: []HTTPHeader{{Name: "X-Yolo", Value: "fomo"}},
+			SearchToken:     String("fomo"),
+		},
+		Created: Timestamp{referenceTime},
+	}
+
+	got := Redact(s)
+
+	want := &Store{
+		Name: "Splunk",
+		Splunk: &StoreSplunk{
+			HECURL:          "https://splunk.example.com",
+			HECToken:        RedactedValue,
+			HECExtraHeaders: []HTTPHeader{{Name: "X-Yolo", Value: RedactedValue}},
+			SearchToken:     String(RedactedValue),
+		},
+		Created: Timestamp{referenceTime},
+	}
+	if !cmp.Equal(got, want) {
+		t.Errorf("Redact returned %+v, want %+v", got, want)
+	}
+
+	// the original is not modified
+	if s.Splunk.HECToken != "yolo" || *s.Splunk.SearchToken != "fomo" || s.Splunk.HECExtraHeaders[0].Value != "fomo" {
+		t.Errorf("Redact modified its argument: %+v", s.Splunk)
+	}
+}
+
+func TestRedactUnsetSecrets(t *testing.T) {
+	r := RealmsRealm{Name: "Okta", OpenIDCConfig: &OpenIDCConfig{ClientID: "fomo"}}
+
+	got := Redact(r)
+	if !cmp.Equal(got, r) {
+		t.Errorf("Redact returned %+v, want %+v", got, r)
+	}
+}
+
+func TestRedactInterface(t *testing.T) {
+	var v interface{} = []EnrollmentSecret{{ID: 1, Secret: "yolo"}}
+
+	got := Redact(v)
+
+	want := []EnrollmentSecret{{ID: 1, Secret: RedactedValue}}
+	if !cmp.Equal(got, want) {
+		t.Errorf("Redact returned %+v, want %+v", got, want)
+	}
+}
+
+func TestRedactExtras(t *testing.T) {
+	// a store with a backend unknown to goztl
+	s := &Store{
+		Name:    "Datadog",
+		Backend: "DATADOG",
+		Extras: Extras{Extra: map[string]json.RawMessage{
+			"datadog_kwargs": json.RawMessage(`{"api_key":"SUPERSECRET"}`),
+		}},
+	}
+
+	if got := s.String(); strings.Contains(got, "SUPERSECRET") || !strings.Contains(got, "Extra:[datadog_kwargs]") {
+		t.Errorf("Store.String returned %s", got)
+	}
+
+	got := Redact(s)
+	want := map[string]json.RawMessage{"datadog_kwargs": json.RawMessage(`"` + RedactedValue + `"`)}
+	if !cmp.Equal(got.Extra, want) {
+		t.Errorf("Redact returned extras %s, want %s", got.Extra, want)
+	}
+	if string(s.Extra["datadog_kwargs"]) != `{"api_key":"SUPERSECRET"}` {
+		t.Errorf("Redact modified its argument: %s", s.Extra)
+	}
+}
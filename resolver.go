@@ -0,0 +1,352 @@
+package goztl
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// Kinds of objects resolved by the Resolver.
+const (
+	ResolverKindMDMACMEIssuer       = "MDM ACME issuer"
+	ResolverKindMDMBlueprint        = "MDM blueprint"
+	ResolverKindMDMDEPVirtualServer = "MDM DEP virtual server"
+	ResolverKindMDMPackage          = "MDM package"
+	ResolverKindMDMPushCertificate  = "MDM push certificate"
+	ResolverKindMDMSCEPIssuer       = "MDM SCEP issuer"
+	ResolverKindMetaBusinessUnit    = "meta business unit"
+	ResolverKindRealm               = "realm"
+	ResolverKindTag                 = "tag"
+	ResolverKindTaxonomy            = "taxonomy"
+)
+
+// UnresolvedNamesError is returned by the Resolver when some names cannot be resolved. All the
+// names of a batch are reported together.
+type UnresolvedNamesError struct {
+	Kind string
+
+	// Names without matching object
+	Unknown []string
+
+	// Names with more than one matching object
+	Ambiguous []string
+}
+
+var _ error = &UnresolvedNamesError{}
+
+func (e *UnresolvedNamesError) Error() string {
+	var parts []string
+	if len(e.Unknown) > 0 {
+		parts = append(parts, fmt.Sprintf("unknown %s names: %s", e.Kind, strings.Join(e.Unknown, ", ")))
+	}
+	if len(e.Ambiguous) > 0 {
+		parts = append(parts, fmt.Sprintf("ambiguous %s names: %s", e.Kind, strings.Join(e.Ambiguous, ", ")))
+	}
+	return strings.Join(parts, "; ")
+}
+
+// Resolver resolves the names of Zentral objects to their IDs, using the GetByName methods of
+// the client services. The results are cached for the lifetime of the Resolver. A Resolver is safe
+// for concurrent use.
+type Resolver struct {
+	client *Client
+
+	// Create the tags that do not exist, without taxonomy and meta business unit.
+	CreateMissingTags bool
+
+	// Create the taxonomies that do not exist, without meta business unit.
+	CreateMissingTaxonomies bool
+
+	mu    sync.Mutex
+	cache map[string]map[string]string
+}
+
+// NewResolver returns a new Resolver using the services of client.
+func NewResolver(client *Client) *Resolver {
+	return &Resolver{
+		client: client,
+		cache:  make(map[string]map[string]string),
+	}
+}
+
+// resolverLookup returns the IDs of the objects matching a name.
+type resolverLookup func(context.Context, string) ([]string, error)
+
+// Reset empties the cache.
+func (r *Resolver) Reset() {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.cache = make(map[string]map[string]string)
+}
+
+func (r *Resolver) cached(kind, name string) (string, bool) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	id, ok := r.cache[kind][name]
+	return id, ok
+}
+
+func (r *Resolver) store(kind, name, id string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.cache[kind] == nil {
+		r.cache[kind] = make(map[string]string)
+	}
+	r.cache[kind][name] = id
+}
+
+// resolve returns the IDs of the names, in the same order.
+func (r *Resolver) resolve(ctx context.Context, kind string, names []string, lookup resolverLookup) ([]string, error) {
+	ids := make([]string, len(names))
+	ue := &UnresolvedNamesError{Kind: kind}
+	seen := make(map[string]bool)
+	for i, name := range names {
+		if len(name) < 1 {
+			return nil, NewArgError("names", "cannot contain blank names")
+		}
+		if id, ok := r.cached(kind, name); ok {
+			ids[i] = id
+			continue
+		}
+		if seen[name] {
+			// already reported
+			continue
+		}
+		seen[name] = true
+		found, err := lookup(ctx, name)
+		if err != nil {
+			return nil, err
+		}
+		switch len(found) {
+		case 0:
+			ue.Unknown = append(ue.Unknown, name)
+		case 1:
+			r.store(kind, name, found[0])
+			ids[i] = found[0]
+		default:
+			ue.Ambiguous = append(ue.Ambiguous, name)
+		}
+	}
+	if len(ue.Unknown) > 0 || len(ue.Ambiguous) > 0 {
+		sort.Strings(ue.Unknown)
+		sort.Strings(ue.Ambiguous)
+		return nil, ue
+	}
+	return ids, nil
+}
+
+func (r *Resolver) resolveInts(ctx context.Context, kind string, names []string, lookup resolverLookup) ([]int, error) {
+	sids, err := r.resolve(ctx, kind, names, lookup)
+	if err != nil {
+		return nil, err
+	}
+	ids := make([]int, len(sids))
+	for i, sid := range sids {
+		id, err := strconv.Atoi(sid)
+		if err != nil {
+			return nil, err
+		}
+		ids[i] = id
+	}
+	return ids, nil
+}
+
+func (r *Resolver) resolveOneInt(ctx context.Context, kind string, name string, lookup resolverLookup) (int, error) {
+	ids, err := r.resolveInts(ctx, kind, []string{name}, lookup)
+	if err != nil {
+		return 0, err
+	}
+	return ids[0], nil
+}
+
+func (r *Resolver) resolveOne(ctx context.Context, kind string, name string, lookup resolverLookup) (string, error) {
+	ids, err := r.resolve(ctx, kind, []string{name}, lookup)
+	if err != nil {
+		return "", err
+	}
+	return ids[0], nil
+}
+
+// Lookups
+
+func (r *Resolver) lookupTag(ctx context.Context, name string) ([]string, error) {
+	t, _, err := r.client.Tags.GetByName(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+	if t == nil && r.CreateMissingTags {
+		t, _, err = r.client.Tags.Create(ctx, &TagCreateRequest{Name: name})
+		if err != nil {
+			return nil, err
+		}
+	}
+	if t == nil {
+		return nil, nil
+	}
+	return []string{strconv.Itoa(t.ID)}, nil
+}
+
+func (r *Resolver) lookupTaxonomy(ctx context.Context, name string) ([]string, error) {
+	t, _, err := r.client.Taxonomies.GetByName(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+	if t == nil && r.CreateMissingTaxonomies {
+		t, _, err = r.client.Taxonomies.Create(ctx, &TaxonomyCreateRequest{Name: name})
+		if err != nil {
+			return nil, err
+		}
+	}
+	if t == nil {
+		return nil, nil
+	}
+	return []string{strconv.Itoa(t.ID)}, nil
+}
+
+func (r *Resolver) lookupMetaBusinessUnit(ctx context.Context, name string) ([]string, error) {
+	mbu, _, err := r.client.MetaBusinessUnits.GetByName(ctx, name)
+	if err != nil || mbu == nil {
+		return nil, err
+	}
+	return []string{strconv.Itoa(mbu.ID)}, nil
+}
+
+func (r *Resolver) lookupMDMBlueprint(ctx context.Context, name string) ([]string, error) {
+	mb, _, err := r.client.MDMBlueprints.GetByName(ctx, name)
+	if err != nil || mb == nil {
+		return nil, err
+	}
+	return []string{strconv.Itoa(mb.ID)}, nil
+}
+
+func (r *Resolver) lookupMDMPushCertificate(ctx context.Context, name string) ([]string, error) {
+	mpc, _, err := r.client.MDMPushCertificates.GetByName(ctx, name)
+	if err != nil || mpc == nil {
+		return nil, err
+	}
+	return []string{strconv.Itoa(mpc.ID)}, nil
+}
+
+func (r *Resolver) lookupMDMDEPVirtualServer(ctx context.Context, name string) ([]string, error) {
+	vss, _, err := r.client.MDMDEPVirtualServers.GetByName(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+	var ids []string
+	for _, vs := range vss {
+		ids = append(ids, strconv.Itoa(vs.ID))
+	}
+	return ids, nil
+}
+
+func (r *Resolver) lookupMDMSCEPIssuer(ctx context.Context, name string) ([]string, error) {
+	msi, _, err := r.client.MDMSCEPIssuers.GetByName(ctx, name)
+	if err != nil || msi == nil {
+		return nil, err
+	}
+	return []string{msi.ID}, nil
+}
+
+func (r *Resolver) lookupMDMACMEIssuer(ctx context.Context, name string) ([]string, error) {
+	mai, _, err := r.client.MDMACMEIssuers.GetByName(ctx, name)
+	if err != nil || mai == nil {
+		return nil, err
+	}
+	return []string{mai.ID}, nil
+}
+
+func (r *Resolver) lookupMDMPackage(ctx context.Context, name string) ([]string, error) {
+	mps, _, err := r.client.MDMPackages.GetByName(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+	var ids []string
+	for _, mp := range mps {
+		ids = append(ids, mp.ID)
+	}
+	return ids, nil
+}
+
+func (r *Resolver) lookupRealm(ctx context.Context, name string) ([]string, error) {
+	realm, _, err := r.client.RealmsRealms.GetByName(ctx, name)
+	if err != nil || realm == nil {
+		return nil, err
+	}
+	return []string{realm.UUID}, nil
+}
+
+// Public methods
+
+// TagIDs resolves tag names to tag IDs.
+func (r *Resolver) TagIDs(ctx context.Context, names []string) ([]int, error) {
+	return r.resolveInts(ctx, ResolverKindTag, names, r.lookupTag)
+}
+
+// TagID resolves a tag name to a tag ID.
+func (r *Resolver) TagID(ctx context.Context, name string) (int, error) {
+	return r.resolveOneInt(ctx, ResolverKindTag, name, r.lookupTag)
+}
+
+// TaxonomyIDs resolves taxonomy names to taxonomy IDs.
+func (r *Resolver) TaxonomyIDs(ctx context.Context, names []string) ([]int, error) {
+	return r.resolveInts(ctx, ResolverKindTaxonomy, names, r.lookupTaxonomy)
+}
+
+// TaxonomyID resolves a taxonomy name to a taxonomy ID.
+func (r *Resolver) TaxonomyID(ctx context.Context, name string) (int, error) {
+	return r.resolveOneInt(ctx, ResolverKindTaxonomy, name, r.lookupTaxonomy)
+}
+
+// MetaBusinessUnitIDs resolves meta business unit names to meta business unit IDs.
+func (r *Resolver) MetaBusinessUnitIDs(ctx context.Context, names []string) ([]int, error) {
+	return r.resolveInts(ctx, ResolverKindMetaBusinessUnit, names, r.lookupMetaBusinessUnit)
+}
+
+// MetaBusinessUnitID resolves a meta business unit name to a meta business unit ID.
+func (r *Resolver) MetaBusinessUnitID(ctx context.Context, name string) (int, error) {
+	return r.resolveOneInt(ctx, ResolverKindMetaBusinessUnit, name, r.lookupMetaBusinessUnit)
+}
+
+// MDMBlueprintID resolves a MDM blueprint name to a MDM blueprint ID.
+func (r *Resolver) MDMBlueprintID(ctx context.Context, name string) (int, error) {
+	return r.resolveOneInt(ctx, ResolverKindMDMBlueprint, name, r.lookupMDMBlueprint)
+}
+
+// MDMPushCertificateID resolves a MDM push certificate name to a MDM push certificate ID.
+func (r *Resolver) MDMPushCertificateID(ctx context.Context, name string) (int, error) {
+	return r.resolveOneInt(ctx, ResolverKindMDMPushCertificate, name, r.lookupMDMPushCertificate)
+}
+
+// MDMDEPVirtualServerID resolves a MDM DEP virtual server name to a MDM DEP virtual server ID.
+func (r *Resolver) MDMDEPVirtualServerID(ctx context.Context, name string) (int, error) {
+	return r.resolveOneInt(ctx, ResolverKindMDMDEPVirtualServer, name, r.lookupMDMDEPVirtualServer)
+}
+
+// MDMSCEPIssuerUUID resolves a MDM SCEP issuer name to a MDM SCEP issuer UUID.
+func (r *Resolver) MDMSCEPIssuerUUID(ctx context.Context, name string) (string, error) {
+	return r.resolveOne(ctx, ResolverKindMDMSCEPIssuer, name, r.lookupMDMSCEPIssuer)
+}
+
+// MDMACMEIssuerUUID resolves a MDM ACME issuer name to a MDM ACME issuer UUID.
+func (r *Resolver) MDMACMEIssuerUUID(ctx context.Context, name string) (string, error) {
+	return r.resolveOne(ctx, ResolverKindMDMACMEIssuer, name, r.lookupMDMACMEIssuer)
+}
+
+// MDMPackageIDs resolves MDM package names to MDM package IDs. Package names are not unique
+// server-side, so a name matching more than one package is reported as ambiguous.
+func (r *Resolver) MDMPackageIDs(ctx context.Context, names []string) ([]string, error) {
+	return r.resolve(ctx, ResolverKindMDMPackage, names, r.lookupMDMPackage)
+}
+
+// MDMPackageID resolves a MDM package name to a MDM package ID.
+func (r *Resolver) MDMPackageID(ctx context.Context, name string) (string, error) {
+	return r.resolveOne(ctx, ResolverKindMDMPackage, name, r.lookupMDMPackage)
+}
+
+// RealmUUID resolves a realm name to a realm UUID.
+func (r *Resolver) RealmUUID(ctx context.Context, name string) (string, error) {
+	return r.resolveOne(ctx, ResolverKindRealm, name, r.lookupRealm)
+}
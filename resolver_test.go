@@ -0,0 +1,148 @@
+package goztl
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestResolver_TagIDs(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	requests := 0
+	mux.HandleFunc("/inventory/tags/", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		requests++
+		switch r.URL.Query().Get("name") {
+		case "yolo":
+			fmt.Fprint(w, `[{"id":1,"name":"yolo","slug":"yolo","color":"0079bf"}]`)
+		case "fomo":
+			fmt.Fprint(w, `[{"id":2,"name":"fomo","slug":"fomo","color":"0079bf"}]`)
+		default:
+			fmt.Fprint(w, `[]`)
+		}
+	})
+
+	ctx := context.Background()
+	resolver := NewResolver(client)
+	got, err := resolver.TagIDs(ctx, []string{"yolo", "fomo", "yolo"})
+	if err != nil {
+		t.Fatalf("Resolver.TagIDs returned error: %v", err)
+	}
+
+	want := []int{1, 2, 1}
+	if !cmp.Equal(got, want) {
+		t.Errorf("Resolver.TagIDs returned %+v, want %+v", got, want)
+	}
+
+	id, err := resolver.TagID(ctx, "fomo")
+	if err != nil {
+		t.Fatalf("Resolver.TagID returned error: %v", err)
+	}
+	if id != 2 {
+		t.Errorf("Resolver.TagID returned %d, want 2", id)
+	}
+
+	if requests != 2 {
+		t.Errorf("Resolver made %d requests, want 2", requests)
+	}
+}
+
+func TestResolver_UnknownNames(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/inventory/tags/", func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("name") == "yolo" {
+			fmt.Fprint(w, `[{"id":1,"name":"yolo","slug":"yolo","color":"0079bf"}]`)
+			return
+		}
+		fmt.Fprint(w, `[]`)
+	})
+
+	ctx := context.Background()
+	_, err := NewResolver(client).TagIDs(ctx, []string{"zorg", "yolo", "fomo"})
+
+	var ue *UnresolvedNamesError
+	if !errors.As(err, &ue) {
+		t.Fatalf("Resolver.TagIDs returned error %v, want UnresolvedNamesError", err)
+	}
+	want := &UnresolvedNamesError{Kind: ResolverKindTag, Unknown: []string{"fomo", "zorg"}}
+	if !cmp.Equal(ue, want) {
+		t.Errorf("Resolver.TagIDs returned error %+v, want %+v", ue, want)
+	}
+	if got := ue.Error(); got != "unknown tag names: fomo, zorg" {
+		t.Errorf("UnresolvedNamesError.Error returned %q", got)
+	}
+}
+
+func TestResolver_AmbiguousMDMPackage(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/mdm/packages/", func(w http.ResponseWriter, r *http.Request) {
+		testQueryArg(t, r, "name", "yolo")
+		fmt.Fprint(w, `[{"id":"1","name":"yolo"},{"id":"2","name":"yolo"}]`)
+	})
+
+	ctx := context.Background()
+	_, err := NewResolver(client).MDMPackageID(ctx, "yolo")
+
+	var ue *UnresolvedNamesError
+	if !errors.As(err, &ue) {
+		t.Fatalf("Resolver.MDMPackageID returned error %v, want UnresolvedNamesError", err)
+	}
+	want := &UnresolvedNamesError{Kind: ResolverKindMDMPackage, Ambiguous: []string{"yolo"}}
+	if !cmp.Equal(ue, want) {
+		t.Errorf("Resolver.MDMPackageID returned error %+v, want %+v", ue, want)
+	}
+}
+
+func TestResolver_CreateMissingTags(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/inventory/tags/", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodPost {
+			testBody(t, r, `{"name":"yolo","taxonomy":null,"meta_business_unit":null}`+"\n")
+			fmt.Fprint(w, `{"id":3,"name":"yolo","slug":"yolo","color":"0079bf"}`)
+			return
+		}
+		fmt.Fprint(w, `[]`)
+	})
+
+	ctx := context.Background()
+	resolver := NewResolver(client)
+	resolver.CreateMissingTags = true
+	got, err := resolver.TagID(ctx, "yolo")
+	if err != nil {
+		t.Fatalf("Resolver.TagID returned error: %v", err)
+	}
+	if got != 3 {
+		t.Errorf("Resolver.TagID returned %d, want 3", got)
+	}
+}
+
+func TestResolver_RealmUUID(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/realms/realms/", func(w http.ResponseWriter, r *http.Request) {
+		testQueryArg(t, r, "name", "Okta")
+		fmt.Fprint(w, `[{"uuid":"e2ef0a4b-54bd-4ea4-bbb1-bf05ef4c6e5b","name":"Okta"}]`)
+	})
+
+	ctx := context.Background()
+	got, err := NewResolver(client).RealmUUID(ctx, "Okta")
+	if err != nil {
+		t.Fatalf("Resolver.RealmUUID returned error: %v", err)
+	}
+	if got != "e2ef0a4b-54bd-4ea4-bbb1-bf05ef4c6e5b" {
+		t.Errorf("Resolver.RealmUUID returned %q", got)
+	}
+}
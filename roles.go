@@ -0,0 +1,333 @@
+package goztl
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+)
+
+const rolesBasePath = "accounts/roles/"
+
+// RolesService is an interface for interfacing with the roles
+// endpoints of the Zentral API
+type RolesService interface {
+	List(context.Context, *ListOptions) ([]Role, *Response, error)
+	GetByID(context.Context, int) (*Role, *Response, error)
+	GetByName(context.Context, string) (*Role, *Response, error)
+	Create(context.Context, *RoleRequest) (*Role, *Response, error)
+	Update(context.Context, int, *RoleRequest) (*Role, *Response, error)
+	Delete(context.Context, int) (*Response, error)
+	ListPermissions(context.Context, int) ([]string, *Response, error)
+	SetPermissions(context.Context, int, []string) (*Role, *Response, error)
+}
+
+// RolesServiceOp handles communication with the roles related
+// methods of the Zentral API.
+type RolesServiceOp struct {
+	client *Client
+}
+
+var _ RolesService = &RolesServiceOp{}
+
+// Role represents a Zentral role
+type Role struct {
+	ID   int    `json:"id"`
+	Name string `json:"name"`
+
+	// Permissions granted by the role, as "app_label.codename", e.g. "santa.add_rule"
+	Permissions []string `json:"permissions"`
+}
+
+func (r Role) String() string {
+	return Stringify(r)
+}
+
+// RoleRequest represents a request to create or update a role
+type RoleRequest struct {
+	Name        string   `json:"name"`
+	Permissions []string `json:"permissions"`
+}
+
+type listRoleOptions struct {
+	Name string `url:"name,omitempty"`
+}
+
+// List lists all the roles.
+func (s *RolesServiceOp) List(ctx context.Context, opt *ListOptions) ([]Role, *Response, error) {
+	return s.list(ctx, opt, nil)
+}
+
+// GetByID retrieves a role by id.
+func (s *RolesServiceOp) GetByID(ctx context.Context, roleID int) (*Role, *Response, error) {
+	if roleID < 1 {
+		return nil, nil, NewArgError("roleID", "cannot be less than 1")
+	}
+
+	path := fmt.Sprintf("%s%d/", rolesBasePath, roleID)
+
+	req, err := s.client.NewRequest(ctx, http.MethodGet, path, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	role := new(Role)
+
+	resp, err := s.client.Do(ctx, req, role)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return role, resp, err
+}
+
+// GetByName retrieves a role by name.
+func (s *RolesServiceOp) GetByName(ctx context.Context, name string) (*Role, *Response, error) {
+	if len(name) < 1 {
+		return nil, nil, NewArgError("name", "cannot be blank")
+	}
+
+	listRoleOpt := &listRoleOptions{Name: name}
+
+	roles, resp, err := s.list(ctx, nil, listRoleOpt)
+	if err != nil {
+		return nil, resp, err
+	}
+	if len(roles) < 1 {
+		return nil, resp, nil
+	}
+
+	return &roles[0], resp, err
+}
+
+// Create a new role.
+func (s *RolesServiceOp) Create(ctx context.Context, createRequest *RoleRequest) (*Role, *Response, error) {
+	if createRequest == nil {
+		return nil, nil, NewArgError("createRequest", "cannot be nil")
+	}
+
+	req, err := s.client.NewRequest(ctx, http.MethodPost, rolesBasePath, createRequest)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	role := new(Role)
+	resp, err := s.client.Do(ctx, req, role)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return role, resp, err
+}
+
+// Update a role.
+func (s *RolesServiceOp) Update(ctx context.Context, roleID int, updateRequest *RoleRequest) (*Role, *Response, error) {
+	if roleID < 1 {
+		return nil, nil, NewArgError("roleID", "cannot be less than 1")
+	}
+
+	if updateRequest == nil {
+		return nil, nil, NewArgError("updateRequest", "cannot be nil")
+	}
+
+	path := fmt.Sprintf("%s%d/", rolesBasePath, roleID)
+
+	req, err := s.client.NewRequest(ctx, http.MethodPut, path, updateRequest)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	role := new(Role)
+	resp, err := s.client.Do(ctx, req, role)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return role, resp, err
+}
+
+// Delete a role.
+func (s *RolesServiceOp) Delete(ctx context.Context, roleID int) (*Response, error) {
+	if roleID < 1 {
+		return nil, NewArgError("roleID", "cannot be less than 1")
+	}
+
+	path := fmt.Sprintf("%s%d/", rolesBasePath, roleID)
+
+	req, err := s.client.NewRequest(ctx, http.MethodDelete, path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.client.Do(ctx, req, nil)
+
+	return resp, err
+}
+
+// ListPermissions lists the permissions granted by a role.
+func (s *RolesServiceOp) ListPermissions(ctx context.Context, roleID int) ([]string, *Response, error) {
+	role, resp, err := s.GetByID(ctx, roleID)
+	if err != nil {
+		return nil, resp, err
+	}
+	return role.Permissions, resp, err
+}
+
+// SetPermissions replaces the permissions granted by a role.
+func (s *RolesServiceOp) SetPermissions(ctx context.Context, roleID int, permissions []string) (*Role, *Response, error) {
+	for _, p := range permissions {
+		if app, codename, ok := strings.Cut(p, "."); !ok || app == "" || codename == "" {
+			return nil, nil, NewArgError("permissions", fmt.Sprintf("invalid permission %q", p))
+		}
+	}
+	role, resp, err := s.GetByID(ctx, roleID)
+	if err != nil {
+		return nil, resp, err
+	}
+	if permissions == nil {
+		permissions = []string{}
+	}
+	return s.Update(ctx, roleID, &RoleRequest{Name: role.Name, Permissions: permissions})
+}
+
+// Helper method for listing roles
+func (s *RolesServiceOp) list(ctx context.Context, opt *ListOptions, roleOpt *listRoleOptions) ([]Role, *Response, error) {
+	path := rolesBasePath
+	path, err := addOptions(path, opt)
+	if err != nil {
+		return nil, nil, err
+	}
+	path, err = addOptions(path, roleOpt)
+	if err != nil {
+		return nil, nil, err
+	}
+	return resolveAllPages[Role](ctx, s.client, path)
+}
+
+// MethodPermission is the permission needed to call a method of a client service, and the roles
+// of the user of the client token granting it.
+type MethodPermission struct {
+	Service string
+	Method  string
+
+	// Permission needed by the method, as "app_label.codename", e.g. "santa.add_rule"
+	Permission string
+
+	// Granted is true if the user is a superuser or has a role granting the permission.
+	Granted bool
+
+	// Roles of the user granting the permission
+	Roles []Role
+}
+
+// methodActions maps the prefixes of the service method names to the permission actions.
+var methodActions = []struct{ prefix, action string }{
+	{"List", "view"},
+	{"Get", "view"},
+	{"Create", "add"},
+	{"Update", "change"},
+	{"Delete", "delete"},
+}
+
+// permissionModels are the app labels and models of the services whose permissions cannot be
+// derived from their base paths.
+var permissionModels = map[string]string{
+	"MDMDEPVirtualServers": "mdm.depvirtualserver",
+	"OsqueryATC":           "osquery.automatictableconstruction",
+	"Probes":               "probes.probesource",
+	"RealmsGroups":         "realms.realmgroup",
+	"RealmsTagMappings":    "realms.realmtagmapping",
+	"Roles":                "auth.group",
+}
+
+// PermissionForMethod returns the permission needed to call a CRUD method of a client service, e.g.
+// SantaRules and Create, and the roles of the user of the client token granting it. userID is the
+// ID of this user, the UserID of the API token. The user and its roles are read with the
+// AccountsUsers and Roles services, so the token needs the permissions to view them.
+//
+// The model of the permission is the name of the endpoint, from an OPTIONS request on its base
+// path, or the singular of the last element of the base path if the name is not available.
+func (c *Client) PermissionForMethod(ctx context.Context, userID int, service, method string) (*MethodPermission, error) {
+	if userID < 1 {
+		return nil, NewArgError("userID", "cannot be less than 1")
+	}
+	path, ok := serviceBasePaths[service]
+	if !ok {
+		return nil, NewArgError("service", fmt.Sprintf("unknown service %q", service))
+	}
+	action := ""
+	for _, ma := range methodActions {
+		if strings.HasPrefix(method, ma.prefix) {
+			action = ma.action
+			break
+		}
+	}
+	if action == "" {
+		return nil, NewArgError("method", fmt.Sprintf("unknown permission for method %q", method))
+	}
+
+	mp := &MethodPermission{Service: service, Method: method}
+	elems := strings.Split(strings.Trim(path, "/"), "/")
+	app, model := elems[0], singularModel(elems[len(elems)-1])
+	pm, fixed := permissionModels[service]
+	if fixed {
+		app, model, _ = strings.Cut(pm, ".")
+	}
+	eo, _, err := c.EndpointOptions(ctx, path)
+	var er *ErrorResponse
+	switch {
+	case err == nil:
+		if name := endpointModel(eo.Name); name != "" && !fixed {
+			model = name
+		}
+	case !errors.As(err, &er):
+		return nil, err
+	}
+	mp.Permission = fmt.Sprintf("%s.%s_%s", app, action, model)
+
+	user, _, err := c.AccountsUsers.GetByID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	for _, roleID := range user.RoleIDs {
+		r, _, err := c.Roles.GetByID(ctx, roleID)
+		if err != nil {
+			return nil, err
+		}
+		for _, p := range r.Permissions {
+			if p == mp.Permission {
+				mp.Roles = append(mp.Roles, *r)
+				break
+			}
+		}
+	}
+	sort.Slice(mp.Roles, func(i, j int) bool { return mp.Roles[i].Name < mp.Roles[j].Name })
+	mp.Granted = user.IsSuperuser || len(mp.Roles) > 0
+	return mp, nil
+}
+
+// endpointModel returns the lowercase model name of an endpoint name, e.g. jmespathcheck for
+// "JMESPath Check List".
+func endpointModel(name string) string {
+	for _, suffix := range []string{" List", " Detail", " Instance"} {
+		name = strings.TrimSuffix(name, suffix)
+	}
+	return strings.ToLower(strings.ReplaceAll(name, " ", ""))
+}
+
+// singularModel returns the lowercase model name of the last element of a base path, e.g.
+// filecategory for file_categories.
+func singularModel(elem string) string {
+	switch {
+	case strings.HasSuffix(elem, "ies"):
+		elem = strings.TrimSuffix(elem, "ies") + "y"
+	case strings.HasSuffix(elem, "ches"), strings.HasSuffix(elem, "shes"),
+		strings.HasSuffix(elem, "sses"), strings.HasSuffix(elem, "xes"):
+		elem = strings.TrimSuffix(elem, "es")
+	default:
+		elem = strings.TrimSuffix(elem, "s")
+	}
+	return strings.ReplaceAll(elem, "_", "")
+}
@@ -0,0 +1,316 @@
+package goztl
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+var roleJSONResponse = `
+{
+  "id": 3,
+  "name": "Santa admins",
+  "permissions": ["santa.add_rule", "santa.view_rule"]
+}
+`
+
+var roleReadOnlyJSONResponse = `
+{
+  "id": 4,
+  "name": "Read only",
+  "permissions": ["santa.view_rule", "inventory.view_jmespathcheck"]
+}
+`
+
+func TestRolesService_List(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/accounts/roles/", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testHeader(t, r, "Accept", "application/json")
+		fmt.Fprint(w, "["+roleJSONResponse+"]")
+	})
+
+	ctx := context.Background()
+	got, _, err := client.Roles.List(ctx, nil)
+	if err != nil {
+		t.Errorf("Roles.List returned error: %v", err)
+	}
+
+	want := []Role{
+		{
+			ID:          3,
+			Name:        "Santa admins",
+			Permissions: []string{"santa.add_rule", "santa.view_rule"},
+		},
+	}
+	if !cmp.Equal(got, want) {
+		t.Errorf("Roles.List returned %+v, want %+v", got, want)
+	}
+}
+
+func TestRolesService_GetByID(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/accounts/roles/3/", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testHeader(t, r, "Accept", "application/json")
+		fmt.Fprint(w, roleJSONResponse)
+	})
+
+	ctx := context.Background()
+	got, _, err := client.Roles.GetByID(ctx, 3)
+	if err != nil {
+		t.Errorf("Roles.GetByID returned error: %v", err)
+	}
+	if got == nil || got.Name != "Santa admins" {
+		t.Errorf("Roles.GetByID returned %+v", got)
+	}
+}
+
+func TestRolesService_GetByName(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/accounts/roles/", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testHeader(t, r, "Accept", "application/json")
+		if r.URL.Query().Get("name") == "Santa admins" {
+			fmt.Fprint(w, "["+roleJSONResponse+"]")
+		} else {
+			fmt.Fprint(w, "[]")
+		}
+	})
+
+	ctx := context.Background()
+	got, _, err := client.Roles.GetByName(ctx, "Santa admins")
+	if err != nil {
+		t.Errorf("Roles.GetByName returned error: %v", err)
+	}
+	if got == nil || got.ID != 3 {
+		t.Errorf("Roles.GetByName returned %+v", got)
+	}
+
+	got, _, err = client.Roles.GetByName(ctx, "Yolo")
+	if err != nil || got != nil {
+		t.Errorf("Roles.GetByName returned %+v, %v, want nil, nil", got, err)
+	}
+}
+
+func TestRolesService_Create(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/accounts/roles/", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testHeader(t, r, "Accept", "application/json")
+		testHeader(t, r, "Content-Type", "application/json")
+		testBody(t, r, `{"name":"Santa admins","permissions":["santa.add_rule","santa.view_rule"]}`+"\n")
+		fmt.Fprint(w, roleJSONResponse)
+	})
+
+	ctx := context.Background()
+	createRequest := &RoleRequest{
+		Name:        "Santa admins",
+		Permissions: []string{"santa.add_rule", "santa.view_rule"},
+	}
+	got, _, err := client.Roles.Create(ctx, createRequest)
+	if err != nil {
+		t.Errorf("Roles.Create returned error: %v", err)
+	}
+	if got == nil || got.ID != 3 {
+		t.Errorf("Roles.Create returned %+v", got)
+	}
+}
+
+func TestRolesService_Update(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/accounts/roles/3/", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PUT")
+		testHeader(t, r, "Accept", "application/json")
+		testHeader(t, r, "Content-Type", "application/json")
+		testBody(t, r, `{"name":"Santa admins","permissions":["santa.add_rule","santa.view_rule"]}`+"\n")
+		fmt.Fprint(w, roleJSONResponse)
+	})
+
+	ctx := context.Background()
+	updateRequest := &RoleRequest{
+		Name:        "Santa admins",
+		Permissions: []string{"santa.add_rule", "santa.view_rule"},
+	}
+	got, _, err := client.Roles.Update(ctx, 3, updateRequest)
+	if err != nil {
+		t.Errorf("Roles.Update returned error: %v", err)
+	}
+	if got == nil || got.ID != 3 {
+		t.Errorf("Roles.Update returned %+v", got)
+	}
+}
+
+func TestRolesService_Delete(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/accounts/roles/3/", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+	})
+
+	ctx := context.Background()
+	_, err := client.Roles.Delete(ctx, 3)
+	if err != nil {
+		t.Errorf("Roles.Delete returned error: %v", err)
+	}
+}
+
+func TestRolesService_SetPermissions(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/accounts/roles/3/", func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodGet:
+			fmt.Fprint(w, roleJSONResponse)
+		case http.MethodPut:
+			testBody(t, r, `{"name":"Santa admins","permissions":["santa.view_rule"]}`+"\n")
+			fmt.Fprint(w, `{"id": 3, "name": "Santa admins", "permissions": ["santa.view_rule"]}`)
+		default:
+			t.Errorf("Request method: %v", r.Method)
+		}
+	})
+
+	ctx := context.Background()
+	got, _, err := client.Roles.SetPermissions(ctx, 3, []string{"santa.view_rule"})
+	if err != nil {
+		t.Errorf("Roles.SetPermissions returned error: %v", err)
+	}
+	if got == nil || !cmp.Equal(got.Permissions, []string{"santa.view_rule"}) {
+		t.Errorf("Roles.SetPermissions returned %+v", got)
+	}
+
+	if _, _, err := client.Roles.SetPermissions(ctx, 3, []string{"view_rule"}); err == nil {
+		t.Error("Roles.SetPermissions returned no error for an invalid permission")
+	}
+}
+
+func TestPermissionForMethod(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/accounts/users/5/", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"id": 5, "username": "ci", "is_superuser": false, "roles": [4, 3]}`)
+	})
+	mux.HandleFunc("/accounts/users/6/", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"id": 6, "username": "admin", "is_superuser": true, "roles": []}`)
+	})
+	mux.HandleFunc("/accounts/roles/3/", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, roleJSONResponse)
+	})
+	mux.HandleFunc("/accounts/roles/4/", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, roleReadOnlyJSONResponse)
+	})
+	mux.HandleFunc("/santa/rules/", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "OPTIONS")
+		fmt.Fprint(w, srOptionsJSONResponse)
+	})
+	mux.HandleFunc("/inventory/jmespath_checks/", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "OPTIONS")
+		fmt.Fprint(w, `{"name": "JMESPath Check List", "actions": {}}`)
+	})
+	for _, path := range []string{"/santa/configurations/", "/inventory/taxonomies/", "/osquery/file_categories/"} {
+		mux.HandleFunc(path, func(w http.ResponseWriter, r *http.Request) {
+			http.Error(w, `{"detail": "Forbidden"}`, http.StatusForbidden)
+		})
+	}
+
+	roles := []Role{
+		{ID: 4, Name: "Read only", Permissions: []string{"santa.view_rule", "inventory.view_jmespathcheck"}},
+		{ID: 3, Name: "Santa admins", Permissions: []string{"santa.add_rule", "santa.view_rule"}},
+	}
+
+	ctx := context.Background()
+	for _, tc := range []struct {
+		userID          int
+		service, method string
+		want            *MethodPermission
+	}{
+		{
+			5, "SantaRules", "Create",
+			&MethodPermission{Permission: "santa.add_rule", Granted: true, Roles: roles[1:]},
+		},
+		{
+			5, "SantaRules", "GetByID",
+			&MethodPermission{Permission: "santa.view_rule", Granted: true, Roles: roles},
+		},
+		{
+			5, "JMESPathChecks", "Create",
+			&MethodPermission{Permission: "inventory.add_jmespathcheck"},
+		},
+		{
+			5, "JMESPathChecks", "List",
+			&MethodPermission{Permission: "inventory.view_jmespathcheck", Granted: true, Roles: roles[:1]},
+		},
+		{
+			5, "SantaConfigurations", "Delete",
+			&MethodPermission{Permission: "santa.delete_configuration"},
+		},
+		{
+			5, "Taxonomies", "GetByName",
+			&MethodPermission{Permission: "inventory.view_taxonomy"},
+		},
+		{
+			5, "OsqueryFileCategories", "Update",
+			&MethodPermission{Permission: "osquery.change_filecategory"},
+		},
+		{
+			6, "SantaConfigurations", "Delete",
+			&MethodPermission{Permission: "santa.delete_configuration", Granted: true},
+		},
+	} {
+		tc.want.Service, tc.want.Method = tc.service, tc.method
+		got, err := client.PermissionForMethod(ctx, tc.userID, tc.service, tc.method)
+		if err != nil {
+			t.Errorf("PermissionForMethod(%d, %s, %s) returned error: %v", tc.userID, tc.service, tc.method, err)
+		} else if !cmp.Equal(got, tc.want) {
+			t.Errorf("PermissionForMethod(%d, %s, %s) returned %s", tc.userID, tc.service, tc.method, cmp.Diff(tc.want, got))
+		}
+	}
+
+	if _, err := client.PermissionForMethod(ctx, 0, "SantaRules", "List"); err == nil {
+		t.Error("PermissionForMethod returned no error for a 0 user ID")
+	}
+	if _, err := client.PermissionForMethod(ctx, 5, "Yolo", "List"); err == nil {
+		t.Error("PermissionForMethod returned no error for an unknown service")
+	}
+	if _, err := client.PermissionForMethod(ctx, 5, "SantaRules", "Yolo"); err == nil {
+		t.Error("PermissionForMethod returned no error for an unknown method")
+	}
+}
+
+func TestSingularModel(t *testing.T) {
+	for elem, want := range map[string]string{
+		"rules":           "rule",
+		"taxonomies":      "taxonomy",
+		"queries":         "query",
+		"repositories":    "repository",
+		"file_categories": "filecategory",
+		"packages":        "package",
+		"api_tokens":      "apitoken",
+		"mscp_checks":     "mscpcheck",
+		"atcs":            "atc",
+	} {
+		if got := singularModel(elem); got != want {
+			t.Errorf("singularModel(%q) = %q, want %q", elem, got, want)
+		}
+	}
+}
@@ -46,6 +46,9 @@ type SantaConfiguration struct {
 	SyncIncidentSeverity      int       `json:"sync_incident_severity"`
 	Created                   Timestamp `json:"created_at,omitempty"`
 	Updated                   Timestamp `json:"updated_at,omitempty"`
+
+	// Fields not modeled by goztl
+	Extras
 }
 
 func (sc SantaConfiguration) String() string {
@@ -68,6 +71,9 @@ type SantaConfigurationRequest struct {
 	AllowUnknownShard         int      `json:"allow_unknown_shard"`
 	EnableAllEventUploadShard int      `json:"enable_all_event_upload_shard"`
 	SyncIncidentSeverity      int      `json:"sync_incident_severity"`
+
+	// Fields not modeled by goztl, sent as is
+	Extras
 }
 
 type listSCOptions struct {
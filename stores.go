@@ -33,7 +33,7 @@ type StoreHTTP struct {
 	EndpointURL    string       `json:"endpoint_url"`
 	VerifyTLS      bool         `json:"verify_tls"`
 	Username       *string      `json:"username"`
-	Password       *string      `json:"password"`
+	Password       *string      `json:"password" ztl:"secret"`
 	Headers        []HTTPHeader `json:"headers"`
 	Concurrency    int          `json:"concurrency"`
 	RequestTimeout int          `json:"request_timeout"`
@@ -43,7 +43,7 @@ type StoreHTTP struct {
 type StoreKinesis struct {
 	RegionName          string  `json:"region_name"`
 	AWSAccessKeyID      *string `json:"aws_access_key_id"`
-	AWSSecretAccessKey  *string `json:"aws_secret_access_key"`
+	AWSSecretAccessKey  *string `json:"aws_secret_access_key" ztl:"secret"`
 	AssumeRoleARN       *string `json:"assume_role_arn"`
 	Stream              string  `json:"stream"`
 	BatchSize           int     `json:"batch_size"`
@@ -52,14 +52,14 @@ type StoreKinesis struct {
 
 type StorePanther struct {
 	EndpointURL string `json:"endpoint_url"`
-	BearerToken string `json:"bearer_token"`
+	BearerToken string `json:"bearer_token" ztl:"secret"`
 	BatchSize   int    `json:"batch_size"`
 }
 
 type StoreSplunk struct {
 	// HEC
 	HECURL                    string       `json:"hec_url"`
-	HECToken                  string       `json:"hec_token"`
+	HECToken                  string       `json:"hec_token" ztl:"secret"`
 	HECExtraHeaders           []HTTPHeader `json:"hec_extra_headers"`
 	HECRequestTimeout         int          `json:"hec_request_timeout"`
 	HECIndex                  *string      `json:"hec_index"`
@@ -72,7 +72,7 @@ type StoreSplunk struct {
 	SearchAppURL *string `json:"search_app_url"`
 	// Events search
 	SearchURL            *string      `json:"search_url"`
-	SearchToken          *string      `json:"search_token"`
+	SearchToken          *string      `json:"search_token" ztl:"secret"`
 	SearchExtraHeaders   []HTTPHeader `json:"search_extra_headers"`
 	SearchRequestTimeout int          `json:"search_request_timeout"`
 	SearchIndex          *string      `json:"search_index"`
@@ -95,6 +95,9 @@ type Store struct {
 	Splunk                     *StoreSplunk    `json:"splunk_kwargs"`
 	Created                    Timestamp       `json:"created_at"`
 	Updated                    Timestamp       `json:"updated_at"`
+
+	// Fields not modeled by goztl, e.g. the settings of the newer backends
+	Extras
 }
 
 func (s Store) String() string {
@@ -113,6 +116,9 @@ type StoreRequest struct {
 	Kinesis                    *StoreKinesis   `json:"kinesis_kwargs"`
 	Panther                    *StorePanther   `json:"panther_kwargs"`
 	Splunk                     *StoreSplunk    `json:"splunk_kwargs"`
+
+	// Fields not modeled by goztl, sent as is
+	Extras
 }
 
 type listSOptions struct {
@@ -208,6 +208,7 @@ func TestStoresService_List(t *testing.T) {
 			},
 			Created: Timestamp{referenceTime},
 			Updated: Timestamp{referenceTime},
+			Extras:  Extras{Extra: map[string]json.RawMessage{"provisioning_uid": json.RawMessage("null")}},
 		},
 	}
 	if !cmp.Equal(got, want) {
@@ -248,6 +249,7 @@ func TestStoresService_GetByID(t *testing.T) {
 		},
 		Created: Timestamp{referenceTime},
 		Updated: Timestamp{referenceTime},
+		Extras:  Extras{Extra: map[string]json.RawMessage{"provisioning_uid": json.RawMessage("null")}},
 	}
 	if !cmp.Equal(got, want) {
 		t.Errorf("Stores.GetByID returned %+v, want %+v", got, want)
@@ -287,6 +289,7 @@ func TestStoresService_GetByName(t *testing.T) {
 		},
 		Created: Timestamp{referenceTime},
 		Updated: Timestamp{referenceTime},
+		Extras:  Extras{Extra: map[string]json.RawMessage{"provisioning_uid": json.RawMessage("null")}},
 	}
 	if !cmp.Equal(got, want) {
 		t.Errorf("Stores.GetByName returned %+v, want %+v", got, want)
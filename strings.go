@@ -5,11 +5,15 @@ import (
 	"fmt"
 	"io"
 	"reflect"
+	"sort"
+	"strings"
 )
 
 var timestampType = reflect.TypeOf(Timestamp{})
 
-// Stringify attempts to create a string representation of Zentral types
+// Stringify attempts to create a string representation of Zentral types. The values of the secret
+// fields are replaced by RedactedValue, and only the keys of the unknown fields kept in Extras are
+// written.
 func Stringify(message interface{}) string {
 	var buf bytes.Buffer
 	v := reflect.ValueOf(message)
@@ -76,6 +80,9 @@ func stringifyStruct(w io.Writer, v reflect.Value) {
 		if fv.Kind() == reflect.Slice && fv.IsNil() {
 			continue
 		}
+		if fv.Type() == extrasType && fv.IsZero() {
+			continue
+		}
 
 		if sep {
 			_, _ = w.Write([]byte(", "))
@@ -83,10 +90,29 @@ func stringifyStruct(w io.Writer, v reflect.Value) {
 			sep = true
 		}
 
-		_, _ = w.Write([]byte(v.Type().Field(i).Name))
+		sf := v.Type().Field(i)
+		_, _ = w.Write([]byte(sf.Name))
 		_, _ = w.Write([]byte{':'})
+		if isSecretField(sf) && !fv.IsZero() {
+			_, _ = w.Write([]byte(RedactedValue))
+			continue
+		}
+		if fv.Type() == extrasType {
+			stringifyExtras(w, fv.Interface().(Extras))
+			continue
+		}
 		stringifyValue(w, fv)
 	}
 
 	_, _ = w.Write([]byte{'}'})
 }
+
+// stringifyExtras writes the keys of the unknown fields only, their values can be secrets.
+func stringifyExtras(w io.Writer, e Extras) {
+	keys := make([]string, 0, len(e.Extra))
+	for k := range e.Extra {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	fmt.Fprintf(w, "%s{Extra:[%s]}", extrasType, strings.Join(keys, " "))
+}
@@ -0,0 +1,161 @@
+package goztl
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"time"
+)
+
+const taskResultBasePath = "task_result/"
+
+// TasksService is an interface for interfacing with the task result
+// endpoints of the Zentral API
+type TasksService interface {
+	GetByID(context.Context, string) (*TaskResult, *Response, error)
+	Wait(context.Context, string, *TaskPollOptions) (*TaskResult, *Response, error)
+	Download(context.Context, *TaskResult, io.Writer) (*Response, error)
+}
+
+// TasksServiceOp handles communication with the task result related
+// methods of the Zentral API.
+type TasksServiceOp struct {
+	client *Client
+}
+
+var _ TasksService = &TasksServiceOp{}
+
+// Task represents a Zentral background task, as returned by the endpoints starting one
+type Task struct {
+	ID        string `json:"task_id"`
+	ResultURL string `json:"task_result_url"`
+}
+
+// Task statuses
+const (
+	TaskPending = "PENDING"
+	TaskStarted = "STARTED"
+	TaskRetry   = "RETRY"
+	TaskSuccess = "SUCCESS"
+	TaskFailure = "FAILURE"
+	TaskRevoked = "REVOKED"
+)
+
+// TaskResult represents the state of a Zentral background task
+type TaskResult struct {
+	ID      string `json:"id"`
+	Name    string `json:"name"`
+	Status  string `json:"status"`
+	Unready bool   `json:"unready"`
+
+	// URL of the file produced by the task, if any
+	DownloadURL string `json:"download_url,omitempty"`
+}
+
+func (tr TaskResult) String() string {
+	return Stringify(tr)
+}
+
+// Done tells if the task is finished, successfully or not.
+func (tr TaskResult) Done() bool {
+	return !tr.Unready
+}
+
+// TaskFailedError is returned by Wait when a task is finished without success.
+type TaskFailedError struct {
+	Result *TaskResult
+}
+
+func (e *TaskFailedError) Error() string {
+	return fmt.Sprintf("task %s %s: %s", e.Result.Name, e.Result.ID, e.Result.Status)
+}
+
+// TaskPollOptions specifies how the task results are polled. The interval between two polls
+// starts at MinInterval, 1s by default, and is doubled after each poll, up to MaxInterval, 10s
+// by default.
+type TaskPollOptions struct {
+	MinInterval time.Duration
+	MaxInterval time.Duration
+}
+
+// GetByID retrieves the current state of a task by id.
+func (s *TasksServiceOp) GetByID(ctx context.Context, taskID string) (*TaskResult, *Response, error) {
+	if len(taskID) < 1 {
+		return nil, nil, NewArgError("taskID", "cannot be blank")
+	}
+
+	path := taskResultBasePath + url.PathEscape(taskID) + "/"
+
+	req, err := s.client.NewRequest(ctx, http.MethodGet, path, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	tr := new(TaskResult)
+
+	resp, err := s.client.Do(ctx, req, tr)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return tr, resp, err
+}
+
+// Wait polls the result of a task until it is finished, or until the context is done. A
+// TaskFailedError is returned if the task is finished without success.
+func (s *TasksServiceOp) Wait(ctx context.Context, taskID string, opt *TaskPollOptions) (*TaskResult, *Response, error) {
+	if len(taskID) < 1 {
+		return nil, nil, NewArgError("taskID", "cannot be blank")
+	}
+	interval, maxInterval := time.Second, 10*time.Second
+	if opt != nil {
+		if opt.MinInterval > 0 {
+			interval = opt.MinInterval
+		}
+		if opt.MaxInterval > 0 {
+			maxInterval = opt.MaxInterval
+		}
+	}
+	for {
+		tr, resp, err := s.GetByID(ctx, taskID)
+		if err != nil {
+			return nil, resp, err
+		}
+		if tr.Done() {
+			if tr.Status != TaskSuccess {
+				return tr, resp, &TaskFailedError{Result: tr}
+			}
+			return tr, resp, nil
+		}
+		t := time.NewTimer(interval)
+		select {
+		case <-ctx.Done():
+			t.Stop()
+			return tr, resp, ctx.Err()
+		case <-t.C:
+		}
+		interval = min(2*interval, maxInterval)
+	}
+}
+
+// Download writes the file produced by a finished task to w.
+func (s *TasksServiceOp) Download(ctx context.Context, tr *TaskResult, w io.Writer) (*Response, error) {
+	if tr == nil {
+		return nil, NewArgError("tr", "cannot be nil")
+	}
+	if len(tr.DownloadURL) < 1 {
+		return nil, NewArgError("tr", "no file to download")
+	}
+	if w == nil {
+		return nil, NewArgError("w", "cannot be nil")
+	}
+
+	req, err := s.client.NewRequest(ctx, http.MethodGet, tr.DownloadURL, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(ctx, req, w)
+}
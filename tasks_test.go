@@ -0,0 +1,117 @@
+package goztl
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestTasksService_GetByID(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/task_result/a7f0d2d0/", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testHeader(t, r, "Accept", "application/json")
+		fmt.Fprint(w, `{"name": "zentral.contrib.inventory.tasks.export_inventory", "id": "a7f0d2d0", "status": "STARTED", "unready": true}`)
+	})
+
+	ctx := context.Background()
+	got, _, err := client.Tasks.GetByID(ctx, "a7f0d2d0")
+	if err != nil {
+		t.Errorf("Tasks.GetByID returned error: %v", err)
+	}
+
+	want := &TaskResult{ID: "a7f0d2d0", Name: "zentral.contrib.inventory.tasks.export_inventory", Status: TaskStarted, Unready: true}
+	if !cmp.Equal(got, want) {
+		t.Errorf("Tasks.GetByID returned %+v, want %+v", got, want)
+	}
+	if got.Done() {
+		t.Error("TaskResult.Done returned true")
+	}
+}
+
+func TestTasksService_Wait(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	var polls int
+	mux.HandleFunc("/task_result/a7f0d2d0/", func(w http.ResponseWriter, r *http.Request) {
+		polls++
+		if polls < 3 {
+			fmt.Fprint(w, `{"name": "export", "id": "a7f0d2d0", "status": "PENDING", "unready": true}`)
+			return
+		}
+		fmt.Fprint(w, `{"name": "export", "id": "a7f0d2d0", "status": "SUCCESS", "unready": false,
+		                "download_url": "/task_result/a7f0d2d0/download/"}`)
+	})
+	mux.HandleFunc("/task_result/a7f0d2d0/download/", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		w.Header().Set("Content-Type", "text/csv")
+		fmt.Fprint(w, "serial_number\n0123456789\n")
+	})
+	mux.HandleFunc("/task_result/b8e1e3e1/", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"name": "export", "id": "b8e1e3e1", "status": "FAILURE", "unready": false}`)
+	})
+
+	ctx := context.Background()
+	opt := &TaskPollOptions{MinInterval: time.Millisecond, MaxInterval: 2 * time.Millisecond}
+	tr, _, err := client.Tasks.Wait(ctx, "a7f0d2d0", opt)
+	if err != nil {
+		t.Fatalf("Tasks.Wait returned error: %v", err)
+	}
+	if polls != 3 || tr.Status != TaskSuccess {
+		t.Errorf("Tasks.Wait returned %+v after %d polls", tr, polls)
+	}
+
+	var buf bytes.Buffer
+	resp, err := client.Tasks.Download(ctx, tr, &buf)
+	if err != nil {
+		t.Fatalf("Tasks.Download returned error: %v", err)
+	}
+	if got := buf.String(); got != "serial_number\n0123456789\n" {
+		t.Errorf("Tasks.Download wrote %q", got)
+	}
+	if got := resp.Header.Get("Content-Type"); got != "text/csv" {
+		t.Errorf("Tasks.Download response Content-Type %q", got)
+	}
+
+	_, _, err = client.Tasks.Wait(ctx, "b8e1e3e1", opt)
+	var tfe *TaskFailedError
+	if !errors.As(err, &tfe) || tfe.Result.Status != TaskFailure {
+		t.Errorf("Tasks.Wait returned error %v, want a TaskFailedError", err)
+	}
+
+	if _, err := client.Tasks.Download(ctx, &TaskResult{ID: "b8e1e3e1"}, &buf); err == nil {
+		t.Error("Tasks.Download returned no error for a task without file")
+	}
+}
+
+func TestTasksService_WaitDeadline(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/task_result/a7f0d2d0/", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"name": "export", "id": "a7f0d2d0", "status": "STARTED", "unready": true}`)
+	})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	start := time.Now()
+	tr, _, err := client.Tasks.Wait(ctx, "a7f0d2d0", nil)
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("Tasks.Wait returned error %v, want %v", err, context.DeadlineExceeded)
+	}
+	if elapsed := time.Since(start); elapsed > time.Second {
+		t.Errorf("Tasks.Wait returned after %s", elapsed)
+	}
+	if tr == nil || tr.Status != TaskStarted {
+		t.Errorf("Tasks.Wait returned %+v, want the last task result", tr)
+	}
+}
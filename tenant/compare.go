@@ -0,0 +1,177 @@
+package tenant
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/zentralopensource/goztl"
+)
+
+// DifferenceStatus tells where an object is found when comparing two tenants.
+type DifferenceStatus string
+
+// The difference statuses.
+const (
+	OnlyInSource DifferenceStatus = "only in source"
+	OnlyInTarget DifferenceStatus = "only in target"
+	Changed      DifferenceStatus = "changed"
+)
+
+// Difference is the difference of an object between two tenants.
+type Difference struct {
+	Node
+	Status DifferenceStatus
+
+	// Differences of the changed fields, from the target to the source.
+	Diffs []FieldDiff
+}
+
+func (d Difference) String() string {
+	return fmt.Sprintf("%s: %s", d.Node, d.Status)
+}
+
+// Compare loads the snapshots of two tenants, like Load, and returns their differences.
+func Compare(ctx context.Context, source, target *goztl.Client, kindNames ...string) ([]Difference, error) {
+	src, err := Load(ctx, source, kindNames...)
+	if err != nil {
+		return nil, fmt.Errorf("source: %w", err)
+	}
+	tgt, err := Load(ctx, target, kindNames...)
+	if err != nil {
+		return nil, fmt.Errorf("target: %w", err)
+	}
+	return Diff(src, tgt), nil
+}
+
+// Diff returns the differences between two snapshots, in registry order of the kinds, then key
+// order. The objects are matched by natural key, and compared in their canonical form, without
+// IDs and timestamps. The version fields that are not part of the natural keys are ignored.
+func Diff(source, target *Snapshot) []Difference {
+	seen := make(map[string]bool)
+	var ks []*Kind
+	for _, k := range append(source.Kinds(), target.Kinds()...) {
+		if !seen[k.Name] {
+			seen[k.Name] = true
+			ks = append(ks, k)
+		}
+	}
+	sortKinds(ks)
+
+	var diffs []Difference
+	for _, k := range ks {
+		for _, e := range source.Entries(k.Name) {
+			n := Node{k.Name, e.Key}
+			te := target.Lookup(k.Name, e.Key)
+			if te == nil {
+				diffs = append(diffs, Difference{Node: n, Status: OnlyInSource})
+				continue
+			}
+			var fds []FieldDiff
+			for _, fd := range diffObjects(k, te.Object, e.Object) {
+				if fd.Path == "version" && !k.isKeyField("version") {
+					continue
+				}
+				fds = append(fds, fd)
+			}
+			if len(fds) > 0 {
+				diffs = append(diffs, Difference{Node: n, Status: Changed, Diffs: fds})
+			}
+		}
+		for _, e := range target.Entries(k.Name) {
+			if source.Lookup(k.Name, e.Key) == nil {
+				diffs = append(diffs, Difference{Node: Node{k.Name, e.Key}, Status: OnlyInTarget})
+			}
+		}
+	}
+	sortDifferences(diffs)
+	return diffs
+}
+
+func sortDifferences(diffs []Difference) {
+	order := make(map[string]int)
+	for i, k := range kinds {
+		order[k.Name] = i
+	}
+	sort.SliceStable(diffs, func(i, j int) bool {
+		if diffs[i].Kind != diffs[j].Kind {
+			return order[diffs[i].Kind] < order[diffs[j].Kind]
+		}
+		return diffs[i].Key < diffs[j].Key
+	})
+}
+
+func (k *Kind) isKeyField(field string) bool {
+	for _, f := range k.Key {
+		if f == field {
+			return true
+		}
+	}
+	return false
+}
+
+// PromoteOptions specifies the optional parameters of a promotion.
+type PromoteOptions struct {
+	// Also promote the objects referenced by the selected objects, directly or indirectly. The
+	// read-only objects are never changed, and must exist in the target tenant.
+	Dependencies bool
+
+	// Only return the plan, without applying it.
+	DryRun bool
+}
+
+// Promote copies the selected objects of the source tenant to the target tenant. The objects are
+// matched by natural key: the missing objects are created, and the different objects are updated.
+// The references are remapped to the IDs of the target tenant.
+//
+// The plan of the promotion is returned, applied with Plan.Apply unless DryRun is set.
+func Promote(ctx context.Context, source, target *goztl.Client, nodes []Node, opt *PromoteOptions) (*Plan, error) {
+	if opt == nil {
+		opt = &PromoteOptions{}
+	}
+	var names []string
+	for _, n := range nodes {
+		names = append(names, n.Kind)
+	}
+	src, err := Load(ctx, source, names...)
+	if err != nil {
+		return nil, fmt.Errorf("source: %w", err)
+	}
+	for _, n := range nodes {
+		if src.Lookup(n.Kind, n.Key) == nil {
+			return nil, fmt.Errorf("source: %s not found", n)
+		}
+	}
+
+	selected := append([]Node(nil), nodes...)
+	if opt.Dependencies {
+		g := NewGraph(src)
+		for _, n := range nodes {
+			selected = append(selected, g.AllDependencies(n)...)
+		}
+	}
+
+	desired := NewSnapshot()
+	names = nil
+	for _, n := range selected {
+		e := src.Lookup(n.Kind, n.Key)
+		if desired.Lookup(n.Kind, n.Key) != nil {
+			continue
+		}
+		desired.add(&Entry{Kind: e.Kind, Key: e.Key, Object: e.Object.Copy()})
+		names = append(names, n.Kind)
+	}
+
+	tgt, err := Load(ctx, target, names...)
+	if err != nil {
+		return nil, fmt.Errorf("target: %w", err)
+	}
+	p, err := NewPlan(desired, tgt, nil)
+	if err != nil {
+		return nil, err
+	}
+	if opt.DryRun {
+		return p, nil
+	}
+	return p, p.Apply(ctx, target)
+}
@@ -0,0 +1,96 @@
+package tenant
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/zentralopensource/goztl"
+	"github.com/zentralopensource/goztl/goztlfake"
+)
+
+// stagingTenant returns a client for a tenant with the same meta business unit as fakeTenant, a
+// different tag, and no taxonomies.
+func stagingTenant() (*goztl.Client, *goztlfake.Fakes) {
+	client, fakes := goztlfake.NewClient()
+	fakes.MetaBusinessUnits.ListFunc = func(context.Context, *goztl.ListOptions) ([]goztl.MetaBusinessUnit, *goztl.Response, error) {
+		return []goztl.MetaBusinessUnit{{ID: 20, Name: "Default"}}, nil, nil
+	}
+	fakes.Taxonomies.ListFunc = func(context.Context, *goztl.ListOptions) ([]goztl.Taxonomy, *goztl.Response, error) {
+		return nil, nil, nil
+	}
+	fakes.Tags.ListFunc = func(context.Context, *goztl.ListOptions) ([]goztl.Tag, *goztl.Response, error) {
+		return []goztl.Tag{
+			{ID: 40, Name: "Yolo", Color: "ffffff"},
+			{ID: 41, Name: "Zorg", Color: "0079bf"},
+		}, nil, nil
+	}
+	return client, fakes
+}
+
+func TestCompare(t *testing.T) {
+	source, _ := fakeTenant()
+	target, _ := stagingTenant()
+
+	ctx := context.Background()
+	got, err := Compare(ctx, source, target, "inventory/tags")
+	if err != nil {
+		t.Fatalf("Compare returned error: %v", err)
+	}
+
+	want := []Difference{
+		{Node: Node{"inventory/taxonomies", "Teams"}, Status: OnlyInSource},
+		{Node: Node{"inventory/tags", "Fomo"}, Status: OnlyInSource},
+		{Node: Node{"inventory/tags", "Yolo"}, Status: Changed, Diffs: []FieldDiff{{Path: "color", Old: "ffffff", New: "0079bf"}}},
+		{Node: Node{"inventory/tags", "Yolo~2"}, Status: OnlyInSource},
+		{Node: Node{"inventory/tags", "Zorg"}, Status: OnlyInTarget},
+	}
+	if !cmp.Equal(got, want) {
+		t.Errorf("Compare returned %+v, want %+v", got, want)
+	}
+}
+
+func TestPromote(t *testing.T) {
+	source, _ := fakeTenant()
+	target, fakes := stagingTenant()
+	fakes.Taxonomies.CreateFunc = func(_ context.Context, r *goztl.TaxonomyCreateRequest) (*goztl.Taxonomy, *goztl.Response, error) {
+		return &goztl.Taxonomy{ID: 30, Name: r.Name, MetaBusinessUnitID: r.MetaBusinessUnitID}, nil, nil
+	}
+	fakes.Tags.CreateFunc = func(_ context.Context, r *goztl.TagCreateRequest) (*goztl.Tag, *goztl.Response, error) {
+		return &goztl.Tag{ID: 42, Name: r.Name, TaxonomyID: r.TaxonomyID, Color: r.Color}, nil, nil
+	}
+
+	ctx := context.Background()
+	nodes := []Node{{"inventory/tags", "Fomo"}}
+	p, err := Promote(ctx, source, target, nodes, &PromoteOptions{DryRun: true})
+	if err == nil {
+		t.Fatalf("Promote returned plan %s, want an error without the dependencies", p)
+	}
+
+	p, err = Promote(ctx, source, target, nodes, &PromoteOptions{Dependencies: true})
+	if err != nil {
+		t.Fatalf("Promote returned error: %v", err)
+	}
+	want := `+ create inventory/taxonomies "Teams" (30)
++ create inventory/tags "Fomo" (42)
+Plan: 2 to create, 0 to update, 0 to delete.
+`
+	if got := p.String(); got != want {
+		t.Errorf("Promote returned plan %q, want %q", got, want)
+	}
+
+	calls := fakes.Taxonomies.CallsTo("Create")
+	wantCalls := []goztlfake.Call{
+		{Method: "Create", Args: []interface{}{&goztl.TaxonomyCreateRequest{Name: "Teams", MetaBusinessUnitID: intPtr(20)}}},
+	}
+	if !cmp.Equal(calls, wantCalls) {
+		t.Errorf("Taxonomies.Create calls %+v, want %+v", calls, wantCalls)
+	}
+	calls = fakes.Tags.CallsTo("Create")
+	wantCalls = []goztlfake.Call{
+		{Method: "Create", Args: []interface{}{&goztl.TagCreateRequest{Name: "Fomo", TaxonomyID: intPtr(30), Color: "ff0000"}}},
+	}
+	if !cmp.Equal(calls, wantCalls) {
+		t.Errorf("Tags.Create calls %+v, want %+v", calls, wantCalls)
+	}
+}
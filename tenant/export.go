@@ -0,0 +1,242 @@
+package tenant
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/zentralopensource/goztl"
+	"go.yaml.in/yaml/v3"
+)
+
+// Format is the encoding of the exported files.
+type Format string
+
+// The export formats.
+const (
+	FormatJSON Format = "json"
+	FormatYAML Format = "yaml"
+)
+
+// SecretsMode tells how the secret fields are exported.
+type SecretsMode int
+
+// The secrets modes.
+const (
+	// SecretsRedact replaces the secret values with goztl.RedactedValue.
+	SecretsRedact SecretsMode = iota
+
+	// SecretsSeparate redacts the secret values in the object files, and writes them to the
+	// SecretsFile at the root of the export directory.
+	SecretsSeparate
+
+	// SecretsInclude keeps the secret values in the object files.
+	SecretsInclude
+)
+
+// SecretsFile is the name of the file holding the secret values, with the SecretsSeparate mode.
+const SecretsFile = "secrets.json"
+
+// ExportOptions specifies the optional parameters of an export.
+type ExportOptions struct {
+	// Names of the kinds to export. All the kinds if empty.
+	Kinds []string
+
+	// Format of the files. JSON if blank.
+	Format Format
+
+	Secrets SecretsMode
+}
+
+// Export exports the configuration of a tenant to a directory tree, with one file per object. The
+// files of an object kind are in a directory named after the kind, and are named after the
+// natural keys of the objects. The references to other objects are their natural keys.
+//
+// The output is deterministic: exporting the same configuration twice gives the same files. The
+// existing files of the exported kinds are removed.
+func Export(ctx context.Context, c *goztl.Client, dir string, opt *ExportOptions) (*Snapshot, error) {
+	if opt == nil {
+		opt = &ExportOptions{}
+	}
+	s, err := Load(ctx, c, opt.Kinds...)
+	if err != nil {
+		return nil, err
+	}
+	if err := WriteSnapshot(s, dir, opt); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+// WriteSnapshot writes a snapshot to a directory tree, like Export. The secrets file is removed
+// if the secrets are not exported separately.
+func WriteSnapshot(s *Snapshot, dir string, opt *ExportOptions) error {
+	if opt == nil {
+		opt = &ExportOptions{}
+	}
+	format := opt.Format
+	if format == "" {
+		format = FormatJSON
+	}
+	if format != FormatJSON && format != FormatYAML {
+		return goztl.NewArgError("Format", fmt.Sprintf("%q is not a supported format", format))
+	}
+	ks, err := selectKinds(opt.Kinds)
+	if err != nil {
+		return err
+	}
+
+	secrets := make(map[string]map[string]Object)
+	for _, k := range ks {
+		kindDir := filepath.Join(dir, filepath.FromSlash(k.Name))
+		if err := os.RemoveAll(kindDir); err != nil {
+			return err
+		}
+		entries := s.Entries(k.Name)
+		if len(entries) == 0 {
+			continue
+		}
+		if err := os.MkdirAll(kindDir, 0755); err != nil {
+			return err
+		}
+		for _, e := range entries {
+			obj := e.Object.Copy()
+			if opt.Secrets != SecretsInclude {
+				if sec := extractSecrets(k, obj); sec != nil && opt.Secrets == SecretsSeparate {
+					if secrets[k.Name] == nil {
+						secrets[k.Name] = make(map[string]Object)
+					}
+					secrets[k.Name][e.Key] = sec
+				}
+			}
+			b, err := encode(obj, format)
+			if err != nil {
+				return fmt.Errorf("%s %s: %w", k.Name, e.Key, err)
+			}
+			path := filepath.Join(kindDir, fileName(e.Key, format))
+			if err := os.WriteFile(path, b, 0644); err != nil {
+				return err
+			}
+		}
+	}
+
+	if opt.Secrets == SecretsSeparate {
+		b, err := json.MarshalIndent(secrets, "", "  ")
+		if err != nil {
+			return err
+		}
+		return os.WriteFile(filepath.Join(dir, SecretsFile), append(b, '\n'), 0600)
+	}
+	// a secrets file left by a previous export would be merged by ReadSnapshot
+	if err := os.Remove(filepath.Join(dir, SecretsFile)); err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return err
+	}
+	return nil
+}
+
+// extractSecrets redacts the secret values of a canonical object, and returns them in a sparse
+// copy of the object, or nil if the object has no secret values.
+func extractSecrets(k *Kind, obj Object) Object {
+	sec := make(Object)
+	found := false
+	for _, path := range k.secrets {
+		collectSecrets(obj, sec, strings.Split(path, "."), &found)
+	}
+	if !found {
+		return nil
+	}
+	return sec
+}
+
+func collectSecrets(src, dst map[string]interface{}, parts []string, found *bool) {
+	field := parts[0]
+	if len(parts) == 1 {
+		if v, ok := src[field]; ok && v != nil && v != "" {
+			dst[field] = v
+			src[field] = goztl.RedactedValue
+			*found = true
+		}
+		return
+	}
+	if name, ok := strings.CutSuffix(field, "[]"); ok {
+		l, _ := src[name].([]interface{})
+		if len(l) == 0 {
+			return
+		}
+		dl, _ := dst[name].([]interface{})
+		if dl == nil {
+			dl = make([]interface{}, len(l))
+			for i := range dl {
+				dl[i] = make(map[string]interface{})
+			}
+			dst[name] = dl
+		}
+		for i, e := range l {
+			if m := asMap(e); m != nil {
+				collectSecrets(m, dl[i].(map[string]interface{}), parts[1:], found)
+			}
+		}
+		return
+	}
+	m := asMap(src[field])
+	if m == nil {
+		return
+	}
+	dm, _ := dst[field].(map[string]interface{})
+	if dm == nil {
+		dm = make(map[string]interface{})
+		dst[field] = dm
+	}
+	collectSecrets(m, dm, parts[1:], found)
+}
+
+// encode encodes an object, with the map keys sorted.
+func encode(obj Object, format Format) ([]byte, error) {
+	if format == FormatYAML {
+		var buf bytes.Buffer
+		enc := yaml.NewEncoder(&buf)
+		enc.SetIndent(2)
+		if err := enc.Encode(map[string]interface{}(obj)); err != nil {
+			return nil, err
+		}
+		if err := enc.Close(); err != nil {
+			return nil, err
+		}
+		return buf.Bytes(), nil
+	}
+	var buf bytes.Buffer
+	enc := json.NewEncoder(&buf)
+	enc.SetEscapeHTML(false)
+	enc.SetIndent("", "  ")
+	if err := enc.Encode(obj); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// fileName returns the name of the file of an object, from its natural key. The characters that
+// are not safe in a file name are replaced, and the replacements are escaped to keep the names
+// unique.
+func fileName(key string, format Format) string {
+	var b strings.Builder
+	for _, r := range key {
+		switch {
+		case r >= 'a' && r <= 'z', r >= 'A' && r <= 'Z', r >= '0' && r <= '9',
+			r == '-', r == '.', r == '@', r == '+', r == ',', r == '~', r == ' ':
+			b.WriteRune(r)
+		default:
+			fmt.Fprintf(&b, "_%x_", r)
+		}
+	}
+	name := b.String()
+	if name == "" || strings.HasPrefix(name, ".") {
+		name = "_" + name
+	}
+	return name + "." + string(format)
+}
@@ -0,0 +1,306 @@
+package tenant
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/zentralopensource/goztl"
+	"github.com/zentralopensource/goztl/goztlfake"
+)
+
+func intPtr(i int) *int {
+	return &i
+}
+
+func stringPtr(s string) *string {
+	return &s
+}
+
+// fakeTenant returns a client for a tenant with a few inventory objects.
+func fakeTenant() (*goztl.Client, *goztlfake.Fakes) {
+	client, fakes := goztlfake.NewClient()
+	fakes.MetaBusinessUnits.ListFunc = func(context.Context, *goztl.ListOptions) ([]goztl.MetaBusinessUnit, *goztl.Response, error) {
+		return []goztl.MetaBusinessUnit{{ID: 2, Name: "Default"}}, nil, nil
+	}
+	fakes.Taxonomies.ListFunc = func(context.Context, *goztl.ListOptions) ([]goztl.Taxonomy, *goztl.Response, error) {
+		return []goztl.Taxonomy{{ID: 3, Name: "Teams", MetaBusinessUnitID: intPtr(2)}}, nil, nil
+	}
+	fakes.Tags.ListFunc = func(context.Context, *goztl.ListOptions) ([]goztl.Tag, *goztl.Response, error) {
+		return []goztl.Tag{
+			{ID: 5, Name: "Fomo", TaxonomyID: intPtr(3), Color: "ff0000"},
+			{ID: 4, Name: "Yolo", Color: "0079bf"},
+			{ID: 6, Name: "Yolo", Color: "00ff00"},
+		}, nil, nil
+	}
+	fakes.JMESPathChecks.ListFunc = func(context.Context, *goztl.ListOptions) ([]goztl.JMESPathCheck, *goztl.Response, error) {
+		return []goztl.JMESPathCheck{
+			{ID: 7, Name: "Check", SourceName: "Munki", Platforms: []string{"MACOS"}, TagIDs: []int{6, 5, 99},
+				JMESPathExpression: "contains(os_version, 'macOS')"},
+		}, nil, nil
+	}
+	fakes.MDMRecoveryPasswordConfigs.ListFunc = func(context.Context, *goztl.ListOptions) ([]goztl.MDMRecoveryPasswordConfig, *goztl.Response, error) {
+		return []goztl.MDMRecoveryPasswordConfig{
+			{ID: 8, Name: "Static", StaticPassword: stringPtr("12345678"), RotationIntervalDays: 90},
+		}, nil, nil
+	}
+	return client, fakes
+}
+
+func readJSON(t *testing.T, path string) map[string]interface{} {
+	t.Helper()
+	b, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var m map[string]interface{}
+	if err := json.Unmarshal(b, &m); err != nil {
+		t.Fatal(err)
+	}
+	return m
+}
+
+func TestLoad(t *testing.T) {
+	client, fakes := fakeTenant()
+
+	ctx := context.Background()
+	s, err := Load(ctx, client, "inventory/jmespath_checks")
+	if err != nil {
+		t.Fatalf("Load returned error: %v", err)
+	}
+
+	var keys []string
+	for _, e := range s.Entries("inventory/tags") {
+		keys = append(keys, e.Key+"="+e.ID)
+	}
+	wantKeys := []string{"Fomo=5", "Yolo=4", "Yolo~2=6"}
+	if !cmp.Equal(keys, wantKeys) {
+		t.Errorf("Snapshot.Entries returned keys %v, want %v", keys, wantKeys)
+	}
+
+	e := s.Lookup("inventory/jmespath_checks", "Check")
+	if e == nil {
+		t.Fatal("Snapshot.Lookup returned nil")
+	}
+	if got, want := e.Object["tags"], []interface{}{int64(99), "Fomo", "Yolo~2"}; !cmp.Equal(got, want) {
+		t.Errorf("Canonical tags %v, want %v", got, want)
+	}
+	if _, ok := e.Object["id"]; ok {
+		t.Error("Canonical object with an ID")
+	}
+
+	if got := s.Lookup("inventory/tags", "Fomo").Object["taxonomy"]; got != "Teams" {
+		t.Errorf("Canonical taxonomy %v, want Teams", got)
+	}
+
+	if calls := fakes.Stores.Calls(); len(calls) != 0 {
+		t.Errorf("Load called the stores service: %v", calls)
+	}
+}
+
+func TestLoadUnknownKind(t *testing.T) {
+	client, _ := fakeTenant()
+
+	_, err := Load(context.Background(), client, "yolo")
+	if err == nil {
+		t.Fatal("Load did not return an error")
+	}
+}
+
+func TestLoadRealms(t *testing.T) {
+	client, fakes := fakeTenant()
+	fakes.RealmsRealms.ListFunc = func(context.Context, *goztl.ListOptions) ([]goztl.RealmsRealm, *goztl.Response, error) {
+		return []goztl.RealmsRealm{{UUID: "r1", Name: "Okta", Backend: "saml"}}, nil, nil
+	}
+	fakes.RealmsGroups.ListFunc = func(context.Context, *goztl.ListOptions) ([]goztl.RealmsGroup, *goztl.Response, error) {
+		return []goztl.RealmsGroup{
+			{UUID: "g1", RealmUUID: "r1", DisplayName: "IT"},
+			{UUID: "g2", RealmUUID: "r1", ParentUUID: stringPtr("g1"), DisplayName: "Admins"},
+		}, nil, nil
+	}
+	fakes.Roles.ListFunc = func(context.Context, *goztl.ListOptions) ([]goztl.Role, *goztl.Response, error) {
+		return []goztl.Role{{ID: 9, Name: "Admin"}}, nil, nil
+	}
+	fakes.RealmsRoleMappings.ListFunc = func(context.Context, *goztl.ListOptions) ([]goztl.RealmsRoleMapping, *goztl.Response, error) {
+		return []goztl.RealmsRoleMapping{{ID: "m1", RealmGroupUUID: "g2", RoleID: 9}}, nil, nil
+	}
+	fakes.RealmsTagMappings.ListFunc = func(context.Context, *goztl.ListOptions) ([]goztl.RealmsTagMapping, *goztl.Response, error) {
+		return []goztl.RealmsTagMapping{{ID: "m2", RealmGroupUUID: "g1", TagID: 5}}, nil, nil
+	}
+
+	ctx := context.Background()
+	s, err := Load(ctx, client, "realms/role_mappings", "realms/tag_mappings")
+	if err != nil {
+		t.Fatalf("Load returned error: %v", err)
+	}
+
+	var keys []string
+	for _, name := range []string{"realms/groups", "realms/role_mappings", "realms/tag_mappings"} {
+		for _, e := range s.Entries(name) {
+			keys = append(keys, name+" "+e.Key+"="+e.ID)
+		}
+	}
+	wantKeys := []string{
+		"realms/groups Okta/Admins=g2",
+		"realms/groups Okta/IT=g1",
+		"realms/role_mappings Okta/Admins/Admin=m1",
+		"realms/tag_mappings Okta/IT/Fomo=m2",
+	}
+	if !cmp.Equal(keys, wantKeys) {
+		t.Errorf("Snapshot.Entries returned keys %v, want %v", keys, wantKeys)
+	}
+
+	e := s.Lookup("realms/groups", "Okta/Admins")
+	if e == nil {
+		t.Fatal("Snapshot.Lookup returned nil")
+	}
+	if got := e.Object["parent"]; got != "Okta/IT" {
+		t.Errorf("Canonical parent %v, want Okta/IT", got)
+	}
+}
+
+func TestExport(t *testing.T) {
+	client, _ := fakeTenant()
+	dir := t.TempDir()
+
+	// stale file, removed by the export
+	stale := filepath.Join(dir, "inventory", "tags", "Stale.json")
+	if err := os.MkdirAll(filepath.Dir(stale), 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(stale, []byte("{}"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	ctx := context.Background()
+	opt := &ExportOptions{Kinds: []string{"inventory/tags", "mdm/recovery_password_configs"}}
+	if _, err := Export(ctx, client, dir, opt); err != nil {
+		t.Fatalf("Export returned error: %v", err)
+	}
+
+	if _, err := os.Stat(stale); !os.IsNotExist(err) {
+		t.Error("Export did not remove the stale file")
+	}
+	if _, err := os.Stat(filepath.Join(dir, "inventory", "taxonomies")); !os.IsNotExist(err) {
+		t.Error("Export exported a kind that was not selected")
+	}
+
+	got := readJSON(t, filepath.Join(dir, "inventory", "tags", "Fomo.json"))
+	want := map[string]interface{}{"name": "Fomo", "taxonomy": "Teams", "meta_business_unit": nil, "color": "ff0000"}
+	if !cmp.Equal(got, want) {
+		t.Errorf("Exported tag %v, want %v", got, want)
+	}
+
+	got = readJSON(t, filepath.Join(dir, "mdm", "recovery_password_configs", "Static.json"))
+	if got["static_password"] != goztl.RedactedValue {
+		t.Errorf("Exported static_password %v, want %v", got["static_password"], goztl.RedactedValue)
+	}
+	if _, err := os.Stat(filepath.Join(dir, SecretsFile)); !os.IsNotExist(err) {
+		t.Error("Export wrote the secrets file")
+	}
+
+	// deterministic
+	b1, err := os.ReadFile(filepath.Join(dir, "inventory", "tags", "Yolo~2.json"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := Export(ctx, client, dir, opt); err != nil {
+		t.Fatalf("Export returned error: %v", err)
+	}
+	b2, err := os.ReadFile(filepath.Join(dir, "inventory", "tags", "Yolo~2.json"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(b1) != string(b2) {
+		t.Errorf("Export is not deterministic: %s != %s", b1, b2)
+	}
+}
+
+func TestExportSeparateSecretsYAML(t *testing.T) {
+	client, _ := fakeTenant()
+	dir := t.TempDir()
+
+	ctx := context.Background()
+	opt := &ExportOptions{
+		Kinds:   []string{"mdm/recovery_password_configs"},
+		Format:  FormatYAML,
+		Secrets: SecretsSeparate,
+	}
+	if _, err := Export(ctx, client, dir, opt); err != nil {
+		t.Fatalf("Export returned error: %v", err)
+	}
+
+	b, err := os.ReadFile(filepath.Join(dir, "mdm", "recovery_password_configs", "Static.yaml"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := `dynamic_password: false
+name: Static
+reveal_rotation_delay: 0
+rotate_firmware_password: false
+rotation_interval_days: 90
+static_password: <redacted>
+`
+	if string(b) != want {
+		t.Errorf("Exported file %q, want %q", b, want)
+	}
+
+	got := readJSON(t, filepath.Join(dir, SecretsFile))
+	wantSecrets := map[string]interface{}{
+		"mdm/recovery_password_configs": map[string]interface{}{
+			"Static": map[string]interface{}{"static_password": "12345678"},
+		},
+	}
+	if !cmp.Equal(got, wantSecrets) {
+		t.Errorf("Exported secrets %v, want %v", got, wantSecrets)
+	}
+}
+
+func TestExportRedactRemovesSecretsFile(t *testing.T) {
+	client, _ := fakeTenant()
+	dir := t.TempDir()
+
+	ctx := context.Background()
+	opt := &ExportOptions{
+		Kinds:   []string{"mdm/recovery_password_configs"},
+		Secrets: SecretsSeparate,
+	}
+	if _, err := Export(ctx, client, dir, opt); err != nil {
+		t.Fatalf("Export returned error: %v", err)
+	}
+	if _, err := os.Stat(filepath.Join(dir, SecretsFile)); err != nil {
+		t.Fatalf("Export did not write the secrets file: %v", err)
+	}
+
+	opt.Secrets = SecretsRedact
+	if _, err := Export(ctx, client, dir, opt); err != nil {
+		t.Fatalf("Export returned error: %v", err)
+	}
+	if _, err := os.Stat(filepath.Join(dir, SecretsFile)); !errors.Is(err, fs.ErrNotExist) {
+		t.Errorf("Export left the secrets file: %v", err)
+	}
+}
+
+func TestFileName(t *testing.T) {
+	tests := []struct {
+		key  string
+		want string
+	}{
+		{"Yolo", "Yolo.json"},
+		{"Yolo~2", "Yolo~2.json"},
+		{"config/Default/a,b", "config_2f_Default_2f_a,b.json"},
+		{"a_b", "a_5f_b.json"},
+		{".hidden", "_.hidden.json"},
+		{"", "_.json"},
+	}
+	for _, tt := range tests {
+		if got := fileName(tt.key, FormatJSON); got != tt.want {
+			t.Errorf("fileName(%q) = %q, want %q", tt.key, got, tt.want)
+		}
+	}
+}
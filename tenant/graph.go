@@ -0,0 +1,296 @@
+package tenant
+
+import (
+	"container/heap"
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/zentralopensource/goztl"
+)
+
+// Node is an object of a graph.
+type Node struct {
+	Kind string
+	Key  string
+}
+
+func (n Node) String() string {
+	return fmt.Sprintf("%s %q", n.Kind, n.Key)
+}
+
+// Graph is the graph of the references between the objects of a snapshot.
+type Graph struct {
+	nodes      []Node
+	index      map[Node]int
+	deps       map[Node][]Node
+	dependents map[Node][]Node
+}
+
+// CycleError is returned when the objects cannot be ordered because of reference cycles.
+type CycleError struct {
+	Cycles [][]Node
+}
+
+func (e *CycleError) Error() string {
+	var cycles []string
+	for _, c := range e.Cycles {
+		var nodes []string
+		for _, n := range c {
+			nodes = append(nodes, n.String())
+		}
+		cycles = append(cycles, strings.Join(nodes, " -> "))
+	}
+	return fmt.Sprintf("reference cycles: %s", strings.Join(cycles, "; "))
+}
+
+// LoadGraph loads a snapshot of a tenant, like Load, and returns the graph of its objects.
+func LoadGraph(ctx context.Context, c *goztl.Client, kindNames ...string) (*Graph, error) {
+	s, err := Load(ctx, c, kindNames...)
+	if err != nil {
+		return nil, err
+	}
+	return NewGraph(s), nil
+}
+
+// NewGraph returns the graph of the objects of a snapshot. The references to objects that are not
+// in the snapshot are ignored.
+func NewGraph(s *Snapshot) *Graph {
+	g := &Graph{
+		index:      make(map[Node]int),
+		deps:       make(map[Node][]Node),
+		dependents: make(map[Node][]Node),
+	}
+	for _, k := range s.Kinds() {
+		for _, e := range s.Entries(k.Name) {
+			n := Node{k.Name, e.Key}
+			g.index[n] = len(g.nodes)
+			g.nodes = append(g.nodes, n)
+		}
+	}
+	for _, n := range g.nodes {
+		e := s.Lookup(n.Kind, n.Key)
+		seen := make(map[Node]bool)
+		for _, d := range refNodes(e.Kind, e.Object) {
+			if _, ok := g.index[d]; !ok || seen[d] {
+				continue
+			}
+			seen[d] = true
+			g.deps[n] = append(g.deps[n], d)
+			g.dependents[d] = append(g.dependents[d], n)
+		}
+	}
+	for _, m := range []map[Node][]Node{g.deps, g.dependents} {
+		for _, l := range m {
+			g.sort(l)
+		}
+	}
+	return g
+}
+
+// refNodes returns the objects referenced by a canonical object. The unresolved references are
+// skipped.
+func refNodes(k *Kind, obj Object) []Node {
+	var nodes []Node
+	paths, refs := refsByPath(k)
+	for _, path := range paths {
+		candidates := refs[path]
+		for _, v := range values(obj, path) {
+			l, ok := v.([]interface{})
+			if !ok {
+				l = []interface{}{v}
+			}
+			for _, rv := range l {
+				key, ok := rv.(string)
+				if !ok {
+					continue
+				}
+				if len(candidates) == 1 {
+					nodes = append(nodes, Node{candidates[0].Kind, key})
+					continue
+				}
+				for _, r := range candidates {
+					if rk, ok := strings.CutPrefix(key, r.Kind+":"); ok {
+						nodes = append(nodes, Node{r.Kind, rk})
+					}
+				}
+			}
+		}
+	}
+	return nodes
+}
+
+// sort sorts nodes in graph order: registry order of the kinds, then key order.
+func (g *Graph) sort(nodes []Node) {
+	sort.Slice(nodes, func(i, j int) bool { return g.index[nodes[i]] < g.index[nodes[j]] })
+}
+
+// Nodes returns the nodes of the graph, in registry order of the kinds, then key order.
+func (g *Graph) Nodes() []Node {
+	nodes := make([]Node, len(g.nodes))
+	copy(nodes, g.nodes)
+	return nodes
+}
+
+// Has tells if the graph has the node.
+func (g *Graph) Has(n Node) bool {
+	_, ok := g.index[n]
+	return ok
+}
+
+// Dependencies returns the nodes directly referenced by a node.
+func (g *Graph) Dependencies(n Node) []Node {
+	return append([]Node(nil), g.deps[n]...)
+}
+
+// Dependents returns the nodes directly referencing a node.
+func (g *Graph) Dependents(n Node) []Node {
+	return append([]Node(nil), g.dependents[n]...)
+}
+
+// AllDependencies returns the nodes directly or indirectly referenced by a node: the objects
+// that must exist before the node is created.
+func (g *Graph) AllDependencies(n Node) []Node {
+	return g.reachable(n, g.deps)
+}
+
+// AllDependents returns the nodes directly or indirectly referencing a node: the objects that could
+// break if the node is deleted.
+func (g *Graph) AllDependents(n Node) []Node {
+	return g.reachable(n, g.dependents)
+}
+
+func (g *Graph) reachable(n Node, edges map[Node][]Node) []Node {
+	seen := map[Node]bool{n: true}
+	var all []Node
+	queue := []Node{n}
+	for len(queue) > 0 {
+		cur := queue[0]
+		queue = queue[1:]
+		for _, d := range edges[cur] {
+			if !seen[d] {
+				seen[d] = true
+				all = append(all, d)
+				queue = append(queue, d)
+			}
+		}
+	}
+	g.sort(all)
+	return all
+}
+
+// Cycles returns the reference cycles, as the strongly connected components of the graph with
+// more than one node, or with a node referencing itself.
+func (g *Graph) Cycles() [][]Node {
+	// Tarjan's algorithm
+	index := make(map[Node]int)
+	low := make(map[Node]int)
+	onStack := make(map[Node]bool)
+	var stack []Node
+	var cycles [][]Node
+	var strongConnect func(n Node)
+	strongConnect = func(n Node) {
+		index[n] = len(index)
+		low[n] = index[n]
+		stack = append(stack, n)
+		onStack[n] = true
+		for _, d := range g.deps[n] {
+			if _, ok := index[d]; !ok {
+				strongConnect(d)
+				low[n] = min(low[n], low[d])
+			} else if onStack[d] {
+				low[n] = min(low[n], index[d])
+			}
+		}
+		if low[n] != index[n] {
+			return
+		}
+		var scc []Node
+		for {
+			m := stack[len(stack)-1]
+			stack = stack[:len(stack)-1]
+			onStack[m] = false
+			scc = append(scc, m)
+			if m == n {
+				break
+			}
+		}
+		if len(scc) > 1 || g.references(n, n) {
+			g.sort(scc)
+			cycles = append(cycles, scc)
+		}
+	}
+	for _, n := range g.nodes {
+		if _, ok := index[n]; !ok {
+			strongConnect(n)
+		}
+	}
+	sort.Slice(cycles, func(i, j int) bool { return g.index[cycles[i][0]] < g.index[cycles[j][0]] })
+	return cycles
+}
+
+func (g *Graph) references(n, d Node) bool {
+	for _, m := range g.deps[n] {
+		if m == d {
+			return true
+		}
+	}
+	return false
+}
+
+// nodeHeap is a min-heap of nodes in graph order.
+type nodeHeap struct {
+	g     *Graph
+	nodes []Node
+}
+
+func (h *nodeHeap) Len() int           { return len(h.nodes) }
+func (h *nodeHeap) Less(i, j int) bool { return h.g.index[h.nodes[i]] < h.g.index[h.nodes[j]] }
+func (h *nodeHeap) Swap(i, j int)      { h.nodes[i], h.nodes[j] = h.nodes[j], h.nodes[i] }
+func (h *nodeHeap) Push(x interface{}) { h.nodes = append(h.nodes, x.(Node)) }
+func (h *nodeHeap) Pop() interface{} {
+	n := h.nodes[len(h.nodes)-1]
+	h.nodes = h.nodes[:len(h.nodes)-1]
+	return n
+}
+
+// CreateOrder returns the nodes in an order where the referenced nodes come first. The order is
+// deterministic. A CycleError is returned if the graph has cycles.
+func (g *Graph) CreateOrder() ([]Node, error) {
+	return g.topologicalOrder(g.deps, g.dependents)
+}
+
+// DeleteOrder returns the nodes in an order where the referencing nodes come first. The order is
+// deterministic. A CycleError is returned if the graph has cycles.
+func (g *Graph) DeleteOrder() ([]Node, error) {
+	return g.topologicalOrder(g.dependents, g.deps)
+}
+
+// topologicalOrder is Kahn's algorithm, taking the first ready node in graph order.
+func (g *Graph) topologicalOrder(before, after map[Node][]Node) ([]Node, error) {
+	remaining := make(map[Node]int)
+	h := &nodeHeap{g: g}
+	for _, n := range g.nodes {
+		remaining[n] = len(before[n])
+		if remaining[n] == 0 {
+			h.nodes = append(h.nodes, n)
+		}
+	}
+	heap.Init(h)
+	var order []Node
+	for h.Len() > 0 {
+		n := heap.Pop(h).(Node)
+		order = append(order, n)
+		for _, m := range after[n] {
+			remaining[m]--
+			if remaining[m] == 0 {
+				heap.Push(h, m)
+			}
+		}
+	}
+	if len(order) < len(g.nodes) {
+		return nil, &CycleError{Cycles: g.Cycles()}
+	}
+	return order, nil
+}
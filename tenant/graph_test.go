@@ -0,0 +1,82 @@
+package tenant
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestGraph(t *testing.T) {
+	client, _ := fakeTenant()
+
+	ctx := context.Background()
+	g, err := LoadGraph(ctx, client, "inventory/jmespath_checks")
+	if err != nil {
+		t.Fatalf("LoadGraph returned error: %v", err)
+	}
+
+	mbu := Node{"inventory/meta_business_units", "Default"}
+	taxonomy := Node{"inventory/taxonomies", "Teams"}
+	fomo := Node{"inventory/tags", "Fomo"}
+	yolo := Node{"inventory/tags", "Yolo"}
+	yolo2 := Node{"inventory/tags", "Yolo~2"}
+	check := Node{"inventory/jmespath_checks", "Check"}
+
+	if got, want := g.AllDependents(mbu), []Node{taxonomy, fomo, check}; !cmp.Equal(got, want) {
+		t.Errorf("Graph.AllDependents returned %v, want %v", got, want)
+	}
+	if got, want := g.Dependencies(check), []Node{fomo, yolo2}; !cmp.Equal(got, want) {
+		t.Errorf("Graph.Dependencies returned %v, want %v", got, want)
+	}
+	if got := g.Dependents(yolo); len(got) != 0 {
+		t.Errorf("Graph.Dependents returned %v, want none", got)
+	}
+
+	got, err := g.CreateOrder()
+	if err != nil {
+		t.Fatalf("Graph.CreateOrder returned error: %v", err)
+	}
+	if want := []Node{mbu, taxonomy, fomo, yolo, yolo2, check}; !cmp.Equal(got, want) {
+		t.Errorf("Graph.CreateOrder returned %v, want %v", got, want)
+	}
+
+	got, err = g.DeleteOrder()
+	if err != nil {
+		t.Fatalf("Graph.DeleteOrder returned error: %v", err)
+	}
+	if want := []Node{yolo, check, fomo, taxonomy, mbu, yolo2}; !cmp.Equal(got, want) {
+		t.Errorf("Graph.DeleteOrder returned %v, want %v", got, want)
+	}
+}
+
+func TestGraphCycles(t *testing.T) {
+	s := NewSnapshot()
+	for _, obj := range []Object{
+		{"name": "A", "requires": []interface{}{"B"}},
+		{"name": "B", "requires": []interface{}{"A"}},
+		{"name": "C", "requires": []interface{}{"A", "Unknown"}},
+	} {
+		if _, err := s.Add("mdm/artifacts", obj); err != nil {
+			t.Fatal(err)
+		}
+	}
+	g := NewGraph(s)
+
+	a := Node{"mdm/artifacts", "A"}
+	b := Node{"mdm/artifacts", "B"}
+	want := [][]Node{{a, b}}
+	if got := g.Cycles(); !cmp.Equal(got, want) {
+		t.Errorf("Graph.Cycles returned %v, want %v", got, want)
+	}
+
+	_, err := g.CreateOrder()
+	var ce *CycleError
+	if !errors.As(err, &ce) {
+		t.Fatalf("Graph.CreateOrder returned error %v, want CycleError", err)
+	}
+	if got := err.Error(); got != `reference cycles: mdm/artifacts "A" -> mdm/artifacts "B"` {
+		t.Errorf("CycleError.Error returned %q", got)
+	}
+}
@@ -0,0 +1,354 @@
+package tenant
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/zentralopensource/goztl"
+	"go.yaml.in/yaml/v3"
+)
+
+// ReadSnapshot reads a snapshot from a directory tree written by Export. The secret values of the
+// SecretsFile, if present, replace the redacted values. The entries have no IDs.
+func ReadSnapshot(dir string) (*Snapshot, error) {
+	var secrets map[string]interface{}
+	b, err := os.ReadFile(filepath.Join(dir, SecretsFile))
+	if err == nil {
+		if err := decodeJSON(b, &secrets); err != nil {
+			return nil, fmt.Errorf("%s: %w", SecretsFile, err)
+		}
+	} else if !os.IsNotExist(err) {
+		return nil, err
+	}
+
+	var ks []*Kind
+	objs := make(map[string][]Object)
+	names := make(map[string][]string)
+	for _, k := range kinds {
+		kindDir := filepath.Join(dir, filepath.FromSlash(k.Name))
+		des, err := os.ReadDir(kindDir)
+		if os.IsNotExist(err) {
+			continue
+		} else if err != nil {
+			return nil, err
+		}
+		ks = append(ks, k)
+		for _, de := range des {
+			ext := filepath.Ext(de.Name())
+			if de.IsDir() || (ext != ".json" && ext != ".yaml" && ext != ".yml") {
+				continue
+			}
+			b, err := os.ReadFile(filepath.Join(kindDir, de.Name()))
+			if err != nil {
+				return nil, err
+			}
+			obj, err := decodeObject(b, ext)
+			if err != nil {
+				return nil, fmt.Errorf("%s/%s: %w", k.Name, de.Name(), err)
+			}
+			objs[k.Name] = append(objs[k.Name], obj)
+			names[k.Name] = append(names[k.Name], de.Name())
+		}
+	}
+
+	s := newSnapshot(ks)
+	for _, k := range ks {
+		// The "~N" suffixes of the duplicated keys are taken from the file names, the other
+		// duplicates get the first free suffixes.
+		keys := make([]string, len(objs[k.Name]))
+		taken := make(map[string]bool)
+		for i, obj := range objs[k.Name] {
+			key := naturalKey(k, obj)
+			if n := duplicateNumber(names[k.Name][i], key); n > 1 {
+				key = fmt.Sprintf("%s~%d", key, n)
+			}
+			if !taken[key] {
+				keys[i] = key
+				taken[key] = true
+			}
+		}
+		for i, obj := range objs[k.Name] {
+			if keys[i] != "" {
+				continue
+			}
+			key := naturalKey(k, obj)
+			for n := 2; ; n++ {
+				if dup := fmt.Sprintf("%s~%d", key, n); !taken[dup] {
+					keys[i] = dup
+					taken[dup] = true
+					break
+				}
+			}
+		}
+		for i, obj := range objs[k.Name] {
+			if sec := asMap(asMap(secrets[k.Name])[keys[i]]); sec != nil {
+				mergeSecrets(obj, sec)
+			}
+			s.add(&Entry{Kind: k, Key: keys[i], Object: obj})
+		}
+	}
+	return s, nil
+}
+
+// duplicateNumber returns the N of the "~N" suffix of the name of the file of a duplicated key,
+// written by WriteSnapshot, or 0.
+func duplicateNumber(name string, key string) int {
+	ext := filepath.Ext(name)
+	prefix := strings.TrimSuffix(fileName(key, Format(strings.TrimPrefix(ext, "."))), ext)
+	suffix, ok := strings.CutPrefix(strings.TrimSuffix(name, ext), prefix+"~")
+	if !ok {
+		return 0
+	}
+	n, err := strconv.Atoi(suffix)
+	if err != nil || suffix != strconv.Itoa(n) {
+		return 0
+	}
+	return n
+}
+
+// decodeObject decodes a JSON or YAML object.
+func decodeObject(b []byte, ext string) (Object, error) {
+	var m map[string]interface{}
+	if ext == ".json" {
+		if err := decodeJSON(b, &m); err != nil {
+			return nil, err
+		}
+	} else {
+		if err := yaml.Unmarshal(b, &m); err != nil {
+			return nil, err
+		}
+		normalize(m)
+	}
+	if m == nil {
+		return nil, fmt.Errorf("not an object")
+	}
+	return m, nil
+}
+
+// mergeSecrets copies the values of a sparse copy of an object, written by extractSecrets, to the
+// object.
+func mergeSecrets(dst, src map[string]interface{}) {
+	for field, v := range src {
+		switch tv := v.(type) {
+		case map[string]interface{}:
+			if m := asMap(dst[field]); m != nil {
+				mergeSecrets(m, tv)
+			}
+		case []interface{}:
+			l, _ := dst[field].([]interface{})
+			for i, e := range tv {
+				if i < len(l) {
+					if m, sm := asMap(l[i]), asMap(e); m != nil && sm != nil {
+						mergeSecrets(m, sm)
+					}
+				}
+			}
+		default:
+			dst[field] = v
+		}
+	}
+}
+
+// UnresolvedRefsError is returned when the references of an object cannot be resolved.
+type UnresolvedRefsError struct {
+	Kind string
+	Key  string
+
+	// Unresolved references, as kind:key.
+	Refs []string
+}
+
+func (e *UnresolvedRefsError) Error() string {
+	return fmt.Sprintf("%s %s: unresolved references: %s", e.Kind, e.Key, strings.Join(e.Refs, ", "))
+}
+
+// RedactedSecretsError is returned when an object with redacted secret values would be sent.
+type RedactedSecretsError struct {
+	Kind string
+	Key  string
+
+	// Paths of the redacted fields.
+	Paths []string
+}
+
+func (e *RedactedSecretsError) Error() string {
+	return fmt.Sprintf("%s %s: redacted secrets: %s", e.Kind, e.Key, strings.Join(e.Paths, ", "))
+}
+
+// requestForm returns the request form of a canonical object, with the natural keys of the
+// references replaced by the values of the referenced objects in the target snapshot.
+func (k *Kind) requestForm(key string, obj Object, target *Snapshot) (Object, error) {
+	return k.resolveRefs(key, obj, func(r Ref, rk string) (interface{}, bool) {
+		e := target.Lookup(r.Kind, rk)
+		if e == nil || e.raw == nil {
+			return nil, false
+		}
+		field := r.Field
+		if field == "" {
+			field = kindsByName[r.Kind].idField()
+		}
+		return e.raw[field], true
+	})
+}
+
+// resolveRefs returns a copy of a canonical object, with the natural keys of the references
+// replaced by the values returned by lookup.
+func (k *Kind) resolveRefs(key string, obj Object, lookup func(r Ref, key string) (interface{}, bool)) (Object, error) {
+	req := obj.Copy()
+	var unresolved []string
+	paths, refs := refsByPath(k)
+	for _, path := range paths {
+		candidates := refs[path]
+		resolve := func(v interface{}) interface{} {
+			if v == nil {
+				return nil
+			}
+			// the unresolved references of the exports are only resolved if lookup finds them
+			for _, r := range candidates {
+				rk, ok := refKey(r, v, len(candidates))
+				if !ok {
+					continue
+				}
+				if rv, ok := lookup(r, rk); ok {
+					return rv
+				}
+			}
+			if len(candidates) > 1 {
+				unresolved = append(unresolved, scalarString(v))
+			} else {
+				unresolved = append(unresolved, candidates[0].Kind+":"+scalarString(v))
+			}
+			return v
+		}
+		walk(req, path, func(m map[string]interface{}, field string) {
+			switch v := m[field].(type) {
+			case nil:
+			case []interface{}:
+				for i, e := range v {
+					v[i] = resolve(e)
+				}
+			default:
+				m[field] = resolve(v)
+			}
+		})
+	}
+	if len(unresolved) > 0 {
+		sort.Strings(unresolved)
+		return nil, &UnresolvedRefsError{Kind: k.Name, Key: key, Refs: unresolved}
+	}
+	return req, nil
+}
+
+// fillSecrets replaces the redacted secret values of a canonical object with the values of the
+// current object, and returns the paths of the values still redacted.
+func (k *Kind) fillSecrets(obj Object, current Object) []string {
+	var redacted []string
+	for _, path := range k.secrets {
+		walkPair(obj, current, strings.Split(path, "."), func(m, cm map[string]interface{}, field string) {
+			if m[field] != goztl.RedactedValue {
+				return
+			}
+			if cv, ok := cm[field]; ok && cv != goztl.RedactedValue {
+				m[field] = copyValue(cv)
+				return
+			}
+			redacted = append(redacted, path)
+		})
+	}
+	return redacted
+}
+
+// walkPair is walk on two objects with the same structure. The maps of the second object are nil
+// when they are missing.
+func walkPair(v, cv interface{}, parts []string, fn func(m, cm map[string]interface{}, field string)) {
+	m, cm := asMap(v), asMap(cv)
+	if m == nil {
+		return
+	}
+	field := parts[0]
+	if len(parts) == 1 {
+		fn(m, cm, field)
+		return
+	}
+	if name, ok := strings.CutSuffix(field, "[]"); ok {
+		l, _ := m[name].([]interface{})
+		cl, _ := cm[name].([]interface{})
+		for i, e := range l {
+			var ce interface{}
+			if i < len(cl) {
+				ce = cl[i]
+			}
+			walkPair(e, ce, parts[1:], fn)
+		}
+		return
+	}
+	walkPair(m[field], cm[field], parts[1:], fn)
+}
+
+// ImportedObject describes what was done to an object during an import.
+type ImportedObject struct {
+	Kind   string
+	Key    string
+	ID     string
+	Action Action
+}
+
+// ImportOptions specifies the optional parameters of an import.
+type ImportOptions struct {
+	// Names of the kinds to import. All the kinds of the directory tree if empty.
+	Kinds []string
+
+	// Do not update the existing objects that are different.
+	NoUpdate bool
+}
+
+// Import imports a directory tree written by Export into a tenant.
+//
+// The objects are matched with the existing objects using their natural keys, so that Import can
+// be run again with the same result. The missing objects are created, the referenced kinds first,
+// and the existing objects that are different are updated. The read-only kinds are never created
+// or updated, their objects must exist in the tenant. The redacted secrets of the existing objects
+// are kept. The objects with redacted secrets cannot be created.
+//
+// The plan of the import is checked before any change is made. If a change cannot be applied, the
+// objects imported before the error are returned with the error.
+func Import(ctx context.Context, c *goztl.Client, dir string, opt *ImportOptions) ([]ImportedObject, error) {
+	if opt == nil {
+		opt = &ImportOptions{}
+	}
+	desired, err := ReadSnapshot(dir)
+	if err != nil {
+		return nil, err
+	}
+	ks := desired.Kinds()
+	if len(opt.Kinds) > 0 {
+		if ks, err = selectKinds(opt.Kinds); err != nil {
+			return nil, err
+		}
+	}
+	var names []string
+	for _, k := range ks {
+		names = append(names, k.Name)
+	}
+	target, err := Load(ctx, c, names...)
+	if err != nil {
+		return nil, err
+	}
+	p, err := NewPlan(desired, target, &PlanOptions{Kinds: names, NoUpdate: opt.NoUpdate})
+	if err != nil {
+		return nil, err
+	}
+	err = p.apply(ctx, c, false)
+	var done []ImportedObject
+	for _, ch := range p.Changes {
+		if ch.Action == ActionNone || ch.Applied {
+			done = append(done, ImportedObject{Kind: ch.Kind.Name, Key: ch.Key, ID: ch.ID, Action: ch.Action})
+		}
+	}
+	return done, err
+}
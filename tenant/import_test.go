@@ -0,0 +1,198 @@
+package tenant
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/zentralopensource/goztl"
+	"github.com/zentralopensource/goztl/goztlfake"
+)
+
+func TestImport(t *testing.T) {
+	source, _ := fakeTenant()
+	dir := t.TempDir()
+
+	ctx := context.Background()
+	opt := &ExportOptions{
+		Kinds:   []string{"inventory/meta_business_units", "inventory/taxonomies", "inventory/tags", "mdm/recovery_password_configs"},
+		Format:  FormatYAML,
+		Secrets: SecretsSeparate,
+	}
+	if _, err := Export(ctx, source, dir, opt); err != nil {
+		t.Fatalf("Export returned error: %v", err)
+	}
+
+	target, fakes := goztlfake.NewClient()
+	fakes.MetaBusinessUnits.ListFunc = func(context.Context, *goztl.ListOptions) ([]goztl.MetaBusinessUnit, *goztl.Response, error) {
+		return []goztl.MetaBusinessUnit{{ID: 20, Name: "Default"}}, nil, nil
+	}
+	fakes.Taxonomies.ListFunc = func(context.Context, *goztl.ListOptions) ([]goztl.Taxonomy, *goztl.Response, error) {
+		return nil, nil, nil
+	}
+	fakes.Taxonomies.CreateFunc = func(_ context.Context, r *goztl.TaxonomyCreateRequest) (*goztl.Taxonomy, *goztl.Response, error) {
+		return &goztl.Taxonomy{ID: 30, Name: r.Name, MetaBusinessUnitID: r.MetaBusinessUnitID}, nil, nil
+	}
+	fakes.Tags.ListFunc = func(context.Context, *goztl.ListOptions) ([]goztl.Tag, *goztl.Response, error) {
+		return []goztl.Tag{{ID: 40, Name: "Yolo", Color: "0079bf"}}, nil, nil
+	}
+	fakes.Tags.CreateFunc = func(_ context.Context, r *goztl.TagCreateRequest) (*goztl.Tag, *goztl.Response, error) {
+		return &goztl.Tag{ID: 41 + len(fakes.Tags.CallsTo("Create")), Name: r.Name, TaxonomyID: r.TaxonomyID, Color: r.Color}, nil, nil
+	}
+	fakes.MDMRecoveryPasswordConfigs.ListFunc = func(context.Context, *goztl.ListOptions) ([]goztl.MDMRecoveryPasswordConfig, *goztl.Response, error) {
+		return nil, nil, nil
+	}
+	fakes.MDMRecoveryPasswordConfigs.CreateFunc = func(_ context.Context, r *goztl.MDMRecoveryPasswordConfigRequest) (*goztl.MDMRecoveryPasswordConfig, *goztl.Response, error) {
+		return &goztl.MDMRecoveryPasswordConfig{ID: 50, Name: r.Name}, nil, nil
+	}
+
+	got, err := Import(ctx, target, dir, nil)
+	if err != nil {
+		t.Fatalf("Import returned error: %v", err)
+	}
+
+	want := []ImportedObject{
+		{Kind: "inventory/meta_business_units", Key: "Default", ID: "20", Action: ActionNone},
+		{Kind: "inventory/taxonomies", Key: "Teams", ID: "30", Action: ActionCreate},
+		{Kind: "inventory/tags", Key: "Fomo", ID: "42", Action: ActionCreate},
+		{Kind: "inventory/tags", Key: "Yolo", ID: "40", Action: ActionNone},
+		{Kind: "inventory/tags", Key: "Yolo~2", ID: "43", Action: ActionCreate},
+		{Kind: "mdm/recovery_password_configs", Key: "Static", ID: "50", Action: ActionCreate},
+	}
+	if !cmp.Equal(got, want) {
+		t.Errorf("Import returned %+v, want %+v", got, want)
+	}
+
+	calls := fakes.Tags.CallsTo("Create")
+	wantCalls := []goztlfake.Call{
+		{Method: "Create", Args: []interface{}{&goztl.TagCreateRequest{Name: "Fomo", TaxonomyID: intPtr(30), Color: "ff0000"}}},
+		{Method: "Create", Args: []interface{}{&goztl.TagCreateRequest{Name: "Yolo", Color: "00ff00"}}},
+	}
+	if !cmp.Equal(calls, wantCalls) {
+		t.Errorf("Tags.Create calls %+v, want %+v", calls, wantCalls)
+	}
+
+	calls = fakes.MDMRecoveryPasswordConfigs.CallsTo("Create")
+	wantCalls = []goztlfake.Call{
+		{Method: "Create", Args: []interface{}{&goztl.MDMRecoveryPasswordConfigRequest{
+			Name: "Static", StaticPassword: stringPtr("12345678"), RotationIntervalDays: 90,
+		}}},
+	}
+	if !cmp.Equal(calls, wantCalls) {
+		t.Errorf("MDMRecoveryPasswordConfigs.Create calls %+v, want %+v", calls, wantCalls)
+	}
+}
+
+func TestReadSnapshotDuplicateKeys(t *testing.T) {
+	client, fakes := goztlfake.NewClient()
+	fakes.MDMRecoveryPasswordConfigs.ListFunc = func(context.Context, *goztl.ListOptions) ([]goztl.MDMRecoveryPasswordConfig, *goztl.Response, error) {
+		var configs []goztl.MDMRecoveryPasswordConfig
+		for i := 1; i <= 12; i++ {
+			configs = append(configs, goztl.MDMRecoveryPasswordConfig{ID: i, Name: "Static", StaticPassword: stringPtr(fmt.Sprintf("password%d", i)),
+				RotationIntervalDays: i})
+		}
+		return configs, nil, nil
+	}
+	dir := t.TempDir()
+
+	ctx := context.Background()
+	opt := &ExportOptions{Kinds: []string{"mdm/recovery_password_configs"}, Secrets: SecretsSeparate}
+	exported, err := Export(ctx, client, dir, opt)
+	if err != nil {
+		t.Fatalf("Export returned error: %v", err)
+	}
+
+	s, err := ReadSnapshot(dir)
+	if err != nil {
+		t.Fatalf("ReadSnapshot returned error: %v", err)
+	}
+	entries := s.Entries("mdm/recovery_password_configs")
+	if len(entries) != 12 {
+		t.Fatalf("ReadSnapshot returned %d entries, want 12", len(entries))
+	}
+	for _, e := range entries {
+		want := exported.Lookup("mdm/recovery_password_configs", e.Key)
+		if want == nil {
+			t.Errorf("ReadSnapshot returned unknown key %s", e.Key)
+			continue
+		}
+		if !cmp.Equal(e.Object, want.Object) {
+			t.Errorf("ReadSnapshot returned %s %s", e.Key, cmp.Diff(want.Object, e.Object))
+		}
+	}
+}
+
+func TestImportIdempotent(t *testing.T) {
+	client, fakes := fakeTenant()
+	dir := t.TempDir()
+
+	ctx := context.Background()
+	opt := &ExportOptions{Kinds: []string{"inventory/jmespath_checks", "mdm/recovery_password_configs"}}
+	if _, err := Export(ctx, client, dir, opt); err != nil {
+		t.Fatalf("Export returned error: %v", err)
+	}
+
+	got, err := Import(ctx, client, dir, nil)
+	if err != nil {
+		t.Fatalf("Import returned error: %v", err)
+	}
+	for _, io := range got {
+		if io.Action != ActionNone {
+			t.Errorf("Import returned %+v, want no action", io)
+		}
+	}
+	if len(fakes.MDMRecoveryPasswordConfigs.CallsTo("Update")) != 0 {
+		t.Error("Import updated the recovery password config with the redacted secret")
+	}
+}
+
+func TestImportRedactedSecrets(t *testing.T) {
+	source, _ := fakeTenant()
+	dir := t.TempDir()
+
+	ctx := context.Background()
+	opt := &ExportOptions{Kinds: []string{"mdm/recovery_password_configs"}}
+	if _, err := Export(ctx, source, dir, opt); err != nil {
+		t.Fatalf("Export returned error: %v", err)
+	}
+
+	target, fakes := goztlfake.NewClient()
+	fakes.MDMRecoveryPasswordConfigs.ListFunc = func(context.Context, *goztl.ListOptions) ([]goztl.MDMRecoveryPasswordConfig, *goztl.Response, error) {
+		return nil, nil, nil
+	}
+
+	_, err := Import(ctx, target, dir, nil)
+	var rse *RedactedSecretsError
+	if !errors.As(err, &rse) {
+		t.Fatalf("Import returned error %v, want RedactedSecretsError", err)
+	}
+	want := &RedactedSecretsError{Kind: "mdm/recovery_password_configs", Key: "Static", Paths: []string{"static_password"}}
+	if !cmp.Equal(rse, want) {
+		t.Errorf("Import returned error %+v, want %+v", rse, want)
+	}
+}
+
+func TestImportUnresolvedRefs(t *testing.T) {
+	client, fakes := fakeTenant()
+	dir := t.TempDir()
+
+	ctx := context.Background()
+	if _, err := Export(ctx, client, dir, &ExportOptions{Kinds: []string{"inventory/jmespath_checks"}}); err != nil {
+		t.Fatalf("Export returned error: %v", err)
+	}
+
+	fakes.JMESPathChecks.ListFunc = func(context.Context, *goztl.ListOptions) ([]goztl.JMESPathCheck, *goztl.Response, error) {
+		return nil, nil, nil
+	}
+	_, err := Import(ctx, client, dir, nil)
+	var ure *UnresolvedRefsError
+	if !errors.As(err, &ure) {
+		t.Fatalf("Import returned error %v, want UnresolvedRefsError", err)
+	}
+	want := &UnresolvedRefsError{Kind: "inventory/jmespath_checks", Key: "Check", Refs: []string{"inventory/tags:99"}}
+	if !cmp.Equal(ure, want) {
+		t.Errorf("Import returned error %+v, want %+v", ure, want)
+	}
+}
@@ -0,0 +1,498 @@
+// Package tenant works on the whole configuration of a Zentral tenant, across the goztl services.
+//
+// Every type of Zentral object is described by a Kind. The objects are handled in a canonical form,
+// an Object, where the references to other objects are their natural keys instead of their IDs.
+// The canonical form is the same on every tenant, so it can be exported, imported, and compared.
+package tenant
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+
+	"github.com/zentralopensource/goztl"
+)
+
+// Ref describes a reference from an object to another object.
+type Ref struct {
+	// Path of the reference in the object, with dots between the fields, and [] after the
+	// fields holding lists of objects. A field holding a list of references is a single path.
+	Path string
+
+	// Name of the referenced kind.
+	Kind string
+
+	// Field of the referenced object holding the reference value. The ID field of the referenced
+	// kind if blank.
+	Field string
+}
+
+// Kind describes a type of Zentral object, and how to manage it with a goztl.Client.
+type Kind struct {
+	// Name of the kind, also used as the directory of its exported objects.
+	Name string
+
+	// Name of the goztl.Client service field.
+	Service string
+
+	// Fields of the natural key, after the rewriting of the references. The natural key is the
+	// same on every tenant.
+	Key []string
+
+	// References to other objects.
+	Refs []Ref
+
+	// Field holding the ID of the objects. "id" if blank.
+	IDField string
+
+	model         reflect.Type
+	createRequest reflect.Type
+	updateRequest reflect.Type
+	id            reflect.Type
+	fields        map[string]reflect.Type
+	secrets       []string
+}
+
+// ReadOnly tells if the objects of the kind cannot be created, updated or deleted with goztl.
+func (k *Kind) ReadOnly() bool {
+	return k.createRequest == nil
+}
+
+// Model returns the goztl type of the objects of the kind.
+func (k *Kind) Model() reflect.Type {
+	return k.model
+}
+
+// Secrets returns the paths of the secret fields of the canonical objects.
+func (k *Kind) Secrets() []string {
+	return k.secrets
+}
+
+// RefsTo returns the references of the kind to the target kind.
+func (k *Kind) RefsTo(target string) []Ref {
+	var refs []Ref
+	for _, r := range k.Refs {
+		if r.Kind == target {
+			refs = append(refs, r)
+		}
+	}
+	return refs
+}
+
+func (k *Kind) idField() string {
+	if k.IDField == "" {
+		return "id"
+	}
+	return k.IDField
+}
+
+var clientType = reflect.TypeOf(goztl.Client{})
+
+// init derives the types of the kind from the methods of its service.
+func (k *Kind) init() {
+	sf, ok := clientType.FieldByName(k.Service)
+	if !ok {
+		panic(fmt.Sprintf("unknown goztl.Client service %s", k.Service))
+	}
+	st := sf.Type
+
+	list, ok := st.MethodByName("List")
+	if !ok {
+		panic(fmt.Sprintf("service %s without List method", k.Service))
+	}
+	k.model = list.Type.Out(0).Elem()
+
+	if create, ok := st.MethodByName("Create"); ok {
+		k.createRequest = create.Type.In(1).Elem()
+	}
+	if update, ok := st.MethodByName("Update"); ok {
+		k.id = update.Type.In(1)
+		k.updateRequest = update.Type.In(2).Elem()
+	}
+
+	if k.ReadOnly() {
+		k.fields = jsonFields(k.model)
+		delete(k.fields, k.idField())
+		delete(k.fields, "created_at")
+		delete(k.fields, "updated_at")
+		k.secrets = secretPaths(k.model, "")
+	} else {
+		k.fields = jsonFields(k.createRequest)
+		for name, t := range jsonFields(k.updateRequest) {
+			k.fields[name] = t
+		}
+		k.secrets = secretPaths(k.createRequest, "")
+	}
+	sort.Strings(k.secrets)
+}
+
+func (k *Kind) service(c *goztl.Client) reflect.Value {
+	return reflect.ValueOf(c).Elem().FieldByName(k.Service)
+}
+
+// callResult converts the results of a service method call.
+func callResult(out []reflect.Value) (reflect.Value, error) {
+	if err, ok := out[len(out)-1].Interface().(error); ok && err != nil {
+		return reflect.Value{}, err
+	}
+	if len(out) > 2 {
+		return out[0], nil
+	}
+	return reflect.Value{}, nil
+}
+
+// List returns all the objects of the kind, in their goztl form encoded in JSON.
+func (k *Kind) List(ctx context.Context, c *goztl.Client) ([]Object, error) {
+	m := k.service(c).MethodByName("List")
+	res, err := callResult(m.Call([]reflect.Value{
+		reflect.ValueOf(ctx),
+		reflect.Zero(reflect.TypeOf((*goztl.ListOptions)(nil))),
+	}))
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", k.Name, err)
+	}
+	var objs []Object
+	if err := convert(res.Interface(), &objs); err != nil {
+		return nil, fmt.Errorf("%s: %w", k.Name, err)
+	}
+	return objs, nil
+}
+
+// Create creates an object of the kind from its request form, and returns it in its goztl form.
+func (k *Kind) Create(ctx context.Context, c *goztl.Client, req Object) (Object, error) {
+	if k.ReadOnly() {
+		return nil, fmt.Errorf("%s: read-only kind", k.Name)
+	}
+	r := reflect.New(k.createRequest)
+	if err := convert(req, r.Interface()); err != nil {
+		return nil, fmt.Errorf("%s: %w", k.Name, err)
+	}
+	m := k.service(c).MethodByName("Create")
+	res, err := callResult(m.Call([]reflect.Value{reflect.ValueOf(ctx), r}))
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", k.Name, err)
+	}
+	var obj Object
+	if err := convert(res.Interface(), &obj); err != nil {
+		return nil, fmt.Errorf("%s: %w", k.Name, err)
+	}
+	return obj, nil
+}
+
+// Update updates an object of the kind from its request form, and returns it in its goztl form.
+func (k *Kind) Update(ctx context.Context, c *goztl.Client, id string, req Object) (Object, error) {
+	if k.updateRequest == nil {
+		return nil, fmt.Errorf("%s: read-only kind", k.Name)
+	}
+	idv, err := k.idValue(id)
+	if err != nil {
+		return nil, err
+	}
+	r := reflect.New(k.updateRequest)
+	if err := convert(req, r.Interface()); err != nil {
+		return nil, fmt.Errorf("%s: %w", k.Name, err)
+	}
+	m := k.service(c).MethodByName("Update")
+	res, err := callResult(m.Call([]reflect.Value{reflect.ValueOf(ctx), idv, r}))
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", k.Name, err)
+	}
+	var obj Object
+	if err := convert(res.Interface(), &obj); err != nil {
+		return nil, fmt.Errorf("%s: %w", k.Name, err)
+	}
+	return obj, nil
+}
+
+// Delete deletes an object of the kind.
+func (k *Kind) Delete(ctx context.Context, c *goztl.Client, id string) error {
+	if k.ReadOnly() {
+		return fmt.Errorf("%s: read-only kind", k.Name)
+	}
+	idv, err := k.idValue(id)
+	if err != nil {
+		return err
+	}
+	m := k.service(c).MethodByName("Delete")
+	if _, err := callResult(m.Call([]reflect.Value{reflect.ValueOf(ctx), idv})); err != nil {
+		return fmt.Errorf("%s: %w", k.Name, err)
+	}
+	return nil
+}
+
+func (k *Kind) idValue(id string) (reflect.Value, error) {
+	v := reflect.New(k.id)
+	if k.id.Kind() == reflect.String {
+		v.Elem().SetString(id)
+	} else if err := json.Unmarshal([]byte(id), v.Interface()); err != nil {
+		return reflect.Value{}, fmt.Errorf("%s: invalid ID %q", k.Name, id)
+	}
+	return v.Elem(), nil
+}
+
+// ID returns the ID of an object in its goztl form.
+func (k *Kind) ID(obj Object) string {
+	return scalarString(obj[k.idField()])
+}
+
+var nameKey = []string{"name"}
+
+// The kinds, sorted so that the kinds referenced in the natural keys come first.
+var kinds = []*Kind{
+	// Accounts
+	{Name: "accounts/roles", Service: "Roles", Key: nameKey},
+	{Name: "accounts/users", Service: "AccountsUsers", Key: []string{"username"}, Refs: []Ref{
+		{Path: "roles", Kind: "accounts/roles"},
+	}},
+	// Inventory
+	{Name: "inventory/meta_business_units", Service: "MetaBusinessUnits", Key: nameKey},
+	{Name: "inventory/taxonomies", Service: "Taxonomies", Key: nameKey, Refs: []Ref{
+		{Path: "meta_business_unit", Kind: "inventory/meta_business_units"},
+	}},
+	{Name: "inventory/tags", Service: "Tags", Key: nameKey, Refs: []Ref{
+		{Path: "taxonomy", Kind: "inventory/taxonomies"},
+		{Path: "meta_business_unit", Kind: "inventory/meta_business_units"},
+	}},
+	{Name: "inventory/jmespath_checks", Service: "JMESPathChecks", Key: nameKey, Refs: []Ref{
+		{Path: "tags", Kind: "inventory/tags"},
+	}},
+	// Google Workspace
+	{Name: "gws/connections", Service: "GWSConnections", Key: nameKey},
+	{Name: "gws/group_tag_mappings", Service: "GWSGroupTagMappings", Key: []string{"connection", "group_email"}, Refs: []Ref{
+		{Path: "connection", Kind: "gws/connections"},
+		{Path: "tags", Kind: "inventory/tags"},
+	}},
+	// Realms
+	{Name: "realms/realms", Service: "RealmsRealms", Key: nameKey, IDField: "uuid"},
+	{Name: "realms/groups", Service: "RealmsGroups", Key: []string{"realm", "display_name"}, IDField: "uuid", Refs: []Ref{
+		{Path: "realm", Kind: "realms/realms"},
+		{Path: "parent", Kind: "realms/groups"},
+	}},
+	{Name: "realms/role_mappings", Service: "RealmsRoleMappings", Key: []string{"realm_group", "role"}, Refs: []Ref{
+		{Path: "realm_group", Kind: "realms/groups"},
+		{Path: "role", Kind: "accounts/roles"},
+	}},
+	{Name: "realms/tag_mappings", Service: "RealmsTagMappings", Key: []string{"realm_group", "tag"}, Refs: []Ref{
+		{Path: "realm_group", Kind: "realms/groups"},
+		{Path: "tag", Kind: "inventory/tags"},
+	}},
+	// MDM
+	{Name: "mdm/push_certificates", Service: "MDMPushCertificates", Key: nameKey},
+	{Name: "mdm/dep_virtual_servers", Service: "MDMDEPVirtualServers", Key: nameKey},
+	{Name: "mdm/locations", Service: "MDMLocations", Key: nameKey},
+	{Name: "mdm/location_assets", Service: "MDMLocationAssets", Key: []string{"location", "adam_id", "pricing_param"}, Refs: []Ref{
+		{Path: "location", Kind: "mdm/locations"},
+	}},
+	{Name: "mdm/acme_issuers", Service: "MDMACMEIssuers", Key: nameKey},
+	{Name: "mdm/scep_issuers", Service: "MDMSCEPIssuers", Key: nameKey},
+	{Name: "mdm/filevault_configs", Service: "MDMFileVaultConfigs", Key: nameKey},
+	{Name: "mdm/recovery_password_configs", Service: "MDMRecoveryPasswordConfigs", Key: nameKey},
+	{Name: "mdm/software_update_enforcements", Service: "MDMSoftwareUpdateEnforcements", Key: nameKey, Refs: []Ref{
+		{Path: "tags", Kind: "inventory/tags"},
+	}},
+	{Name: "mdm/blueprints", Service: "MDMBlueprints", Key: nameKey, Refs: []Ref{
+		{Path: "filevault_config", Kind: "mdm/filevault_configs"},
+		{Path: "recovery_password_config", Kind: "mdm/recovery_password_configs"},
+		{Path: "software_update_enforcements", Kind: "mdm/software_update_enforcements"},
+	}},
+	{Name: "mdm/artifacts", Service: "MDMArtifacts", Key: nameKey, Refs: []Ref{
+		{Path: "requires", Kind: "mdm/artifacts"},
+	}},
+	{Name: "mdm/blueprint_artifacts", Service: "MDMBlueprintArtifacts", Key: []string{"blueprint", "artifact"}, Refs: append([]Ref{
+		{Path: "blueprint", Kind: "mdm/blueprints"},
+	}, artifactVersionRefs...)},
+	{Name: "mdm/cert_assets", Service: "MDMCertAssets", Key: artifactVersionKey, Refs: append([]Ref{
+		{Path: "acme_issuer", Kind: "mdm/acme_issuers"},
+		{Path: "scep_issuer", Kind: "mdm/scep_issuers"},
+	}, artifactVersionRefs...)},
+	{Name: "mdm/data_assets", Service: "MDMDataAssets", Key: artifactVersionKey, Refs: artifactVersionRefs},
+	{Name: "mdm/declarations", Service: "MDMDeclarations", Key: artifactVersionKey, Refs: artifactVersionRefs},
+	{Name: "mdm/enterprise_apps", Service: "MDMEnterpriseApps", Key: artifactVersionKey, Refs: artifactVersionRefs},
+	{Name: "mdm/profiles", Service: "MDMProfiles", Key: artifactVersionKey, Refs: artifactVersionRefs},
+	{Name: "mdm/provisioning_profiles", Service: "MDMProvisioningProfiles", Key: artifactVersionKey, Refs: artifactVersionRefs},
+	{Name: "mdm/store_apps", Service: "MDMStoreApps", Key: artifactVersionKey, Refs: append([]Ref{
+		{Path: "location_asset", Kind: "mdm/location_assets"},
+	}, artifactVersionRefs...)},
+	{Name: "mdm/enrollment_custom_views", Service: "MDMEnrollmentCustomViews", Key: nameKey},
+	{Name: "mdm/dep_enrollments", Service: "MDMDEPEnrollments", Key: nameKey, Refs: append([]Ref{
+		{Path: "virtual_server", Kind: "mdm/dep_virtual_servers"},
+	}, mdmEnrollmentRefs...)},
+	{Name: "mdm/dep_enrollment_custom_views", Service: "MDMDEPEnrollmentCustomViews", Key: []string{"dep_enrollment", "custom_view"}, Refs: []Ref{
+		{Path: "dep_enrollment", Kind: "mdm/dep_enrollments"},
+		{Path: "custom_view", Kind: "mdm/enrollment_custom_views"},
+	}},
+	{Name: "mdm/ota_enrollments", Service: "MDMOTAEnrollments", Key: nameKey, Refs: mdmEnrollmentRefs},
+	{Name: "mdm/packages", Service: "MDMPackages", Key: nameKey},
+	// Monolith
+	{Name: "monolith/repositories", Service: "MonolithRepositories", Key: nameKey, Refs: []Ref{
+		{Path: "meta_business_unit", Kind: "inventory/meta_business_units"},
+	}},
+	{Name: "monolith/catalogs", Service: "MonolithCatalogs", Key: []string{"repository", "name"}, Refs: []Ref{
+		{Path: "repository", Kind: "monolith/repositories"},
+	}},
+	{Name: "monolith/conditions", Service: "MonolithConditions", Key: nameKey},
+	{Name: "monolith/manifests", Service: "MonolithManifests", Key: nameKey, Refs: []Ref{
+		{Path: "meta_business_unit", Kind: "inventory/meta_business_units"},
+	}},
+	{Name: "monolith/sub_manifests", Service: "MonolithSubManifests", Key: nameKey, Refs: []Ref{
+		{Path: "meta_business_unit", Kind: "inventory/meta_business_units"},
+	}},
+	{Name: "monolith/manifest_catalogs", Service: "MonolithManifestCatalogs", Key: []string{"manifest", "catalog"}, Refs: []Ref{
+		{Path: "manifest", Kind: "monolith/manifests"},
+		{Path: "catalog", Kind: "monolith/catalogs"},
+		{Path: "tags", Kind: "inventory/tags"},
+	}},
+	{Name: "monolith/manifest_sub_manifests", Service: "MonolithManifestSubManifests", Key: []string{"manifest", "sub_manifest"}, Refs: []Ref{
+		{Path: "manifest", Kind: "monolith/manifests"},
+		{Path: "sub_manifest", Kind: "monolith/sub_manifests"},
+		{Path: "tags", Kind: "inventory/tags"},
+	}},
+	{Name: "monolith/manifest_enrollment_packages", Service: "MonolithManifestEnrollmentPackages", Key: []string{"manifest", "builder"}, Refs: []Ref{
+		{Path: "manifest", Kind: "monolith/manifests"},
+		{Path: "tags", Kind: "inventory/tags"},
+	}},
+	{Name: "monolith/sub_manifest_pkg_infos", Service: "MonolithSubManifestPkgInfos", Key: []string{"sub_manifest", "key", "pkg_info_name"}, Refs: []Ref{
+		{Path: "sub_manifest", Kind: "monolith/sub_manifests"},
+		{Path: "condition", Kind: "monolith/conditions"},
+		{Path: "excluded_tags", Kind: "inventory/tags"},
+		{Path: "tag_shards[].tag", Kind: "inventory/tags"},
+	}},
+	{Name: "monolith/enrollments", Service: "MonolithEnrollments", Key: []string{"manifest", "secret.meta_business_unit", "secret.tags"}, Refs: []Ref{
+		{Path: "manifest", Kind: "monolith/manifests"},
+		{Path: "secret.meta_business_unit", Kind: "inventory/meta_business_units"},
+		{Path: "secret.tags", Kind: "inventory/tags"},
+	}},
+	// Munki
+	{Name: "munki/configurations", Service: "MunkiConfigurations", Key: nameKey},
+	{Name: "munki/enrollments", Service: "MunkiEnrollments", Key: enrollmentKey, Refs: enrollmentRefs("munki/configurations")},
+	{Name: "munki/script_checks", Service: "MunkiScriptChecks", Key: nameKey, Refs: []Ref{
+		{Path: "tags", Kind: "inventory/tags"},
+		{Path: "excluded_tags", Kind: "inventory/tags"},
+	}},
+	// Osquery
+	{Name: "osquery/atcs", Service: "OsqueryATC", Key: nameKey},
+	{Name: "osquery/file_categories", Service: "OsqueryFileCategories", Key: nameKey},
+	{Name: "osquery/configurations", Service: "OsqueryConfigurations", Key: nameKey, Refs: []Ref{
+		{Path: "automatic_table_constructions", Kind: "osquery/atcs"},
+		{Path: "file_categories", Kind: "osquery/file_categories"},
+	}},
+	{Name: "osquery/packs", Service: "OsqueryPacks", Key: nameKey},
+	{Name: "osquery/configuration_packs", Service: "OsqueryConfigurationPacks", Key: []string{"configuration", "pack"}, Refs: []Ref{
+		{Path: "configuration", Kind: "osquery/configurations"},
+		{Path: "pack", Kind: "osquery/packs"},
+		{Path: "tags", Kind: "inventory/tags"},
+		{Path: "excluded_tags", Kind: "inventory/tags"},
+	}},
+	{Name: "osquery/queries", Service: "OsqueryQueries", Key: nameKey, Refs: []Ref{
+		{Path: "tag", Kind: "inventory/tags"},
+		{Path: "scheduling.pack", Kind: "osquery/packs"},
+	}},
+	{Name: "osquery/enrollments", Service: "OsqueryEnrollments", Key: enrollmentKey, Refs: enrollmentRefs("osquery/configurations")},
+	// Probes
+	{Name: "probes/actions", Service: "ProbesActions", Key: nameKey},
+	{Name: "probes/probes", Service: "Probes", Key: nameKey, Refs: []Ref{
+		{Path: "actions", Kind: "probes/actions"},
+		{Path: "inventory_filters[].meta_business_unit_ids", Kind: "inventory/meta_business_units"},
+		{Path: "inventory_filters[].tag_ids", Kind: "inventory/tags"},
+	}},
+	// Santa
+	{Name: "santa/configurations", Service: "SantaConfigurations", Key: nameKey},
+	{Name: "santa/enrollments", Service: "SantaEnrollments", Key: enrollmentKey, Refs: enrollmentRefs("santa/configurations")},
+	{Name: "santa/rules", Service: "SantaRules", Key: []string{"configuration", "target_type", "target_identifier"}, Refs: []Ref{
+		{Path: "configuration", Kind: "santa/configurations"},
+		{Path: "tags", Kind: "inventory/tags"},
+		{Path: "excluded_tags", Kind: "inventory/tags"},
+	}},
+	// Stores
+	{Name: "stores/stores", Service: "Stores", Key: nameKey},
+	// Turbo
+	{Name: "turbo/configurations", Service: "TurboConfigurations", Key: nameKey},
+	{Name: "turbo/enrollments", Service: "TurboEnrollments", Key: enrollmentKey, Refs: enrollmentRefs("turbo/configurations")},
+	{Name: "turbo/scripts", Service: "TurboScripts", Key: nameKey, Refs: []Ref{
+		{Path: "tag", Kind: "inventory/tags"},
+	}},
+	{Name: "turbo/mscp_checks", Service: "TurboMSCPChecks", Key: []string{"rule_id", "baseline"}},
+	{Name: "turbo/one_time_jobs", Service: "TurboOneTimeJobs", Key: []string{"configuration", "job"}, Refs: turboJobRefs},
+	{Name: "turbo/recurring_jobs", Service: "TurboRecurringJobs", Key: []string{"configuration", "job"}, Refs: turboJobRefs},
+}
+
+var artifactVersionKey = []string{"artifact", "version"}
+
+var artifactVersionRefs = []Ref{
+	{Path: "artifact", Kind: "mdm/artifacts"},
+	{Path: "excluded_tags", Kind: "inventory/tags"},
+	{Path: "tag_shards[].tag", Kind: "inventory/tags"},
+}
+
+var mdmEnrollmentRefs = []Ref{
+	{Path: "push_certificate", Kind: "mdm/push_certificates"},
+	{Path: "acme_issuer", Kind: "mdm/acme_issuers"},
+	{Path: "scep_issuer", Kind: "mdm/scep_issuers"},
+	{Path: "blueprint", Kind: "mdm/blueprints"},
+	{Path: "realm", Kind: "realms/realms"},
+	{Path: "enrollment_secret.meta_business_unit", Kind: "inventory/meta_business_units"},
+	{Path: "enrollment_secret.tags", Kind: "inventory/tags"},
+}
+
+var enrollmentKey = []string{"configuration", "secret.meta_business_unit", "secret.tags"}
+
+func enrollmentRefs(configurationKind string) []Ref {
+	return []Ref{
+		{Path: "configuration", Kind: configurationKind},
+		{Path: "secret.meta_business_unit", Kind: "inventory/meta_business_units"},
+		{Path: "secret.tags", Kind: "inventory/tags"},
+	}
+}
+
+var turboJobRefs = []Ref{
+	{Path: "configuration", Kind: "turbo/configurations"},
+	{Path: "job", Kind: "turbo/scripts", Field: "job_id"},
+	{Path: "job", Kind: "turbo/mscp_checks", Field: "job_id"},
+	{Path: "tags", Kind: "inventory/tags"},
+	{Path: "excluded_tags", Kind: "inventory/tags"},
+}
+
+var kindsByName = make(map[string]*Kind)
+
+func init() {
+	for _, k := range kinds {
+		k.init()
+		kindsByName[k.Name] = k
+	}
+}
+
+// Kinds returns all the kinds, the referenced kinds first.
+func Kinds() []*Kind {
+	ks := make([]*Kind, len(kinds))
+	copy(ks, kinds)
+	return ks
+}
+
+// KindByName returns a kind by name, or nil.
+func KindByName(name string) *Kind {
+	return kindsByName[name]
+}
+
+// selectKinds returns the kinds with the given names, in registry order, or all the kinds if no
+// names are given.
+func selectKinds(names []string) ([]*Kind, error) {
+	if len(names) == 0 {
+		return Kinds(), nil
+	}
+	wanted := make(map[string]bool)
+	for _, name := range names {
+		if kindsByName[name] == nil {
+			return nil, goztl.NewArgError("kinds", fmt.Sprintf("%q is not a known kind", name))
+		}
+		wanted[name] = true
+	}
+	var ks []*Kind
+	for _, k := range kinds {
+		if wanted[k.Name] {
+			ks = append(ks, k)
+		}
+	}
+	return ks, nil
+}
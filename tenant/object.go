@@ -0,0 +1,356 @@
+package tenant
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"math"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Object is a Zentral object decoded from JSON. The numbers are int64 or float64.
+type Object map[string]interface{}
+
+// convert converts from to to, using their JSON encoding.
+func convert(from interface{}, to interface{}) error {
+	b, err := json.Marshal(from)
+	if err != nil {
+		return err
+	}
+	if obj, ok := to.(*Object); ok {
+		var m map[string]interface{}
+		if err := decodeJSON(b, &m); err != nil {
+			return err
+		}
+		*obj = m
+		return nil
+	}
+	if objs, ok := to.(*[]Object); ok {
+		var l []map[string]interface{}
+		if err := decodeJSON(b, &l); err != nil {
+			return err
+		}
+		*objs = make([]Object, len(l))
+		for i, m := range l {
+			(*objs)[i] = m
+		}
+		return nil
+	}
+	if v, ok := to.(*interface{}); ok {
+		return decodeJSON(b, v)
+	}
+	return json.Unmarshal(b, to)
+}
+
+// decodeJSON decodes b, with the numbers as int64 or float64.
+func decodeJSON(b []byte, v interface{}) error {
+	dec := json.NewDecoder(bytes.NewReader(b))
+	dec.UseNumber()
+	if err := dec.Decode(v); err != nil {
+		return err
+	}
+	switch tv := v.(type) {
+	case *interface{}:
+		*tv = normalize(*tv)
+	case *map[string]interface{}:
+		normalize(*tv)
+	case *[]map[string]interface{}:
+		for _, m := range *tv {
+			normalize(m)
+		}
+	}
+	return nil
+}
+
+// normalize returns v with its numbers as int64 or float64.
+func normalize(v interface{}) interface{} {
+	switch tv := v.(type) {
+	case json.Number:
+		if i, err := tv.Int64(); err == nil {
+			return i
+		}
+		f, _ := tv.Float64()
+		return f
+	case int:
+		return int64(tv)
+	case uint64:
+		if tv <= math.MaxInt64 {
+			return int64(tv)
+		}
+		return float64(tv)
+	case float64:
+		if tv == math.Trunc(tv) && math.Abs(tv) < 1<<53 {
+			return int64(tv)
+		}
+		return tv
+	case map[string]interface{}:
+		for k, e := range tv {
+			tv[k] = normalize(e)
+		}
+		return tv
+	case Object:
+		return normalize(map[string]interface{}(tv))
+	case []interface{}:
+		for i, e := range tv {
+			tv[i] = normalize(e)
+		}
+		return tv
+	default:
+		return v
+	}
+}
+
+// copyValue returns a deep copy of a decoded JSON value.
+func copyValue(v interface{}) interface{} {
+	switch tv := v.(type) {
+	case map[string]interface{}:
+		m := make(map[string]interface{}, len(tv))
+		for k, e := range tv {
+			m[k] = copyValue(e)
+		}
+		return m
+	case Object:
+		return Object(copyValue(map[string]interface{}(tv)).(map[string]interface{}))
+	case []interface{}:
+		l := make([]interface{}, len(tv))
+		for i, e := range tv {
+			l[i] = copyValue(e)
+		}
+		return l
+	default:
+		return v
+	}
+}
+
+// Copy returns a deep copy of the object.
+func (o Object) Copy() Object {
+	if o == nil {
+		return nil
+	}
+	return copyValue(o).(Object)
+}
+
+// scalarString returns the string form of a scalar value, used to match the references.
+func scalarString(v interface{}) string {
+	switch tv := v.(type) {
+	case nil:
+		return ""
+	case string:
+		return tv
+	case int64:
+		return strconv.FormatInt(tv, 10)
+	case float64:
+		return strconv.FormatFloat(tv, 'f', -1, 64)
+	default:
+		return fmt.Sprint(tv)
+	}
+}
+
+// walk calls fn with the map and field of every value at path.
+func walk(v interface{}, path string, fn func(m map[string]interface{}, field string)) {
+	walkParts(v, strings.Split(path, "."), fn)
+}
+
+func walkParts(v interface{}, parts []string, fn func(m map[string]interface{}, field string)) {
+	m := asMap(v)
+	if m == nil {
+		return
+	}
+	field := parts[0]
+	if len(parts) == 1 {
+		fn(m, field)
+		return
+	}
+	if strings.HasSuffix(field, "[]") {
+		l, _ := m[strings.TrimSuffix(field, "[]")].([]interface{})
+		for _, e := range l {
+			walkParts(e, parts[1:], fn)
+		}
+		return
+	}
+	walkParts(m[field], parts[1:], fn)
+}
+
+func asMap(v interface{}) map[string]interface{} {
+	switch tv := v.(type) {
+	case map[string]interface{}:
+		return tv
+	case Object:
+		return tv
+	default:
+		return nil
+	}
+}
+
+// values returns the values at path.
+func values(v interface{}, path string) []interface{} {
+	var vals []interface{}
+	walk(v, path, func(m map[string]interface{}, field string) {
+		if val, ok := m[field]; ok {
+			vals = append(vals, val)
+		}
+	})
+	return vals
+}
+
+// keyString returns the string form of a natural key field value. The lists are joined with commas.
+func keyString(v interface{}) string {
+	if l, ok := v.([]interface{}); ok {
+		s := make([]string, len(l))
+		for i, e := range l {
+			s[i] = scalarString(e)
+		}
+		sort.Strings(s)
+		return strings.Join(s, ",")
+	}
+	return scalarString(v)
+}
+
+// naturalKey returns the natural key of an object. The key fields are joined with slashes.
+func naturalKey(k *Kind, obj Object) string {
+	parts := make([]string, len(k.Key))
+	for i, path := range k.Key {
+		var vals []string
+		for _, v := range values(obj, path) {
+			vals = append(vals, keyString(v))
+		}
+		parts[i] = strings.Join(vals, ",")
+	}
+	return strings.Join(parts, "/")
+}
+
+// sortValues sorts a list of references by their string form.
+func sortValues(l []interface{}) {
+	sort.SliceStable(l, func(i, j int) bool {
+		return scalarString(l[i]) < scalarString(l[j])
+	})
+}
+
+var (
+	timeType          = reflect.TypeOf(time.Time{})
+	jsonMarshalerType = reflect.TypeOf((*json.Marshaler)(nil)).Elem()
+)
+
+// jsonFields returns the types of the JSON fields of a struct type. The fields of the embedded
+// structs are included.
+func jsonFields(t reflect.Type) map[string]reflect.Type {
+	fields := make(map[string]reflect.Type)
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	if t.Kind() != reflect.Struct || t == timeType || t.Implements(jsonMarshalerType) {
+		return fields
+	}
+	for i := 0; i < t.NumField(); i++ {
+		sf := t.Field(i)
+		if !sf.IsExported() {
+			continue
+		}
+		name := jsonName(sf)
+		if name == "-" {
+			continue
+		}
+		if name == "" {
+			if sf.Anonymous {
+				for n, ft := range jsonFields(sf.Type) {
+					if _, ok := fields[n]; !ok {
+						fields[n] = ft
+					}
+				}
+				continue
+			}
+			name = sf.Name
+		}
+		fields[name] = sf.Type
+	}
+	return fields
+}
+
+// jsonName returns the name of the field in the JSON tag, "-" if it is skipped.
+func jsonName(sf reflect.StructField) string {
+	tag := sf.Tag.Get("json")
+	if tag == "-" {
+		return "-"
+	}
+	name, _, _ := strings.Cut(tag, ",")
+	return name
+}
+
+// hasJSONFields tells if t is encoded as a JSON object with the fields of a struct.
+func hasJSONFields(t reflect.Type) bool {
+	return len(jsonFields(t)) > 0
+}
+
+// project returns a copy of v with only the JSON fields of t.
+func project(v interface{}, t reflect.Type) interface{} {
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	switch t.Kind() {
+	case reflect.Struct:
+		m := asMap(v)
+		if m == nil || !hasJSONFields(t) {
+			return copyValue(v)
+		}
+		p := make(map[string]interface{})
+		for name, ft := range jsonFields(t) {
+			if fv, ok := m[name]; ok {
+				p[name] = project(fv, ft)
+			}
+		}
+		return p
+	case reflect.Slice, reflect.Array:
+		l, ok := v.([]interface{})
+		if !ok {
+			return copyValue(v)
+		}
+		p := make([]interface{}, len(l))
+		for i, e := range l {
+			p[i] = project(e, t.Elem())
+		}
+		return p
+	default:
+		return copyValue(v)
+	}
+}
+
+// secretPaths returns the paths of the fields of t tagged with ztl:"secret".
+func secretPaths(t reflect.Type, prefix string) []string {
+	var paths []string
+	for t.Kind() == reflect.Ptr || t.Kind() == reflect.Slice || t.Kind() == reflect.Array {
+		if t.Kind() != reflect.Ptr {
+			prefix = strings.TrimSuffix(prefix, ".") + "[]."
+		}
+		t = t.Elem()
+	}
+	if t.Kind() != reflect.Struct || !hasJSONFields(t) {
+		return nil
+	}
+	for i := 0; i < t.NumField(); i++ {
+		sf := t.Field(i)
+		if !sf.IsExported() {
+			continue
+		}
+		name := jsonName(sf)
+		if name == "-" {
+			continue
+		}
+		if name == "" {
+			if sf.Anonymous {
+				paths = append(paths, secretPaths(sf.Type, prefix)...)
+				continue
+			}
+			name = sf.Name
+		}
+		if sf.Tag.Get("ztl") == "secret" {
+			paths = append(paths, prefix+name)
+			continue
+		}
+		paths = append(paths, secretPaths(sf.Type, prefix+name+".")...)
+	}
+	return paths
+}
@@ -0,0 +1,296 @@
+package tenant
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/zentralopensource/goztl"
+)
+
+// FindingCategory is the category of an orphaned, dangling or unused object.
+type FindingCategory string
+
+// The finding categories.
+const (
+	// Tags not referenced by any object, only reported if all the kinds that can reference tags are
+	// in the snapshot. The tags of the machines are not taken into account, and deleting a tag
+	// removes it from the machines, so they are only cleaned up if explicitly selected.
+	UnusedTag FindingCategory = "unused tag"
+
+	// Archived Monolith catalogs still attached to manifests.
+	AttachedArchivedCatalog FindingCategory = "attached archived catalog"
+
+	// Enrollments without enrolled machines. Deleting an enrollment breaks the packages already
+	// built with it, so they are only cleaned up if explicitly selected.
+	EmptyEnrollment FindingCategory = "empty enrollment"
+
+	// References to objects that do not exist.
+	DanglingReference FindingCategory = "dangling reference"
+
+	// Osquery packs not in any configuration.
+	UnusedPack FindingCategory = "unused pack"
+)
+
+// Finding is an orphaned, dangling or unused object.
+type Finding struct {
+	Category FindingCategory
+	Node
+
+	// Path and value of a dangling reference.
+	Path  string
+	Value interface{}
+
+	// Objects referencing an attached archived catalog.
+	Dependents []Node
+}
+
+func (f Finding) String() string {
+	switch f.Category {
+	case DanglingReference:
+		return fmt.Sprintf("%s: %s %s: %v", f.Category, f.Node, f.Path, f.Value)
+	case AttachedArchivedCatalog:
+		return fmt.Sprintf("%s: %s (%d attachments)", f.Category, f.Node, len(f.Dependents))
+	}
+	return fmt.Sprintf("%s: %s", f.Category, f.Node)
+}
+
+// OrphanReport is the result of the analysis of a snapshot.
+type OrphanReport struct {
+	Findings []Finding
+
+	snapshot *Snapshot
+	graph    *Graph
+}
+
+// ByCategory returns the findings of a category.
+func (r *OrphanReport) ByCategory(c FindingCategory) []Finding {
+	var fs []Finding
+	for _, f := range r.Findings {
+		if f.Category == c {
+			fs = append(fs, f)
+		}
+	}
+	return fs
+}
+
+// FindOrphans loads a snapshot of a tenant, like Load, and reports its orphaned, dangling and
+// unused objects. All the kinds are loaded by default.
+func FindOrphans(ctx context.Context, c *goztl.Client, kindNames ...string) (*OrphanReport, error) {
+	s, err := Load(ctx, c, kindNames...)
+	if err != nil {
+		return nil, err
+	}
+	return AnalyzeOrphans(s), nil
+}
+
+// enrollmentKinds are the kinds of the enrollments with an enrolled machines count.
+var enrollmentKinds = []string{
+	"monolith/enrollments",
+	"munki/enrollments",
+	"osquery/enrollments",
+	"santa/enrollments",
+	"turbo/enrollments",
+}
+
+// AnalyzeOrphans reports the orphaned, dangling and unused objects of a snapshot, in registry order
+// of the kinds, then key order. The unused packs are only reported accurately if the snapshot has
+// the configuration packs, and the unused tags are not reported if the snapshot is missing a kind
+// that can reference them. The empty enrollments and the archived catalogs are only found in the
+// loaded snapshots.
+func AnalyzeOrphans(s *Snapshot) *OrphanReport {
+	r := &OrphanReport{snapshot: s, graph: NewGraph(s)}
+	tagRefsLoaded := hasReferencingKinds(s, "inventory/tags")
+	for _, k := range s.Kinds() {
+		for _, e := range s.Entries(k.Name) {
+			n := Node{k.Name, e.Key}
+			r.Findings = append(r.Findings, danglingRefs(s, n, e)...)
+			switch {
+			case k.Name == "inventory/tags":
+				if tagRefsLoaded && len(r.graph.Dependents(n)) == 0 {
+					r.Findings = append(r.Findings, Finding{Category: UnusedTag, Node: n})
+				}
+			case k.Name == "osquery/packs":
+				used := false
+				for _, d := range r.graph.Dependents(n) {
+					used = used || d.Kind == "osquery/configuration_packs"
+				}
+				if !used {
+					r.Findings = append(r.Findings, Finding{Category: UnusedPack, Node: n})
+				}
+			case k.Name == "monolith/catalogs":
+				if e.raw["archived_at"] != nil {
+					if deps := r.graph.Dependents(n); len(deps) > 0 {
+						r.Findings = append(r.Findings, Finding{Category: AttachedArchivedCatalog, Node: n, Dependents: deps})
+					}
+				}
+			case isEnrollmentKind(k.Name):
+				if count, ok := e.raw["enrolled_machines_count"].(int64); ok && count == 0 {
+					r.Findings = append(r.Findings, Finding{Category: EmptyEnrollment, Node: n})
+				}
+			}
+		}
+	}
+	return r
+}
+
+// hasReferencingKinds tells if all the kinds that can reference the target kind are in the snapshot.
+func hasReferencingKinds(s *Snapshot, target string) bool {
+	loaded := make(map[string]bool)
+	for _, k := range s.Kinds() {
+		loaded[k.Name] = true
+	}
+	for _, k := range kinds {
+		if len(k.RefsTo(target)) > 0 && !loaded[k.Name] {
+			return false
+		}
+	}
+	return true
+}
+
+func isEnrollmentKind(name string) bool {
+	for _, k := range enrollmentKinds {
+		if k == name {
+			return true
+		}
+	}
+	return false
+}
+
+// danglingRefs returns the references of an entry to objects missing from the snapshot.
+func danglingRefs(s *Snapshot, n Node, e *Entry) []Finding {
+	var fs []Finding
+	paths, refs := refsByPath(e.Kind)
+	for _, path := range paths {
+		for _, v := range values(e.Object, path) {
+			l, ok := v.([]interface{})
+			if !ok {
+				l = []interface{}{v}
+			}
+			for _, rv := range l {
+				if rv != nil && isDangling(s, refs[path], rv) {
+					fs = append(fs, Finding{Category: DanglingReference, Node: n, Path: path, Value: rv})
+				}
+			}
+		}
+	}
+	return fs
+}
+
+// isDangling tells if a reference is not the natural key of an object of the snapshot. The
+// references that can be the keys of objects of kinds missing from the snapshot are not dangling.
+func isDangling(s *Snapshot, candidates []Ref, v interface{}) bool {
+	for _, r := range candidates {
+		key, ok := refKey(r, v, len(candidates))
+		if !ok {
+			continue
+		}
+		if !s.hasKind(r.Kind) || s.Lookup(r.Kind, key) != nil {
+			return false
+		}
+	}
+	return true
+}
+
+// defaultCleanupCategories are the categories cleaned up by default, without the deletions that
+// have side effects outside of the snapshot.
+var defaultCleanupCategories = []FindingCategory{AttachedArchivedCatalog, DanglingReference, UnusedPack}
+
+// CleanupPlan returns the plan deleting the findings of the selected categories. If none is
+// selected, all the categories but UnusedTag and EmptyEnrollment are cleaned up:
+//
+//   - the unused tags, the unused packs and the empty enrollments are deleted;
+//   - the attachments of the archived catalogs are deleted;
+//   - the dangling references are removed from their lists.
+//
+// The objects that are still referenced by other objects, the read-only objects and the dangling
+// references that are not list elements are skipped. The plan is applied with Plan.Apply.
+func (r *OrphanReport) CleanupPlan(categories ...FindingCategory) (*Plan, error) {
+	if len(categories) == 0 {
+		categories = defaultCleanupCategories
+	}
+	selected := make(map[FindingCategory]bool)
+	for _, c := range categories {
+		selected[c] = true
+	}
+
+	deleted := make(map[Node]bool)
+	dangling := make(map[Node][]Finding)
+	for _, f := range r.Findings {
+		if !selected[f.Category] {
+			continue
+		}
+		switch f.Category {
+		case DanglingReference:
+			dangling[f.Node] = append(dangling[f.Node], f)
+		case AttachedArchivedCatalog:
+			for _, d := range f.Dependents {
+				deleted[d] = true
+			}
+		default:
+			deleted[f.Node] = true
+		}
+	}
+	// keep the objects with remaining dependents
+	for changed := true; changed; {
+		changed = false
+		for n := range deleted {
+			for _, d := range r.graph.Dependents(n) {
+				if !deleted[d] {
+					delete(deleted, n)
+					changed = true
+					break
+				}
+			}
+		}
+	}
+
+	touched := make(map[string]bool)
+	for n := range deleted {
+		touched[n.Kind] = true
+	}
+	for n := range dangling {
+		if !deleted[n] {
+			touched[n.Kind] = true
+		}
+	}
+	var names []string
+	desired := NewSnapshot()
+	for _, k := range r.snapshot.Kinds() {
+		if !touched[k.Name] || k.ReadOnly() {
+			continue
+		}
+		names = append(names, k.Name)
+		for _, e := range r.snapshot.Entries(k.Name) {
+			n := Node{k.Name, e.Key}
+			if deleted[n] {
+				continue
+			}
+			obj := e.Object.Copy()
+			for _, f := range dangling[n] {
+				removeDanglingRef(obj, f.Path, f.Value)
+			}
+			desired.add(&Entry{Kind: k, Key: e.Key, Object: obj})
+		}
+	}
+	if len(names) == 0 {
+		return &Plan{target: r.snapshot}, nil
+	}
+	return NewPlan(desired, r.snapshot, &PlanOptions{Kinds: names, Ownership: &Ownership{}})
+}
+
+// removeDanglingRef removes an unresolved reference from the lists at path.
+func removeDanglingRef(obj Object, path string, value interface{}) {
+	walk(obj, path, func(m map[string]interface{}, field string) {
+		l, ok := m[field].([]interface{})
+		if !ok {
+			return
+		}
+		kept := make([]interface{}, 0, len(l))
+		for _, v := range l {
+			if v != value {
+				kept = append(kept, v)
+			}
+		}
+		m[field] = kept
+	})
+}
@@ -0,0 +1,157 @@
+package tenant
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/zentralopensource/goztl"
+)
+
+func TestFindOrphans(t *testing.T) {
+	client, fakes := fakeTenant()
+	fakes.OsqueryConfigurations.ListFunc = func(context.Context, *goztl.ListOptions) ([]goztl.OsqueryConfiguration, *goztl.Response, error) {
+		return []goztl.OsqueryConfiguration{{ID: 10, Name: "Default"}}, nil, nil
+	}
+	fakes.OsqueryATC.ListFunc = func(context.Context, *goztl.ListOptions) ([]goztl.OsqueryATC, *goztl.Response, error) {
+		return nil, nil, nil
+	}
+	fakes.OsqueryFileCategories.ListFunc = func(context.Context, *goztl.ListOptions) ([]goztl.OsqueryFileCategory, *goztl.Response, error) {
+		return nil, nil, nil
+	}
+	fakes.OsqueryPacks.ListFunc = func(context.Context, *goztl.ListOptions) ([]goztl.OsqueryPack, *goztl.Response, error) {
+		return []goztl.OsqueryPack{{ID: 11, Name: "Used"}, {ID: 12, Name: "Unused"}}, nil, nil
+	}
+	fakes.OsqueryConfigurationPacks.ListFunc = func(context.Context, *goztl.ListOptions) ([]goztl.OsqueryConfigurationPack, *goztl.Response, error) {
+		return []goztl.OsqueryConfigurationPack{{ID: 13, ConfigurationID: 10, PackID: 11}}, nil, nil
+	}
+	fakes.OsqueryEnrollments.ListFunc = func(context.Context, *goztl.ListOptions) ([]goztl.OsqueryEnrollment, *goztl.Response, error) {
+		return []goztl.OsqueryEnrollment{
+			{ID: 14, ConfigurationID: 10, EnrolledMachinesCount: 0, Secret: goztl.EnrollmentSecret{MetaBusinessUnitID: 2}},
+			{ID: 15, ConfigurationID: 10, EnrolledMachinesCount: 3, Secret: goztl.EnrollmentSecret{MetaBusinessUnitID: 2, TagIDs: []int{5}}},
+		}, nil, nil
+	}
+
+	ctx := context.Background()
+	r, err := FindOrphans(ctx, client, "inventory/jmespath_checks", "osquery/configuration_packs", "osquery/enrollments")
+	if err != nil {
+		t.Fatalf("FindOrphans returned error: %v", err)
+	}
+	var got []string
+	for _, f := range r.Findings {
+		got = append(got, f.String())
+	}
+	// the snapshot does not have all the kinds referencing the tags
+	want := []string{
+		`dangling reference: inventory/jmespath_checks "Check" tags: 99`,
+		`unused pack: osquery/packs "Unused"`,
+		`empty enrollment: osquery/enrollments "Default/Default/"`,
+	}
+	if !cmp.Equal(got, want) {
+		t.Errorf("FindOrphans returned %s", cmp.Diff(want, got))
+	}
+	if fs := r.ByCategory(UnusedPack); len(fs) != 1 || fs[0].Key != "Unused" {
+		t.Errorf("OrphanReport.ByCategory returned %v", fs)
+	}
+
+	p, err := r.CleanupPlan()
+	if err != nil {
+		t.Fatalf("OrphanReport.CleanupPlan returned error: %v", err)
+	}
+	wantPlan := `~ update inventory/jmespath_checks "Check" (7)
+    tags: [99,"Fomo","Yolo~2"] => ["Fomo","Yolo~2"]
+- delete osquery/packs "Unused" (12)
+Plan: 0 to create, 1 to update, 1 to delete.
+`
+	if got := p.String(); got != wantPlan {
+		t.Errorf("OrphanReport.CleanupPlan returned\n%s\nwant\n%s", got, wantPlan)
+	}
+
+	p, err = r.CleanupPlan(EmptyEnrollment)
+	if err != nil {
+		t.Fatalf("OrphanReport.CleanupPlan returned error: %v", err)
+	}
+	if p.Count(ActionDelete) != 1 || p.Count(ActionUpdate) != 0 {
+		t.Errorf("OrphanReport.CleanupPlan returned %s", p)
+	}
+}
+
+func TestAnalyzeOrphansUnusedTags(t *testing.T) {
+	client, _ := fakeTenant()
+
+	ctx := context.Background()
+	partial, err := Load(ctx, client, "inventory/jmespath_checks")
+	if err != nil {
+		t.Fatalf("Load returned error: %v", err)
+	}
+
+	// the same objects, with all the kinds that can reference the tags
+	var ks []*Kind
+	for _, k := range Kinds() {
+		if len(k.RefsTo("inventory/tags")) > 0 {
+			ks = append(ks, k)
+		}
+	}
+	ks = withDependencies(ks)
+	raw := make(map[string][]Object)
+	for _, k := range partial.Kinds() {
+		for _, e := range partial.Entries(k.Name) {
+			raw[k.Name] = append(raw[k.Name], e.raw)
+		}
+	}
+	r := AnalyzeOrphans(newSnapshotFromRaw(ks, raw))
+	var got []string
+	for _, f := range r.ByCategory(UnusedTag) {
+		got = append(got, f.String())
+	}
+	want := []string{`unused tag: inventory/tags "Yolo"`}
+	if !cmp.Equal(got, want) {
+		t.Errorf("AnalyzeOrphans returned %s", cmp.Diff(want, got))
+	}
+
+	p, err := r.CleanupPlan()
+	if err != nil {
+		t.Fatalf("OrphanReport.CleanupPlan returned error: %v", err)
+	}
+	if p.Count(ActionDelete) != 0 {
+		t.Errorf("OrphanReport.CleanupPlan deleted the unused tags by default:\n%s", p)
+	}
+
+	p, err = r.CleanupPlan(UnusedTag)
+	if err != nil {
+		t.Fatalf("OrphanReport.CleanupPlan returned error: %v", err)
+	}
+	if p.Count(ActionDelete) != 1 || p.Count(ActionUpdate) != 0 {
+		t.Errorf("OrphanReport.CleanupPlan returned %s", p)
+	}
+}
+
+func TestFindOrphansDanglingUUIDs(t *testing.T) {
+	client, fakes := fakeTenant()
+	fakes.RealmsRealms.ListFunc = func(context.Context, *goztl.ListOptions) ([]goztl.RealmsRealm, *goztl.Response, error) {
+		return []goztl.RealmsRealm{{UUID: "r1", Name: "Okta"}}, nil, nil
+	}
+	fakes.RealmsGroups.ListFunc = func(context.Context, *goztl.ListOptions) ([]goztl.RealmsGroup, *goztl.Response, error) {
+		return []goztl.RealmsGroup{
+			{UUID: "g1", RealmUUID: "r1", DisplayName: "IT"},
+			{UUID: "g2", RealmUUID: "r1", ParentUUID: goztl.String("g9"), DisplayName: "Admins"},
+			{UUID: "g3", RealmUUID: "r9", DisplayName: "Deleted"},
+		}, nil, nil
+	}
+
+	r, err := FindOrphans(context.Background(), client, "realms/groups")
+	if err != nil {
+		t.Fatalf("FindOrphans returned error: %v", err)
+	}
+	var got []string
+	for _, f := range r.Findings {
+		got = append(got, f.String())
+	}
+	want := []string{
+		`dangling reference: realms/groups "Okta/Admins" parent: g9`,
+		`dangling reference: realms/groups "r9/Deleted" realm: r9`,
+	}
+	if !cmp.Equal(got, want) {
+		t.Errorf("FindOrphans returned %s", cmp.Diff(want, got))
+	}
+}
@@ -0,0 +1,501 @@
+package tenant
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/zentralopensource/goztl"
+)
+
+// Action is what is done to an object.
+type Action string
+
+// The actions.
+const (
+	ActionNone   Action = "none"
+	ActionCreate Action = "create"
+	ActionUpdate Action = "update"
+	ActionDelete Action = "delete"
+)
+
+// FieldDiff is the difference of a field of an object. The secret values are redacted.
+type FieldDiff struct {
+	Path string
+	Old  interface{}
+	New  interface{}
+}
+
+// Change is a change of a plan.
+type Change struct {
+	Action Action
+	Kind   *Kind
+	Key    string
+
+	// ID of the existing object, or of the created object once applied.
+	ID string
+
+	// Differences of the updated object.
+	Diffs []FieldDiff
+
+	// Desired canonical object, or the deleted object.
+	Object Object
+
+	// Applied tells if the change was applied, and not rolled back.
+	Applied bool
+
+	current *Entry
+}
+
+func (ch *Change) String() string {
+	return fmt.Sprintf("%s %s %q", ch.Action, ch.Kind.Name, ch.Key)
+}
+
+// Ownership tells which existing objects are managed by a plan, and can be deleted. An object is
+// owned if it matches all the set criteria. All the objects of the planned kinds are owned if no
+// criteria are set.
+type Ownership struct {
+	// Prefix of the natural keys, the names for most of the kinds.
+	NamePrefix string
+
+	// Natural key of a tag set in the tags field or the tag field of the objects.
+	Tag string
+}
+
+func (o *Ownership) owns(e *Entry) bool {
+	if o.NamePrefix != "" && !strings.HasPrefix(e.Key, o.NamePrefix) {
+		return false
+	}
+	if o.Tag != "" {
+		found := false
+		for _, v := range append(values(e.Object, "tags"), values(e.Object, "tag")...) {
+			if l, ok := v.([]interface{}); ok {
+				for _, t := range l {
+					found = found || t == o.Tag
+				}
+			} else {
+				found = found || v == o.Tag
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
+
+// PlanOptions specifies the optional parameters of a plan.
+type PlanOptions struct {
+	// Names of the planned kinds. The kinds of the desired snapshot if empty.
+	Kinds []string
+
+	// Existing objects that can be deleted. No objects are deleted if nil.
+	Ownership *Ownership
+
+	// Do not update the existing objects that are different.
+	NoUpdate bool
+}
+
+// Plan is the list of changes to apply to a tenant to get a desired configuration. The objects
+// are created and updated with the referenced kinds first, and deleted with the referencing kinds
+// first, after the creations and updates.
+type Plan struct {
+	Changes []*Change
+
+	target *Snapshot
+}
+
+// NewPlan returns the plan to go from the current snapshot of a tenant, returned by Load, to the
+// desired snapshot. The current snapshot is updated when the plan is applied.
+//
+// The desired objects are matched with the current objects using their natural keys. The
+// redacted secrets of the desired objects are replaced with the values of the current objects.
+// The read-only objects are never changed, and must exist in the current snapshot. All the
+// problems found are returned, joined in a single error.
+func NewPlan(desired, current *Snapshot, opt *PlanOptions) (*Plan, error) {
+	if opt == nil {
+		opt = &PlanOptions{}
+	}
+	ks := desired.Kinds()
+	if len(opt.Kinds) > 0 {
+		var err error
+		if ks, err = selectKinds(opt.Kinds); err != nil {
+			return nil, err
+		}
+	}
+
+	p := &Plan{target: current}
+	var errs []error
+
+	// deletions
+	deleted := make(map[string]map[string]bool)
+	var deletions []*Change
+	if opt.Ownership != nil {
+		for _, k := range ks {
+			if k.ReadOnly() {
+				continue
+			}
+			deleted[k.Name] = make(map[string]bool)
+			for _, e := range current.Entries(k.Name) {
+				if desired.Lookup(k.Name, e.Key) == nil && opt.Ownership.owns(e) {
+					deleted[k.Name][e.Key] = true
+					deletions = append(deletions, &Change{
+						Action: ActionDelete, Kind: k, Key: e.Key, ID: e.ID, Object: e.Object, current: e,
+					})
+				}
+			}
+		}
+	}
+	// referencing kinds first
+	for i, j := 0, len(deletions)-1; i < j; i, j = i+1, j-1 {
+		deletions[i], deletions[j] = deletions[j], deletions[i]
+	}
+
+	// creations and updates
+	for _, k := range ks {
+		for _, e := range orderSelfRefs(k, desired.Entries(k.Name), current) {
+			ch, err := planEntry(k, e, current, opt)
+			if err != nil {
+				errs = append(errs, err)
+				continue
+			}
+			if ch.Action == ActionCreate || ch.Action == ActionUpdate {
+				// the references must be to existing objects that are kept, or to desired objects
+				_, err := k.resolveRefs(e.Key, ch.Object, func(r Ref, key string) (interface{}, bool) {
+					if desired.Lookup(r.Kind, key) != nil {
+						return nil, true
+					}
+					return nil, current.Lookup(r.Kind, key) != nil && !deleted[r.Kind][key]
+				})
+				if err != nil {
+					errs = append(errs, err)
+					continue
+				}
+			}
+			p.Changes = append(p.Changes, ch)
+		}
+	}
+	p.Changes = append(p.Changes, deletions...)
+
+	if len(errs) > 0 {
+		return nil, errors.Join(errs...)
+	}
+	return p, nil
+}
+
+// orderSelfRefs orders the entries of a kind, the entries referenced by the other entries first.
+func orderSelfRefs(k *Kind, entries []*Entry, current *Snapshot) []*Entry {
+	selfRefs := k.RefsTo(k.Name)
+	if len(selfRefs) == 0 {
+		return entries
+	}
+	var ordered []*Entry
+	done := make(map[string]bool)
+	pending := entries
+	for len(pending) > 0 {
+		var next []*Entry
+		for _, e := range pending {
+			ready := true
+			for _, r := range selfRefs {
+				for _, v := range values(e.Object, r.Path) {
+					l, ok := v.([]interface{})
+					if !ok {
+						l = []interface{}{v}
+					}
+					for _, rv := range l {
+						key, isKey := rv.(string)
+						if isKey && !done[key] && current.Lookup(k.Name, key) == nil {
+							ready = false
+						}
+					}
+				}
+			}
+			if ready {
+				ordered = append(ordered, e)
+				done[e.Key] = true
+			} else {
+				next = append(next, e)
+			}
+		}
+		if len(next) == len(pending) {
+			// cycle or missing references, reported later
+			return append(ordered, next...)
+		}
+		pending = next
+	}
+	return ordered
+}
+
+func planEntry(k *Kind, e *Entry, current *Snapshot, opt *PlanOptions) (*Change, error) {
+	cur := current.Lookup(k.Name, e.Key)
+	ch := &Change{Action: ActionNone, Kind: k, Key: e.Key, current: cur}
+	if cur != nil {
+		ch.ID = cur.ID
+	}
+	if k.ReadOnly() {
+		if cur == nil {
+			return nil, fmt.Errorf("%s %s: read-only object not found", k.Name, e.Key)
+		}
+		ch.Object = cur.Object
+		return ch, nil
+	}
+
+	obj := e.Object.Copy()
+	var curObj Object
+	if cur != nil {
+		curObj = cur.Object
+	}
+	if redacted := k.fillSecrets(obj, curObj); len(redacted) > 0 {
+		return nil, &RedactedSecretsError{Kind: k.Name, Key: e.Key, Paths: redacted}
+	}
+	ch.Object = obj
+	switch {
+	case cur == nil:
+		ch.Action = ActionCreate
+	case !opt.NoUpdate:
+		ch.Diffs = diffObjects(k, cur.Object, obj)
+		if len(ch.Diffs) > 0 {
+			ch.Action = ActionUpdate
+		}
+	}
+	return ch, nil
+}
+
+// diffObjects returns the differences between two canonical objects.
+func diffObjects(k *Kind, old, new Object) []FieldDiff {
+	secrets := make(map[string]bool)
+	for _, path := range k.secrets {
+		secrets[path] = true
+	}
+	var diffs []FieldDiff
+	var walkDiff func(path, pattern string, o, n interface{})
+	walkDiff = func(path, pattern string, o, n interface{}) {
+		om, nm := asMap(o), asMap(n)
+		if om != nil && nm != nil && !secrets[pattern] {
+			var fields []string
+			for f := range om {
+				fields = append(fields, f)
+			}
+			for f := range nm {
+				if _, ok := om[f]; !ok {
+					fields = append(fields, f)
+				}
+			}
+			sort.Strings(fields)
+			for _, f := range fields {
+				walkDiff(joinPath(path, f), joinPath(pattern, f), om[f], nm[f])
+			}
+			return
+		}
+		ol, ook := o.([]interface{})
+		nl, nok := n.([]interface{})
+		if ook && nok && len(ol) == len(nl) && len(ol) > 0 && asMap(ol[0]) != nil {
+			for i := range ol {
+				walkDiff(fmt.Sprintf("%s[%d]", path, i), pattern+"[]", ol[i], nl[i])
+			}
+			return
+		}
+		if reflect.DeepEqual(o, n) {
+			return
+		}
+		if secrets[pattern] {
+			o, n = redactedValue(o), redactedValue(n)
+		}
+		diffs = append(diffs, FieldDiff{Path: path, Old: o, New: n})
+	}
+	walkDiff("", "", map[string]interface{}(old), map[string]interface{}(new))
+	return diffs
+}
+
+func joinPath(path, field string) string {
+	if path == "" {
+		return field
+	}
+	return path + "." + field
+}
+
+func redactedValue(v interface{}) interface{} {
+	if v == nil || v == "" {
+		return v
+	}
+	return goztl.RedactedValue
+}
+
+// Count returns the number of changes with the given action.
+func (p *Plan) Count(action Action) int {
+	n := 0
+	for _, ch := range p.Changes {
+		if ch.Action == action {
+			n++
+		}
+	}
+	return n
+}
+
+// HasChanges tells if the plan has changes to apply.
+func (p *Plan) HasChanges() bool {
+	return len(p.Changes) > p.Count(ActionNone)
+}
+
+var actionSymbols = map[Action]string{
+	ActionCreate: "+",
+	ActionUpdate: "~",
+	ActionDelete: "-",
+}
+
+// Render writes a human readable description of the plan.
+func (p *Plan) Render(w io.Writer) error {
+	var b strings.Builder
+	for _, ch := range p.Changes {
+		if ch.Action == ActionNone {
+			continue
+		}
+		fmt.Fprintf(&b, "%s %s %s %q", actionSymbols[ch.Action], ch.Action, ch.Kind.Name, ch.Key)
+		if ch.ID != "" {
+			fmt.Fprintf(&b, " (%s)", ch.ID)
+		}
+		b.WriteString("\n")
+		for _, d := range ch.Diffs {
+			fmt.Fprintf(&b, "    %s: %s => %s\n", d.Path, renderValue(d.Old), renderValue(d.New))
+		}
+	}
+	if !p.HasChanges() {
+		b.WriteString("No changes.\n")
+	} else {
+		fmt.Fprintf(&b, "Plan: %d to create, %d to update, %d to delete.\n",
+			p.Count(ActionCreate), p.Count(ActionUpdate), p.Count(ActionDelete))
+	}
+	_, err := io.WriteString(w, b.String())
+	return err
+}
+
+func (p *Plan) String() string {
+	var b strings.Builder
+	p.Render(&b)
+	return b.String()
+}
+
+func renderValue(v interface{}) string {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return fmt.Sprint(v)
+	}
+	return string(b)
+}
+
+// ApplyError is returned when a change of a plan cannot be applied.
+type ApplyError struct {
+	// Change that could not be applied.
+	Change *Change
+
+	Err error
+
+	// Errors of the rollback of the applied changes.
+	RollbackErrors []error
+}
+
+func (e *ApplyError) Error() string {
+	msg := fmt.Sprintf("cannot %s: %v", e.Change, e.Err)
+	if len(e.RollbackErrors) > 0 {
+		msg += fmt.Sprintf(" (%d rollback errors)", len(e.RollbackErrors))
+	}
+	return msg
+}
+
+func (e *ApplyError) Unwrap() error {
+	return e.Err
+}
+
+// Apply applies the changes of the plan, in order. If a change cannot be applied, the applied
+// changes are rolled back, in reverse order: the created objects are deleted, the updated objects
+// are updated with their previous values, and the deleted objects are created again, with new IDs.
+// The secrets that were not returned by the API cannot be restored.
+func (p *Plan) Apply(ctx context.Context, c *goztl.Client) error {
+	return p.apply(ctx, c, true)
+}
+
+func (p *Plan) apply(ctx context.Context, c *goztl.Client, rollback bool) error {
+	var applied []*Change
+	for _, ch := range p.Changes {
+		if ch.Action == ActionNone || ch.Applied {
+			continue
+		}
+		if err := p.applyChange(ctx, c, ch); err != nil {
+			ae := &ApplyError{Change: ch, Err: err}
+			if rollback {
+				ae.RollbackErrors = p.rollback(ctx, c, applied)
+			}
+			return ae
+		}
+		applied = append(applied, ch)
+	}
+	return nil
+}
+
+func (p *Plan) applyChange(ctx context.Context, c *goztl.Client, ch *Change) error {
+	k := ch.Kind
+	switch ch.Action {
+	case ActionCreate, ActionUpdate:
+		req, err := k.requestForm(ch.Key, ch.Object, p.target)
+		if err != nil {
+			return err
+		}
+		var raw Object
+		if ch.Action == ActionCreate {
+			raw, err = k.Create(ctx, c, req)
+		} else {
+			raw, err = k.Update(ctx, c, ch.ID, req)
+		}
+		if err != nil {
+			return err
+		}
+		ch.ID = k.ID(raw)
+		p.target.set(&Entry{Kind: k, Key: ch.Key, ID: ch.ID, Object: ch.Object, raw: raw})
+	case ActionDelete:
+		if err := k.Delete(ctx, c, ch.ID); err != nil {
+			return err
+		}
+		p.target.remove(k.Name, ch.Key)
+	}
+	ch.Applied = true
+	return nil
+}
+
+func (p *Plan) rollback(ctx context.Context, c *goztl.Client, applied []*Change) []error {
+	var errs []error
+	for i := len(applied) - 1; i >= 0; i-- {
+		ch := applied[i]
+		k := ch.Kind
+		var err error
+		switch ch.Action {
+		case ActionCreate:
+			if err = k.Delete(ctx, c, ch.ID); err == nil {
+				p.target.remove(k.Name, ch.Key)
+			}
+		case ActionUpdate, ActionDelete:
+			var req, raw Object
+			if req, err = k.requestForm(ch.Key, ch.current.Object, p.target); err == nil {
+				if ch.Action == ActionUpdate {
+					raw, err = k.Update(ctx, c, ch.current.ID, req)
+				} else {
+					raw, err = k.Create(ctx, c, req)
+				}
+			}
+			if err == nil {
+				ch.ID = k.ID(raw)
+				p.target.set(&Entry{Kind: k, Key: ch.Key, ID: ch.ID, Object: ch.current.Object, raw: raw})
+			}
+		}
+		if err != nil {
+			errs = append(errs, fmt.Errorf("rollback %s: %w", ch, err))
+		} else {
+			ch.Applied = false
+		}
+	}
+	return errs
+}
@@ -0,0 +1,172 @@
+package tenant
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/zentralopensource/goztl"
+)
+
+// desiredTags returns a desired snapshot with a new tag, an updated tag and an unchanged tag.
+func desiredTags(t *testing.T, current *Snapshot) *Snapshot {
+	t.Helper()
+	desired := NewSnapshot()
+	if _, err := desired.Add("inventory/tags", Object{"name": "Fomo", "taxonomy": "Teams", "color": "00ff00"}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := desired.Add("inventory/tags", Object{"name": "New", "taxonomy": "Teams", "color": "0000ff"}); err != nil {
+		t.Fatal(err)
+	}
+	req := &goztl.TagCreateRequest{Name: "Yolo", Color: "0079bf"}
+	if _, err := desired.AddRequest("inventory/tags", req, current); err != nil {
+		t.Fatal(err)
+	}
+	return desired
+}
+
+func TestNewPlan(t *testing.T) {
+	client, _ := fakeTenant()
+
+	ctx := context.Background()
+	current, err := Load(ctx, client, "inventory/tags")
+	if err != nil {
+		t.Fatalf("Load returned error: %v", err)
+	}
+
+	p, err := NewPlan(desiredTags(t, current), current, &PlanOptions{Ownership: &Ownership{NamePrefix: "Yo"}})
+	if err != nil {
+		t.Fatalf("NewPlan returned error: %v", err)
+	}
+
+	want := `~ update inventory/tags "Fomo" (5)
+    color: "ff0000" => "00ff00"
++ create inventory/tags "New"
+- delete inventory/tags "Yolo~2" (6)
+Plan: 1 to create, 1 to update, 1 to delete.
+`
+	if got := p.String(); got != want {
+		t.Errorf("Plan.String returned %q, want %q", got, want)
+	}
+
+	p, err = NewPlan(desiredTags(t, current), current, &PlanOptions{NoUpdate: true})
+	if err != nil {
+		t.Fatalf("NewPlan returned error: %v", err)
+	}
+	if p.Count(ActionUpdate) != 0 || p.Count(ActionDelete) != 0 || p.Count(ActionCreate) != 1 {
+		t.Errorf("NewPlan returned %s", p)
+	}
+}
+
+func TestNewPlanErrors(t *testing.T) {
+	client, _ := fakeTenant()
+
+	ctx := context.Background()
+	current, err := Load(ctx, client, "inventory/tags")
+	if err != nil {
+		t.Fatalf("Load returned error: %v", err)
+	}
+
+	desired := NewSnapshot()
+	if _, err := desired.Add("inventory/tags", Object{"name": "Yolo", "taxonomy": "Zorg"}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := desired.Add("inventory/meta_business_units", Object{"name": "New"}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := desired.Add("inventory/taxonomies", Object{"name": "Other", "meta_business_unit": "Default"}); err != nil {
+		t.Fatal(err)
+	}
+
+	// the Default MBU is deleted
+	_, err = NewPlan(desired, current, &PlanOptions{Ownership: &Ownership{}})
+	var ure *UnresolvedRefsError
+	if !errors.As(err, &ure) {
+		t.Fatalf("NewPlan returned error %v, want UnresolvedRefsError", err)
+	}
+	if got := err.Error(); got != "inventory/taxonomies Other: unresolved references: inventory/meta_business_units:Default\n"+
+		"inventory/tags Yolo: unresolved references: inventory/taxonomies:Zorg" {
+		t.Errorf("NewPlan returned error %q", got)
+	}
+}
+
+func TestPlanApplyRollback(t *testing.T) {
+	client, fakes := fakeTenant()
+
+	ctx := context.Background()
+	current, err := Load(ctx, client, "inventory/tags")
+	if err != nil {
+		t.Fatalf("Load returned error: %v", err)
+	}
+
+	p, err := NewPlan(desiredTags(t, current), current, &PlanOptions{Ownership: &Ownership{NamePrefix: "Yo"}})
+	if err != nil {
+		t.Fatalf("NewPlan returned error: %v", err)
+	}
+
+	fakes.Tags.UpdateFunc = func(_ context.Context, id int, r *goztl.TagUpdateRequest) (*goztl.Tag, *goztl.Response, error) {
+		return &goztl.Tag{ID: id, Name: r.Name, TaxonomyID: r.TaxonomyID, Color: r.Color}, nil, nil
+	}
+	fakes.Tags.CreateFunc = func(_ context.Context, r *goztl.TagCreateRequest) (*goztl.Tag, *goztl.Response, error) {
+		return &goztl.Tag{ID: 10, Name: r.Name, TaxonomyID: r.TaxonomyID, Color: r.Color}, nil, nil
+	}
+	fakeErr := errors.New("yolo")
+	fakes.Tags.DeleteFunc = func(_ context.Context, id int) (*goztl.Response, error) {
+		if id == 6 {
+			return nil, fakeErr
+		}
+		return nil, nil
+	}
+
+	err = p.Apply(ctx, client)
+	var ae *ApplyError
+	if !errors.As(err, &ae) {
+		t.Fatalf("Plan.Apply returned error %v, want ApplyError", err)
+	}
+	if !errors.Is(err, fakeErr) || ae.Change.Key != "Yolo~2" || len(ae.RollbackErrors) != 0 {
+		t.Errorf("Plan.Apply returned error %+v", ae)
+	}
+
+	// created tag deleted, updated tag restored
+	wantDeletes := []interface{}{6, 10}
+	var deletes []interface{}
+	for _, call := range fakes.Tags.CallsTo("Delete") {
+		deletes = append(deletes, call.Args[0])
+	}
+	if !cmp.Equal(deletes, wantDeletes) {
+		t.Errorf("Tags.Delete calls %v, want %v", deletes, wantDeletes)
+	}
+	updates := fakes.Tags.CallsTo("Update")
+	if len(updates) != 2 {
+		t.Fatalf("Tags.Update calls %+v, want 2", updates)
+	}
+	wantUpdate := &goztl.TagUpdateRequest{Name: "Fomo", TaxonomyID: intPtr(3), Color: "ff0000"}
+	if got := updates[1].Args[1]; !cmp.Equal(got, wantUpdate) {
+		t.Errorf("Tags.Update rollback %+v, want %+v", got, wantUpdate)
+	}
+	for _, ch := range p.Changes {
+		if ch.Applied {
+			t.Errorf("Change %s applied after rollback", ch)
+		}
+	}
+}
+
+func TestOwnership(t *testing.T) {
+	e := &Entry{Key: "Yolo", Object: Object{"tags": []interface{}{"Managed", "Other"}}}
+	tests := []struct {
+		o    Ownership
+		want bool
+	}{
+		{Ownership{}, true},
+		{Ownership{NamePrefix: "Yo"}, true},
+		{Ownership{NamePrefix: "Fo"}, false},
+		{Ownership{Tag: "Managed"}, true},
+		{Ownership{NamePrefix: "Yo", Tag: "Zorg"}, false},
+	}
+	for _, tt := range tests {
+		if got := tt.o.owns(e); got != tt.want {
+			t.Errorf("Ownership%+v.owns = %v, want %v", tt.o, got, tt.want)
+		}
+	}
+}
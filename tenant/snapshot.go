@@ -0,0 +1,411 @@
+package tenant
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/zentralopensource/goztl"
+)
+
+// Entry is an object of a snapshot.
+type Entry struct {
+	Kind *Kind
+
+	// Natural key of the object. A "~N" suffix is added to the duplicated keys, in ID order.
+	Key string
+
+	// ID of the object in the tenant. Blank if the object is not in a tenant.
+	ID string
+
+	// Canonical form of the object. The references are the natural keys of the referenced objects,
+	// or their raw values if they could not be resolved.
+	Object Object
+
+	// raw is the goztl form of the object.
+	raw Object
+}
+
+// Raw returns a copy of the goztl form of the object, or nil if the object is not in a tenant.
+func (e *Entry) Raw() Object {
+	return e.raw.Copy()
+}
+
+// Snapshot is the canonical form of the objects of a tenant.
+type Snapshot struct {
+	kinds   []*Kind
+	entries map[string][]*Entry
+	byKey   map[string]map[string]*Entry
+}
+
+func newSnapshot(ks []*Kind) *Snapshot {
+	s := &Snapshot{
+		kinds:   ks,
+		entries: make(map[string][]*Entry),
+		byKey:   make(map[string]map[string]*Entry),
+	}
+	for _, k := range ks {
+		s.byKey[k.Name] = make(map[string]*Entry)
+	}
+	return s
+}
+
+// NewSnapshot returns an empty snapshot, to describe a desired configuration with Add and
+// AddRequest.
+func NewSnapshot() *Snapshot {
+	return newSnapshot(nil)
+}
+
+// Add adds an object of a kind, in its canonical form, with the natural keys of the referenced
+// objects. The fields missing from the object are set to their zero value.
+func (s *Snapshot) Add(kind string, obj Object) (*Entry, error) {
+	k := kindsByName[kind]
+	if k == nil {
+		return nil, goztl.NewArgError("kind", fmt.Sprintf("%q is not a known kind", kind))
+	}
+	obj = obj.Copy()
+	normalize(obj)
+	return s.addObject(k, k.canonical(obj))
+}
+
+// AddRequest adds an object of a kind, described by a goztl create or update request. The IDs of
+// the referenced objects are replaced by their natural keys, found in the current snapshot.
+func (s *Snapshot) AddRequest(kind string, req interface{}, current *Snapshot) (*Entry, error) {
+	k := kindsByName[kind]
+	if k == nil {
+		return nil, goztl.NewArgError("kind", fmt.Sprintf("%q is not a known kind", kind))
+	}
+	rt := reflect.TypeOf(req)
+	if k.ReadOnly() || rt == nil || rt.Kind() != reflect.Ptr ||
+		(rt.Elem() != k.createRequest && rt.Elem() != k.updateRequest) {
+		return nil, goztl.NewArgError("req", fmt.Sprintf("%T is not a %s request", req, kind))
+	}
+	var obj Object
+	if err := convert(req, &obj); err != nil {
+		return nil, err
+	}
+	idx := make(refIndex)
+	if current != nil {
+		for _, ck := range current.kinds {
+			for _, e := range current.entries[ck.Name] {
+				if e.raw != nil {
+					idx.addEntry(e)
+				}
+			}
+		}
+	}
+	rewriteRefs(k, obj, idx)
+	return s.addObject(k, k.canonical(obj))
+}
+
+func (s *Snapshot) addObject(k *Kind, obj Object) (*Entry, error) {
+	e := &Entry{Kind: k, Key: naturalKey(k, obj), Object: obj}
+	if s.Lookup(k.Name, e.Key) != nil {
+		return nil, fmt.Errorf("%s %s: duplicated key", k.Name, e.Key)
+	}
+	s.add(e)
+	return e, nil
+}
+
+// Kinds returns the kinds of the snapshot, the referenced kinds first.
+func (s *Snapshot) Kinds() []*Kind {
+	ks := make([]*Kind, len(s.kinds))
+	copy(ks, s.kinds)
+	return ks
+}
+
+// Entries returns the entries of a kind, sorted by key.
+func (s *Snapshot) Entries(kind string) []*Entry {
+	return s.entries[kind]
+}
+
+// Lookup returns the entry of a kind with the given natural key, or nil.
+func (s *Snapshot) Lookup(kind string, key string) *Entry {
+	return s.byKey[kind][key]
+}
+
+// add adds an entry, and keeps the entries of its kind sorted.
+func (s *Snapshot) add(e *Entry) {
+	name := e.Kind.Name
+	if _, ok := s.byKey[name]; !ok {
+		s.kinds = append(s.kinds, e.Kind)
+		sortKinds(s.kinds)
+		s.byKey[name] = make(map[string]*Entry)
+	}
+	l := s.entries[name]
+	i := sort.Search(len(l), func(i int) bool { return l[i].Key >= e.Key })
+	l = append(l, nil)
+	copy(l[i+1:], l[i:])
+	l[i] = e
+	s.entries[name] = l
+	s.byKey[name][e.Key] = e
+}
+
+// remove removes the entry of a kind with the given key.
+func (s *Snapshot) remove(kind string, key string) {
+	old := s.byKey[kind][key]
+	if old == nil {
+		return
+	}
+	delete(s.byKey[kind], key)
+	l := s.entries[kind]
+	for i, e := range l {
+		if e == old {
+			s.entries[kind] = append(l[:i:i], l[i+1:]...)
+			break
+		}
+	}
+}
+
+// set adds an entry, or replaces the entry of its kind with the same key.
+func (s *Snapshot) set(e *Entry) {
+	name := e.Kind.Name
+	if old := s.byKey[name][e.Key]; old != nil {
+		for i, o := range s.entries[name] {
+			if o == old {
+				s.entries[name][i] = e
+			}
+		}
+		s.byKey[name][e.Key] = e
+		return
+	}
+	s.add(e)
+}
+
+// sortKinds sorts kinds in registry order.
+func sortKinds(ks []*Kind) {
+	order := make(map[string]int)
+	for i, k := range kinds {
+		order[k.Name] = i
+	}
+	sort.SliceStable(ks, func(i, j int) bool { return order[ks[i].Name] < order[ks[j].Name] })
+}
+
+// withDependencies returns the kinds and the kinds they reference, in registry order.
+func withDependencies(ks []*Kind) []*Kind {
+	seen := make(map[string]bool)
+	var visit func(k *Kind)
+	visit = func(k *Kind) {
+		if seen[k.Name] {
+			return
+		}
+		seen[k.Name] = true
+		for _, r := range k.Refs {
+			visit(kindsByName[r.Kind])
+		}
+	}
+	for _, k := range ks {
+		visit(k)
+	}
+	var all []*Kind
+	for _, k := range kinds {
+		if seen[k.Name] {
+			all = append(all, k)
+		}
+	}
+	return all
+}
+
+// Load loads a snapshot of a tenant. If kind names are given, only these kinds and the kinds they
+// reference are loaded.
+func Load(ctx context.Context, c *goztl.Client, kindNames ...string) (*Snapshot, error) {
+	ks, err := selectKinds(kindNames)
+	if err != nil {
+		return nil, err
+	}
+	ks = withDependencies(ks)
+	raw := make(map[string][]Object)
+	for _, k := range ks {
+		objs, err := k.List(ctx, c)
+		if err != nil {
+			return nil, err
+		}
+		raw[k.Name] = objs
+	}
+	return newSnapshotFromRaw(ks, raw), nil
+}
+
+// refIndex maps the reference values to the natural keys, by kind and field.
+type refIndex map[string]map[string]map[string]string
+
+func (idx refIndex) add(k *Kind, field string, value interface{}, key string) {
+	if idx[k.Name] == nil {
+		idx[k.Name] = make(map[string]map[string]string)
+	}
+	if idx[k.Name][field] == nil {
+		idx[k.Name][field] = make(map[string]string)
+	}
+	idx[k.Name][field][scalarString(value)] = key
+}
+
+// addEntry adds the values of an entry referenced by the other kinds.
+func (idx refIndex) addEntry(e *Entry) {
+	k := e.Kind
+	idx.add(k, k.idField(), e.raw[k.idField()], e.Key)
+	for _, other := range kinds {
+		for _, r := range other.RefsTo(k.Name) {
+			if r.Field != "" {
+				idx.add(k, r.Field, e.raw[r.Field], e.Key)
+			}
+		}
+	}
+}
+
+func (idx refIndex) lookup(r Ref, value interface{}) (string, bool) {
+	field := r.Field
+	if field == "" {
+		field = kindsByName[r.Kind].idField()
+	}
+	key, ok := idx[r.Kind][field][scalarString(value)]
+	return key, ok
+}
+
+// refsByPath groups the references of a kind by path, in declaration order.
+func refsByPath(k *Kind) ([]string, map[string][]Ref) {
+	var paths []string
+	refs := make(map[string][]Ref)
+	for _, r := range k.Refs {
+		if _, ok := refs[r.Path]; !ok {
+			paths = append(paths, r.Path)
+		}
+		refs[r.Path] = append(refs[r.Path], r)
+	}
+	return paths, refs
+}
+
+// refValue returns the canonical form of a reference. When the same path can reference
+// different kinds, the natural key is prefixed with the kind name and a colon.
+func refValue(r Ref, key string, candidates int) string {
+	if candidates > 1 {
+		return r.Kind + ":" + key
+	}
+	return key
+}
+
+// refKey returns the natural key of the canonical form of a reference, without the kind prefix
+// added by refValue. The natural keys are strings, but the unresolved references keep their raw
+// values, that can also be strings.
+func refKey(r Ref, v interface{}, candidates int) (string, bool) {
+	s, ok := v.(string)
+	if !ok {
+		return "", false
+	}
+	if candidates > 1 {
+		return strings.CutPrefix(s, r.Kind+":")
+	}
+	return s, true
+}
+
+// hasKind tells if the snapshot has the objects of a kind.
+func (s *Snapshot) hasKind(kind string) bool {
+	_, ok := s.byKey[kind]
+	return ok
+}
+
+// rewriteRefs replaces the references of a goztl object with the natural keys found in idx.
+func rewriteRefs(k *Kind, obj Object, idx refIndex) {
+	paths, refs := refsByPath(k)
+	for _, path := range paths {
+		candidates := refs[path]
+		resolve := func(v interface{}) interface{} {
+			if v == nil {
+				return nil
+			}
+			for _, r := range candidates {
+				if key, ok := idx.lookup(r, v); ok {
+					return refValue(r, key, len(candidates))
+				}
+			}
+			return v
+		}
+		walk(obj, path, func(m map[string]interface{}, field string) {
+			switch v := m[field].(type) {
+			case nil:
+			case []interface{}:
+				for i, e := range v {
+					v[i] = resolve(e)
+				}
+				sortValues(v)
+			default:
+				m[field] = resolve(v)
+			}
+		})
+	}
+}
+
+// compareIDs orders the IDs, numerically if they are numbers.
+func compareIDs(a, b string) bool {
+	ia, erra := strconv.ParseInt(a, 10, 64)
+	ib, errb := strconv.ParseInt(b, 10, 64)
+	if erra == nil && errb == nil {
+		return ia < ib
+	}
+	return a < b
+}
+
+// newSnapshotFromRaw builds a snapshot from the goztl objects of the kinds.
+func newSnapshotFromRaw(ks []*Kind, raw map[string][]Object) *Snapshot {
+	s := newSnapshot(ks)
+	idx := make(refIndex)
+	var all []*Entry
+
+	// The keys are computed in registry order, so the keys of the referenced kinds are known.
+	for _, k := range ks {
+		var es []*Entry
+		for _, r := range raw[k.Name] {
+			obj := r.Copy()
+			rewriteRefs(k, obj, idx)
+			es = append(es, &Entry{Kind: k, Key: naturalKey(k, obj), ID: k.ID(r), raw: r})
+		}
+		sort.SliceStable(es, func(i, j int) bool {
+			if es[i].Key != es[j].Key {
+				return es[i].Key < es[j].Key
+			}
+			return compareIDs(es[i].ID, es[j].ID)
+		})
+		seen := make(map[string]int)
+		for _, e := range es {
+			seen[e.Key]++
+			if n := seen[e.Key]; n > 1 {
+				e.Key = fmt.Sprintf("%s~%d", e.Key, n)
+			}
+			idx.addEntry(e)
+		}
+		all = append(all, es...)
+	}
+
+	// The references are rewritten once all the keys are known, for the references to the same kind.
+	for _, e := range all {
+		obj := e.raw.Copy()
+		rewriteRefs(e.Kind, obj, idx)
+		e.Object = e.Kind.canonical(obj)
+		s.add(e)
+	}
+	return s
+}
+
+// canonical returns the canonical form of a goztl object with its references rewritten. The
+// missing fields are set to their zero value, to get the same form whatever the omitted fields.
+func (k *Kind) canonical(obj Object) Object {
+	c := make(Object)
+	for name, t := range k.fields {
+		if v, ok := obj[name]; ok {
+			c[name] = project(v, t)
+		} else {
+			c[name] = zeroValue(t)
+		}
+	}
+	return c
+}
+
+// zeroValue returns the JSON encoded zero value of a type, decoded.
+func zeroValue(t reflect.Type) interface{} {
+	var v interface{}
+	if err := convert([]interface{}{reflect.Zero(t).Interface()}, &v); err != nil {
+		return nil
+	}
+	return v.([]interface{})[0]
+}
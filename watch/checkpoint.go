@@ -0,0 +1,91 @@
+package watch
+
+import (
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+// Checkpoint is the state of the watched objects at the last poll.
+type Checkpoint struct {
+	// Time of the last poll.
+	Time time.Time `json:"time"`
+
+	// Fingerprints of the objects, by kind name and object ID.
+	Kinds map[string]map[string]string `json:"kinds"`
+}
+
+func newCheckpoint() *Checkpoint {
+	return &Checkpoint{Kinds: make(map[string]map[string]string)}
+}
+
+func (cp *Checkpoint) copy() *Checkpoint {
+	c := &Checkpoint{Time: cp.Time, Kinds: make(map[string]map[string]string, len(cp.Kinds))}
+	for k, fps := range cp.Kinds {
+		c.Kinds[k] = make(map[string]string, len(fps))
+		for id, fp := range fps {
+			c.Kinds[k][id] = fp
+		}
+	}
+	return c
+}
+
+// Store persists the checkpoint of a watcher.
+type Store interface {
+	// Load returns the saved checkpoint, or nil if there is none.
+	Load() (*Checkpoint, error)
+
+	// Save saves the checkpoint.
+	Save(*Checkpoint) error
+}
+
+// FileStore stores the checkpoint in a JSON file.
+type FileStore struct {
+	Path string
+}
+
+// Load implements the Store interface. A missing file is not an error.
+func (s *FileStore) Load() (*Checkpoint, error) {
+	b, err := os.ReadFile(s.Path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+	cp := newCheckpoint()
+	if err := json.Unmarshal(b, cp); err != nil {
+		return nil, err
+	}
+	if cp.Kinds == nil {
+		cp.Kinds = make(map[string]map[string]string)
+	}
+	return cp, nil
+}
+
+// Save implements the Store interface. The file is replaced atomically.
+func (s *FileStore) Save(cp *Checkpoint) error {
+	b, err := json.MarshalIndent(cp, "", "  ")
+	if err != nil {
+		return err
+	}
+	f, err := os.CreateTemp(filepath.Dir(s.Path), filepath.Base(s.Path)+".*")
+	if err != nil {
+		return err
+	}
+	if _, err := f.Write(append(b, '\n')); err != nil {
+		f.Close()
+		os.Remove(f.Name())
+		return err
+	}
+	if err := f.Close(); err != nil {
+		os.Remove(f.Name())
+		return err
+	}
+	if err := os.Rename(f.Name(), s.Path); err != nil {
+		os.Remove(f.Name())
+		return err
+	}
+	return nil
+}
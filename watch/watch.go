@@ -0,0 +1,274 @@
+// Package watch polls the Zentral API and reports the objects created, updated or deleted since
+// the last poll, for example to alert on the changes made outside of a deployment pipeline.
+//
+// The objects are listed with the kinds of the tenant package. Their changes are detected with
+// their updated_at timestamp, their version, or a hash of their content, in that order of
+// preference. The state of the last poll is saved in a checkpoint, so that a restarted watcher
+// does not report the same changes again.
+package watch
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/zentralopensource/goztl"
+	"github.com/zentralopensource/goztl/tenant"
+)
+
+// EventType is the type of a change event.
+type EventType string
+
+// The types of the change events.
+const (
+	Created EventType = "created"
+	Updated EventType = "updated"
+	Deleted EventType = "deleted"
+)
+
+// Event is the change of an object between two polls.
+type Event struct {
+	Type EventType
+
+	// Name of the tenant kind of the object, e.g. "santa/rules".
+	Kind string
+
+	// ID of the object.
+	ID string
+
+	// Current state of the object, in its goztl form. Nil for the deleted objects.
+	Object tenant.Object
+
+	// Fingerprints of the object, before and after the change. Empty before a creation and after
+	// a deletion.
+	PreviousFingerprint string
+	Fingerprint         string
+
+	// Time of the poll that detected the change.
+	Time time.Time
+}
+
+func (e Event) String() string {
+	return fmt.Sprintf("%s %s %s", e.Kind, e.ID, e.Type)
+}
+
+// Handler is called with every change event. The event is recorded in the checkpoint only if the
+// handler returns no error.
+type Handler func(context.Context, Event) error
+
+// SendTo returns a handler sending the events to a channel.
+func SendTo(ch chan<- Event) Handler {
+	return func(ctx context.Context, e Event) error {
+		select {
+		case ch <- e:
+			return nil
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
+
+// Options specifies the parameters of a watcher.
+type Options struct {
+	// Names of the tenant kinds to watch, e.g. "santa/rules", "probes/probes", "mdm/artifacts".
+	Kinds []string
+
+	// Time between two polls. Defaults to one minute.
+	Interval time.Duration
+
+	// Storage of the checkpoint. If nil, the checkpoint is only kept in memory.
+	Store Store
+
+	// Report the existing objects as created when a kind is polled for the first time. By default,
+	// the first poll of a kind only records the existing objects.
+	InitialEvents bool
+
+	// Called with the poll errors. If nil, Run returns the first poll error.
+	OnError func(error)
+}
+
+// Watcher polls the Zentral API for changes.
+type Watcher struct {
+	client *goztl.Client
+	kinds  []*tenant.Kind
+	opt    Options
+	cp     *Checkpoint
+
+	// now returns the current time.
+	now func() time.Time
+}
+
+// New returns a watcher of the selected kinds. The checkpoint is loaded from the store.
+func New(c *goztl.Client, opt *Options) (*Watcher, error) {
+	if opt == nil || len(opt.Kinds) == 0 {
+		return nil, goztl.NewArgError("Kinds", "cannot be empty")
+	}
+	w := &Watcher{client: c, opt: *opt, now: time.Now}
+	for _, name := range opt.Kinds {
+		k := tenant.KindByName(name)
+		if k == nil {
+			return nil, goztl.NewArgError("Kinds", fmt.Sprintf("unknown kind %q", name))
+		}
+		w.kinds = append(w.kinds, k)
+	}
+	if w.opt.Interval <= 0 {
+		w.opt.Interval = time.Minute
+	}
+	w.cp = newCheckpoint()
+	if w.opt.Store != nil {
+		cp, err := w.opt.Store.Load()
+		if err != nil {
+			return nil, err
+		}
+		if cp != nil {
+			w.cp = cp
+		}
+	}
+	return w, nil
+}
+
+// Checkpoint returns a copy of the current checkpoint.
+func (w *Watcher) Checkpoint() *Checkpoint {
+	return w.cp.copy()
+}
+
+// Run polls the API at every interval until the context is done, and calls the handler with the
+// change events. It returns the first handler error, or the first poll error if OnError is not
+// set.
+func (w *Watcher) Run(ctx context.Context, h Handler) error {
+	t := time.NewTicker(w.opt.Interval)
+	defer t.Stop()
+	for {
+		if err := w.Poll(ctx, h); err != nil {
+			var he *HandlerError
+			if errors.As(err, &he) || w.opt.OnError == nil || ctx.Err() != nil {
+				return err
+			}
+			w.opt.OnError(err)
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-t.C:
+		}
+	}
+}
+
+// HandlerError is returned when the handler fails to process an event.
+type HandlerError struct {
+	Event Event
+	Err   error
+}
+
+func (e *HandlerError) Error() string {
+	return fmt.Sprintf("%s: %v", e.Event, e.Err)
+}
+
+func (e *HandlerError) Unwrap() error {
+	return e.Err
+}
+
+// Poll lists the watched kinds once, and calls the handler with the change events, ordered by
+// kind, then by type and ID. The checkpoint is saved after the poll, with the events processed
+// by the handler, even if an error is returned.
+func (w *Watcher) Poll(ctx context.Context, h Handler) (err error) {
+	now := w.now()
+	defer func() {
+		if w.opt.Store == nil {
+			return
+		}
+		w.cp.Time = now
+		if serr := w.opt.Store.Save(w.cp); serr != nil && err == nil {
+			err = serr
+		}
+	}()
+	for _, k := range w.kinds {
+		objs, err := k.List(ctx, w.client)
+		if err != nil {
+			return err
+		}
+		known, seen := w.cp.Kinds[k.Name]
+		current := make(map[string]string, len(objs))
+		byID := make(map[string]tenant.Object, len(objs))
+		for _, obj := range objs {
+			id := k.ID(obj)
+			fp, err := Fingerprint(obj)
+			if err != nil {
+				return fmt.Errorf("%s %s: %w", k.Name, id, err)
+			}
+			current[id] = fp
+			byID[id] = obj
+		}
+		if !seen {
+			w.cp.Kinds[k.Name] = make(map[string]string)
+			if !w.opt.InitialEvents {
+				w.cp.Kinds[k.Name] = current
+				continue
+			}
+		}
+		for _, e := range diff(k.Name, known, current, byID, now) {
+			if err := h(ctx, e); err != nil {
+				return &HandlerError{Event: e, Err: err}
+			}
+			if e.Type == Deleted {
+				delete(w.cp.Kinds[k.Name], e.ID)
+			} else {
+				w.cp.Kinds[k.Name][e.ID] = e.Fingerprint
+			}
+		}
+	}
+	return nil
+}
+
+// diff returns the change events between the known and the current fingerprints of a kind.
+func diff(kind string, known, current map[string]string, objs map[string]tenant.Object, now time.Time) []Event {
+	var events []Event
+	for id, fp := range current {
+		prev, ok := known[id]
+		switch {
+		case !ok:
+			events = append(events, Event{Type: Created, Kind: kind, ID: id, Object: objs[id], Fingerprint: fp, Time: now})
+		case prev != fp:
+			events = append(events, Event{Type: Updated, Kind: kind, ID: id, Object: objs[id],
+				PreviousFingerprint: prev, Fingerprint: fp, Time: now})
+		}
+	}
+	for id, prev := range known {
+		if _, ok := current[id]; !ok {
+			events = append(events, Event{Type: Deleted, Kind: kind, ID: id, PreviousFingerprint: prev, Time: now})
+		}
+	}
+	order := map[EventType]int{Created: 0, Updated: 1, Deleted: 2}
+	sort.Slice(events, func(i, j int) bool {
+		if events[i].Type != events[j].Type {
+			return order[events[i].Type] < order[events[j].Type]
+		}
+		return events[i].ID < events[j].ID
+	})
+	return events
+}
+
+// zeroTime is the JSON form of the zero goztl.Timestamp.
+const zeroTime = "0001-01-01T00:00:00Z"
+
+// Fingerprint returns the fingerprint of an object in its goztl form: its updated_at timestamp,
+// its version, or the SHA-256 hash of its JSON form.
+func Fingerprint(obj tenant.Object) (string, error) {
+	if v, ok := obj["updated_at"].(string); ok && v != "" && v != zeroTime {
+		return "updated_at:" + v, nil
+	}
+	if v, ok := obj["version"]; ok && v != nil {
+		return fmt.Sprintf("version:%v", v), nil
+	}
+	b, err := json.Marshal(obj)
+	if err != nil {
+		return "", err
+	}
+	sum := sha256.Sum256(b)
+	return "sha256:" + hex.EncodeToString(sum[:]), nil
+}
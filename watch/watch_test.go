@@ -0,0 +1,145 @@
+package watch
+
+import (
+	"context"
+	"errors"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/zentralopensource/goztl"
+	"github.com/zentralopensource/goztl/goztlfake"
+)
+
+type event struct {
+	Type EventType
+	Kind string
+	ID   string
+}
+
+func collect(events *[]event) Handler {
+	return func(_ context.Context, e Event) error {
+		*events = append(*events, event{e.Type, e.Kind, e.ID})
+		return nil
+	}
+}
+
+func TestPoll(t *testing.T) {
+	client, fakes := goztlfake.NewClient()
+	updated := goztl.Timestamp{Time: time.Date(2026, 10, 1, 0, 0, 0, 0, time.UTC)}
+	rules := []goztl.SantaRule{
+		{ID: 1, TargetIdentifier: "yolo", Version: 1, Updated: updated},
+		{ID: 2, TargetIdentifier: "fomo", Version: 1, Updated: updated},
+	}
+	fakes.SantaRules.ListFunc = func(context.Context, *goztl.ListOptions) ([]goztl.SantaRule, *goztl.Response, error) {
+		return rules, nil, nil
+	}
+	tags := []goztl.Tag{{ID: 5, Name: "Fomo", Color: "ff0000"}}
+	fakes.Tags.ListFunc = func(context.Context, *goztl.ListOptions) ([]goztl.Tag, *goztl.Response, error) {
+		return tags, nil, nil
+	}
+
+	store := &FileStore{Path: filepath.Join(t.TempDir(), "checkpoint.json")}
+	opt := &Options{Kinds: []string{"santa/rules", "inventory/tags"}, Store: store}
+	w, err := New(client, opt)
+	if err != nil {
+		t.Fatalf("New returned error: %v", err)
+	}
+
+	// first poll, no events
+	ctx := context.Background()
+	var events []event
+	if err := w.Poll(ctx, collect(&events)); err != nil {
+		t.Fatalf("Watcher.Poll returned error: %v", err)
+	}
+	if len(events) != 0 {
+		t.Errorf("Watcher.Poll events %v, want none", events)
+	}
+
+	rules = []goztl.SantaRule{
+		{ID: 1, TargetIdentifier: "yolo", Version: 2, Updated: goztl.Timestamp{Time: updated.Add(time.Hour)}},
+		{ID: 3, TargetIdentifier: "zorg", Version: 1, Updated: updated},
+	}
+	tags = []goztl.Tag{{ID: 5, Name: "Fomo", Color: "00ff00"}, {ID: 6, Name: "Yolo"}}
+
+	// restarted watcher
+	w, err = New(client, opt)
+	if err != nil {
+		t.Fatalf("New returned error: %v", err)
+	}
+	if err := w.Poll(ctx, collect(&events)); err != nil {
+		t.Fatalf("Watcher.Poll returned error: %v", err)
+	}
+	want := []event{
+		{Created, "santa/rules", "3"},
+		{Updated, "santa/rules", "1"},
+		{Deleted, "santa/rules", "2"},
+		{Created, "inventory/tags", "6"},
+		{Updated, "inventory/tags", "5"},
+	}
+	if !cmp.Equal(events, want) {
+		t.Errorf("Watcher.Poll events %v, want %v", events, want)
+	}
+
+	// no changes
+	events = nil
+	if err := w.Poll(ctx, collect(&events)); err != nil {
+		t.Fatalf("Watcher.Poll returned error: %v", err)
+	}
+	if len(events) != 0 {
+		t.Errorf("Watcher.Poll events %v, want none", events)
+	}
+}
+
+func TestPollHandlerError(t *testing.T) {
+	client, fakes := goztlfake.NewClient()
+	tags := []goztl.Tag{{ID: 5, Name: "Fomo"}}
+	fakes.Tags.ListFunc = func(context.Context, *goztl.ListOptions) ([]goztl.Tag, *goztl.Response, error) {
+		return tags, nil, nil
+	}
+
+	w, err := New(client, &Options{Kinds: []string{"inventory/tags"}, InitialEvents: true})
+	if err != nil {
+		t.Fatalf("New returned error: %v", err)
+	}
+
+	ctx := context.Background()
+	tags = append(tags, goztl.Tag{ID: 6, Name: "Yolo"})
+	handlerErr := errors.New("yolo")
+	var events []event
+	err = w.Poll(ctx, func(ctx context.Context, e Event) error {
+		if e.ID == "6" {
+			return handlerErr
+		}
+		return collect(&events)(ctx, e)
+	})
+	var he *HandlerError
+	if !errors.As(err, &he) || !errors.Is(err, handlerErr) || he.Event.ID != "6" {
+		t.Fatalf("Watcher.Poll returned error %v, want HandlerError", err)
+	}
+
+	// the failed event is reported again
+	ch := make(chan Event, 10)
+	if err := w.Poll(ctx, SendTo(ch)); err != nil {
+		t.Fatalf("Watcher.Poll returned error: %v", err)
+	}
+	close(ch)
+	for e := range ch {
+		events = append(events, event{e.Type, e.Kind, e.ID})
+	}
+	want := []event{{Created, "inventory/tags", "5"}, {Created, "inventory/tags", "6"}}
+	if !cmp.Equal(events, want) {
+		t.Errorf("Watcher.Poll events %v, want %v", events, want)
+	}
+}
+
+func TestNewErrors(t *testing.T) {
+	client, _ := goztlfake.NewClient()
+	if _, err := New(client, nil); err == nil {
+		t.Error("New returned no error without kinds")
+	}
+	if _, err := New(client, &Options{Kinds: []string{"yolo/fomo"}}); err == nil {
+		t.Error("New returned no error with an unknown kind")
+	}
+}